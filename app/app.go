@@ -6,7 +6,9 @@ import (
 
 	"github.com/kava-labs/kava/x/auction"
 	"github.com/kava-labs/kava/x/cdp"
+	cdpclient "github.com/kava-labs/kava/x/cdp/client"
 	"github.com/kava-labs/kava/x/pricefeed"
+	pricefeedclient "github.com/kava-labs/kava/x/pricefeed/client"
 	validatorvesting "github.com/kava-labs/kava/x/validator-vesting"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -54,7 +56,13 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		gov.NewAppModuleBasic(
+			paramsclient.ProposalHandler, distr.ProposalHandler,
+			pricefeedclient.AddMarketProposalHandler, pricefeedclient.RemoveMarketProposalHandler,
+			pricefeedclient.AddOracleProposalHandler, pricefeedclient.RemoveOracleProposalHandler,
+			pricefeedclient.ActivateMarketProposalHandler, pricefeedclient.DeactivateMarketProposalHandler,
+			cdpclient.AddCollateralProposalHandler, cdpclient.UpdateCollateralProposalHandler,
+		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
@@ -76,6 +84,8 @@ var (
 		auction.ModuleName:          nil,
 		cdp.ModuleName:              {supply.Minter, supply.Burner},
 		cdp.LiquidatorMacc:          {supply.Minter, supply.Burner},
+		cdp.SavingsMacc:             nil,
+		pricefeed.ModuleName:        nil,
 	}
 )
 
@@ -204,19 +214,12 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
 		invCheckPeriod,
 		app.supplyKeeper,
 		auth.FeeCollectorName)
-	govRouter := gov.NewRouter()
-	govRouter.
-		AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper))
-	app.govKeeper = gov.NewKeeper(
+	app.pricefeedKeeper = pricefeed.NewKeeper(
 		app.cdc,
-		keys[gov.StoreKey],
-		govSubspace,
+		keys[pricefeed.StoreKey],
+		pricefeedSubspace,
 		app.supplyKeeper,
-		&stakingKeeper,
-		gov.DefaultCodespace,
-		govRouter)
+		pricefeed.DefaultCodespace)
 	app.vvKeeper = validatorvesting.NewKeeper(
 		app.cdc,
 		keys[validatorvesting.StoreKey],
@@ -224,11 +227,6 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
 		app.bankKeeper,
 		app.supplyKeeper,
 		&stakingKeeper)
-	app.pricefeedKeeper = pricefeed.NewKeeper(
-		app.cdc,
-		keys[pricefeed.StoreKey],
-		pricefeedSubspace,
-		pricefeed.DefaultCodespace)
 	// NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, pfk types.PricefeedKeeper, sk types.SupplyKeeper, codespace sdk.CodespaceType)
 	app.auctionKeeper = auction.NewKeeper(
 		app.cdc,
@@ -243,6 +241,22 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
 		app.auctionKeeper,
 		app.supplyKeeper,
 		cdp.DefaultCodespace)
+	app.pricefeedKeeper = *app.pricefeedKeeper.SetHooks(app.cdpKeeper.Hooks())
+	govRouter := gov.NewRouter()
+	govRouter.
+		AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(pricefeed.RouterKey, pricefeed.NewProposalHandler(app.pricefeedKeeper)).
+		AddRoute(cdp.RouterKey, cdp.NewProposalHandler(app.cdpKeeper))
+	app.govKeeper = gov.NewKeeper(
+		app.cdc,
+		keys[gov.StoreKey],
+		govSubspace,
+		app.supplyKeeper,
+		&stakingKeeper,
+		gov.DefaultCodespace,
+		govRouter)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -280,11 +294,15 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
 	//
 	// Note: Changing the order of the auth module and modules that use module accounts
 	// results in subtle changes to the way accounts are loaded from genesis.
+	//
+	// Note: crisis asserts all invariants when initialized, so it must come after
+	// every module that registers invariants.
 	app.mm.SetOrderInitGenesis(
 		auth.ModuleName, validatorvesting.ModuleName, distr.ModuleName,
 		staking.ModuleName, bank.ModuleName, slashing.ModuleName,
-		gov.ModuleName, mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName,
+		gov.ModuleName, mint.ModuleName, supply.ModuleName, genutil.ModuleName,
 		pricefeed.ModuleName, cdp.ModuleName, auction.ModuleName, // TODO is this order ok?
+		crisis.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -304,6 +322,9 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
 		distr.NewAppModule(app.distrKeeper, app.supplyKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
+		pricefeed.NewAppModule(app.pricefeedKeeper),
+		cdp.NewAppModule(app.cdpKeeper, app.pricefeedKeeper),
+		auction.NewAppModule(app.auctionKeeper, app.supplyKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -34,6 +34,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingsimops "github.com/cosmos/cosmos-sdk/x/staking/simulation/operations"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/kava-labs/kava/x/auction"
+	auctionsimops "github.com/kava-labs/kava/x/auction/simulation/operations"
+	cdpsimops "github.com/kava-labs/kava/x/cdp/simulation/operations"
+	pricefeedsimops "github.com/kava-labs/kava/x/pricefeed/simulation/operations"
 )
 
 // Simulation parameter constants
@@ -49,6 +54,8 @@ const (
 	OpWeightSubmitVotingSlashingTextProposal           = "op_weight_submit_voting_slashing_text_proposal"
 	OpWeightSubmitVotingSlashingCommunitySpendProposal = "op_weight_submit_voting_slashing_community_spend_proposal"
 	OpWeightSubmitVotingSlashingParamChangeProposal    = "op_weight_submit_voting_slashing_param_change_proposal"
+	OpWeightSubmitVotingSlashingOracleProposal         = "op_weight_submit_voting_slashing_oracle_proposal"
+	OpWeightSubmitVotingSlashingCollateralProposal     = "op_weight_submit_voting_slashing_collateral_proposal"
 	OpWeightMsgDeposit                                 = "op_weight_msg_deposit"
 	OpWeightMsgCreateValidator                         = "op_weight_msg_create_validator"
 	OpWeightMsgEditValidator                           = "op_weight_msg_edit_validator"
@@ -56,6 +63,19 @@ const (
 	OpWeightMsgUndelegate                              = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate                         = "op_weight_msg_begin_redelegate"
 	OpWeightMsgUnjail                                  = "op_weight_msg_unjail"
+	OpWeightMsgCreateCDP                               = "op_weight_msg_create_cdp"
+	OpWeightMsgCdpDeposit                              = "op_weight_msg_cdp_deposit"
+	OpWeightMsgCdpWithdraw                             = "op_weight_msg_cdp_withdraw"
+	OpWeightMsgDrawDebt                                = "op_weight_msg_draw_debt"
+	OpWeightMsgRepayDebt                               = "op_weight_msg_repay_debt"
+	OpWeightMsgLiquidate                               = "op_weight_msg_liquidate"
+	OpWeightMsgDepositSavings                          = "op_weight_msg_deposit_savings"
+	OpWeightMsgWithdrawSavings                         = "op_weight_msg_withdraw_savings"
+	OpWeightMsgPlaceBid                                = "op_weight_msg_place_bid"
+	OpWeightMsgPostPrice                               = "op_weight_msg_post_price"
+	OpWeightMsgBondOracle                              = "op_weight_msg_bond_oracle"
+	OpWeightMsgUnbondOracle                            = "op_weight_msg_unbond_oracle"
+	OpWeightMsgFundRewardPool                          = "op_weight_msg_fund_reward_pool"
 )
 
 // TestMain runs setup and teardown code before all tests.
@@ -187,6 +207,28 @@ func testAndRunTxs(app *App, config simulation.Config) []simulation.WeightedOper
 			}(nil),
 			govsimops.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, paramsimops.SimulateParamChangeProposalContent(paramChanges)),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightSubmitVotingSlashingOracleProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsimops.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, pricefeedsimops.SimulateOracleProposalContent(app.pricefeedKeeper)),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightSubmitVotingSlashingCollateralProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsimops.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, cdpsimops.SimulateCollateralProposalContent(app.cdpKeeper)),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -264,6 +306,149 @@ func testAndRunTxs(app *App, config simulation.Config) []simulation.WeightedOper
 			}(nil),
 			slashingsimops.SimulateMsgUnjail(app.slashingKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgCreateCDP, &v, nil,
+					func(_ *rand.Rand) {
+						v = 100
+					})
+				return v
+			}(nil),
+			cdpsimops.SimulateMsgCreateCDP(app.accountKeeper, app.cdpKeeper, app.pricefeedKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgCdpDeposit, &v, nil,
+					func(_ *rand.Rand) {
+						v = 100
+					})
+				return v
+			}(nil),
+			cdpsimops.SimulateMsgDeposit(app.accountKeeper, app.cdpKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgCdpWithdraw, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			cdpsimops.SimulateMsgWithdraw(app.cdpKeeper, app.pricefeedKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgDrawDebt, &v, nil,
+					func(_ *rand.Rand) {
+						v = 100
+					})
+				return v
+			}(nil),
+			cdpsimops.SimulateMsgDrawDebt(app.cdpKeeper, app.pricefeedKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgRepayDebt, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			cdpsimops.SimulateMsgRepayDebt(app.accountKeeper, app.cdpKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgLiquidate, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			cdpsimops.SimulateMsgLiquidate(app.cdpKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgDepositSavings, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			cdpsimops.SimulateMsgDepositSavings(app.accountKeeper, app.cdpKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgWithdrawSavings, &v, nil,
+					func(_ *rand.Rand) {
+						v = 30
+					})
+				return v
+			}(nil),
+			cdpsimops.SimulateMsgWithdrawSavings(app.cdpKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgPlaceBid, &v, nil,
+					func(_ *rand.Rand) {
+						v = 100
+					})
+				return v
+			}(nil),
+			auctionsimops.SimulateMsgPlaceBid(app.accountKeeper, app.auctionKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgPostPrice, &v, nil,
+					func(_ *rand.Rand) {
+						v = 100
+					})
+				return v
+			}(nil),
+			pricefeedsimops.SimulateMsgPostPrice(app.pricefeedKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgBondOracle, &v, nil,
+					func(_ *rand.Rand) {
+						v = 20
+					})
+				return v
+			}(nil),
+			pricefeedsimops.SimulateMsgBondOracle(app.accountKeeper, app.pricefeedKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgUnbondOracle, &v, nil,
+					func(_ *rand.Rand) {
+						v = 10
+					})
+				return v
+			}(nil),
+			pricefeedsimops.SimulateMsgUnbondOracle(app.pricefeedKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(app.cdc, OpWeightMsgFundRewardPool, &v, nil,
+					func(_ *rand.Rand) {
+						v = 10
+					})
+				return v
+			}(nil),
+			pricefeedsimops.SimulateMsgFundRewardPool(app.accountKeeper, app.pricefeedKeeper),
+		},
 	}
 }
 
@@ -484,6 +669,7 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[supply.StoreKey], newApp.keys[supply.StoreKey], [][]byte{}},
 		{app.keys[params.StoreKey], newApp.keys[params.StoreKey], [][]byte{}},
 		{app.keys[gov.StoreKey], newApp.keys[gov.StoreKey], [][]byte{}},
+		{app.keys[auction.StoreKey], newApp.keys[auction.StoreKey], [][]byte{}},
 	}
 
 	for _, storeKeysPrefix := range storeKeysPrefixes {
@@ -10,4 +10,5 @@ func EndBlocker(ctx sdk.Context, k Keeper) {
 	if err != nil {
 		panic(err)
 	}
+	k.PruneAuctionResults(ctx)
 }
@@ -24,57 +24,97 @@ const (
 	CodeLotTooLarge                       = types.CodeLotTooLarge
 	CodeCollateralAuctionIsInReversePhase = types.CodeCollateralAuctionIsInReversePhase
 	CodeCollateralAuctionIsInForwardPhase = types.CodeCollateralAuctionIsInForwardPhase
+	CodeBidBelowPrice                     = types.CodeBidBelowPrice
 	ModuleName                            = types.ModuleName
 	StoreKey                              = types.StoreKey
 	RouterKey                             = types.RouterKey
 	DefaultParamspace                     = types.DefaultParamspace
 	DefaultMaxAuctionDuration             = types.DefaultMaxAuctionDuration
 	DefaultBidDuration                    = types.DefaultBidDuration
+	DefaultDutchStepDuration              = types.DefaultDutchStepDuration
+	DefaultDutchMaxDuration               = types.DefaultDutchMaxDuration
+	DefaultResultRetention                = types.DefaultResultRetention
 	QueryGetAuction                       = types.QueryGetAuction
 	DefaultNextAuctionID                  = types.DefaultNextAuctionID
 )
 
 var (
 	// functions aliases
-	NewSurplusAuction    = types.NewSurplusAuction
-	NewDebtAuction       = types.NewDebtAuction
-	NewCollateralAuction = types.NewCollateralAuction
-	NewWeightedAddresses = types.NewWeightedAddresses
-	RegisterCodec        = types.RegisterCodec
-	NewGenesisState      = types.NewGenesisState
-	DefaultGenesisState  = types.DefaultGenesisState
-	GetAuctionKey        = types.GetAuctionKey
-	GetAuctionByTimeKey  = types.GetAuctionByTimeKey
-	Uint64FromBytes      = types.Uint64FromBytes
-	Uint64ToBytes        = types.Uint64ToBytes
-	NewMsgPlaceBid       = types.NewMsgPlaceBid
-	NewParams            = types.NewParams
-	DefaultParams        = types.DefaultParams
-	ParamKeyTable        = types.ParamKeyTable
-	NewKeeper            = keeper.NewKeeper
-	NewQuerier           = keeper.NewQuerier
+	NewSurplusAuction         = types.NewSurplusAuction
+	NewDebtAuction            = types.NewDebtAuction
+	NewCollateralAuction      = types.NewCollateralAuction
+	NewDutchCollateralAuction = types.NewDutchCollateralAuction
+	MinForwardBid             = types.MinForwardBid
+	MaxReverseLot             = types.MaxReverseLot
+	NewWeightedAddresses      = types.NewWeightedAddresses
+	WithDefaultDebtCovered    = types.WithDefaultDebtCovered
+	RegisterCodec             = types.RegisterCodec
+	NewGenesisState           = types.NewGenesisState
+	DefaultGenesisState       = types.DefaultGenesisState
+	GetAuctionKey             = types.GetAuctionKey
+	GetAuctionByTimeKey       = types.GetAuctionByTimeKey
+	GetAuctionResultKey       = types.GetAuctionResultKey
+	GetAuctionResultByTimeKey = types.GetAuctionResultByTimeKey
+	Uint64FromBytes           = types.Uint64FromBytes
+	Uint64ToBytes             = types.Uint64ToBytes
+	NewMsgPlaceBid            = types.NewMsgPlaceBid
+	NewAuctionResult          = types.NewAuctionResult
+	NewParams                 = types.NewParams
+	NewAuctionDuration        = types.NewAuctionDuration
+	DefaultParams             = types.DefaultParams
+	ParamKeyTable             = types.ParamKeyTable
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	RegisterInvariants        = keeper.RegisterInvariants
+	AllInvariants             = keeper.AllInvariants
+	ModuleAccountInvariant    = keeper.ModuleAccountInvariant
+	ValidIndexInvariant       = keeper.ValidIndexInvariant
+	ValidAuctionInvariant     = keeper.ValidAuctionInvariant
 
 	// variable aliases
-	ModuleCdc              = types.ModuleCdc
-	AuctionKeyPrefix       = types.AuctionKeyPrefix
-	AuctionByTimeKeyPrefix = types.AuctionByTimeKeyPrefix
-	NextAuctionIDKey       = types.NextAuctionIDKey
-	KeyAuctionBidDuration  = types.KeyAuctionBidDuration
-	KeyAuctionDuration     = types.KeyAuctionDuration
+	ModuleCdc                    = types.ModuleCdc
+	AuctionKeyPrefix             = types.AuctionKeyPrefix
+	AuctionByTimeKeyPrefix       = types.AuctionByTimeKeyPrefix
+	NextAuctionIDKey             = types.NextAuctionIDKey
+	AuctionResultKeyPrefix       = types.AuctionResultKeyPrefix
+	AuctionResultByTimeKeyPrefix = types.AuctionResultByTimeKeyPrefix
+	KeyAuctionBidDuration        = types.KeyAuctionBidDuration
+	KeyAuctionDuration           = types.KeyAuctionDuration
+	KeyDutchStartPremium         = types.KeyDutchStartPremium
+	KeyDutchStepDuration         = types.KeyDutchStepDuration
+	KeyDutchStepDecay            = types.KeyDutchStepDecay
+	KeyDutchFloorPrice           = types.KeyDutchFloorPrice
+	KeyDutchMaxDuration          = types.KeyDutchMaxDuration
+	DefaultDutchStartPremium     = types.DefaultDutchStartPremium
+	DefaultDutchStepDecay        = types.DefaultDutchStepDecay
+	DefaultDutchFloorPrice       = types.DefaultDutchFloorPrice
+	KeyMinBidIncrement           = types.KeyMinBidIncrement
+	KeyMinLotDecrement           = types.KeyMinLotDecrement
+	DefaultMinBidIncrement       = types.DefaultMinBidIncrement
+	DefaultMinLotDecrement       = types.DefaultMinLotDecrement
+	KeyResultRetention           = types.KeyResultRetention
+	KeyAuctionDurations          = types.KeyAuctionDurations
+	DefaultAuctionDurations      = types.DefaultAuctionDurations
 )
 
 type (
-	Auction           = types.Auction
-	BaseAuction       = types.BaseAuction
-	SurplusAuction    = types.SurplusAuction
-	DebtAuction       = types.DebtAuction
-	CollateralAuction = types.CollateralAuction
-	WeightedAddresses = types.WeightedAddresses
-	SupplyKeeper      = types.SupplyKeeper
-	GenesisAuctions   = types.GenesisAuctions
-	GenesisAuction    = types.GenesisAuction
-	GenesisState      = types.GenesisState
-	MsgPlaceBid       = types.MsgPlaceBid
-	Params            = types.Params
-	Keeper            = keeper.Keeper
+	Auction                = types.Auction
+	Auctions               = types.Auctions
+	BaseAuction            = types.BaseAuction
+	SurplusAuction         = types.SurplusAuction
+	DebtAuction            = types.DebtAuction
+	CollateralAuction      = types.CollateralAuction
+	DutchCollateralAuction = types.DutchCollateralAuction
+	WeightedAddresses      = types.WeightedAddresses
+	SupplyKeeper           = types.SupplyKeeper
+	GenesisAuctions        = types.GenesisAuctions
+	GenesisAuction         = types.GenesisAuction
+	GenesisState           = types.GenesisState
+	MsgPlaceBid            = types.MsgPlaceBid
+	Params                 = types.Params
+	AuctionDuration        = types.AuctionDuration
+	AuctionDurations       = types.AuctionDurations
+	AuctionResult          = types.AuctionResult
+	AuctionResults         = types.AuctionResults
+	Keeper                 = keeper.Keeper
 )
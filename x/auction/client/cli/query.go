@@ -3,12 +3,16 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	"github.com/kava-labs/kava/x/auction/types"
 )
@@ -24,6 +28,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	auctionQueryCmd.AddCommand(client.GetCommands(
 		QueryGetAuctionCmd(queryRoute, cdc),
 		QueryGetAuctionsCmd(queryRoute, cdc),
+		QueryGetAuctionResultCmd(queryRoute, cdc),
+		QueryGetAuctionResultsCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
 	)...)
 
@@ -66,17 +72,46 @@ func QueryGetAuctionCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// Flags for filtering auctions queries
+const (
+	flagType        = "type"
+	flagPhase       = "phase"
+	flagLotDenom    = "lot-denom"
+	flagBidDenom    = "bid-denom"
+	flagBidder      = "bidder"
+	flagEndBefore   = "end-before"
+	flagCloseAfter  = "close-after"
+	flagCloseBefore = "close-before"
+	flagPage        = "page"
+	flagLimit       = "limit"
+)
+
 // QueryGetAuctionsCmd queries the auctions in the store
 func QueryGetAuctionsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "auctions",
 		Short: "get a list of active auctions",
-		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Get a list of active auctions, optionally filtered by auction type, phase, lot or bid denom, current bidder, and end time.
+
+Example:
+$ kvcli query auction auctions --type collateral --phase forward --lot-denom bnb --end-before 2020-01-02T15:04:05Z --page 1 --limit 50
+`),
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			// Prepare params for querier
+			params, err := queryAllAuctionParamsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
 			// Query
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAuctions), nil)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAuctions), bz)
 			if err != nil {
 				return err
 			}
@@ -92,6 +127,156 @@ func QueryGetAuctionsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(auctionsWithPhase)
 		},
 	}
+
+	cmd.Flags().String(flagType, "", "only show auctions of this type (surplus, debt, collateral, dutch_collateral)")
+	cmd.Flags().String(flagPhase, "", "only show auctions in this phase (forward, reverse)")
+	cmd.Flags().String(flagLotDenom, "", "only show auctions selling this denom")
+	cmd.Flags().String(flagBidDenom, "", "only show auctions bid on in this denom")
+	cmd.Flags().String(flagBidder, "", "only show auctions with this current bidder")
+	cmd.Flags().String(flagEndBefore, "", "only show auctions ending before this time (RFC3339)")
+	cmd.Flags().Int(flagPage, rest.DefaultPage, "page of results to show")
+	cmd.Flags().Int(flagLimit, 0, "number of auctions per page, 0 shows all")
+
+	return cmd
+}
+
+// queryAllAuctionParamsFromFlags reads the filters and pagination of an auctions query from the command's flags
+func queryAllAuctionParamsFromFlags(cmd *cobra.Command) (types.QueryAllAuctionParams, error) {
+	flags := cmd.Flags()
+	auctionType, _ := flags.GetString(flagType)
+	phase, _ := flags.GetString(flagPhase)
+	lotDenom, _ := flags.GetString(flagLotDenom)
+	bidDenom, _ := flags.GetString(flagBidDenom)
+	page, _ := flags.GetInt(flagPage)
+	limit, _ := flags.GetInt(flagLimit)
+
+	bidder, err := bidderFromFlags(cmd)
+	if err != nil {
+		return types.QueryAllAuctionParams{}, err
+	}
+	endBefore, err := timeFromFlags(cmd, flagEndBefore)
+	if err != nil {
+		return types.QueryAllAuctionParams{}, err
+	}
+	params := types.NewQueryAllAuctionParams(page, limit, auctionType, phase, lotDenom, bidDenom, bidder, endBefore)
+	return params, params.Validate()
+}
+
+// QueryGetAuctionResultCmd queries the result of one closed auction in the store
+func QueryGetAuctionResultCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "result [auction-id]",
+		Short: "get the result of a closed auction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+			bz, err := cdc.MarshalJSON(types.QueryAuctionParams{
+				AuctionID: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAuctionResult), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var result types.AuctionResult
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// QueryGetAuctionResultsCmd queries the results of closed auctions in the store
+func QueryGetAuctionResultsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "results",
+		Short: "get a list of closed auction results",
+		Long: strings.TrimSpace(`Get a list of the results of recently closed auctions, optionally filtered by winning bidder and close time.
+
+Example:
+$ kvcli query auction results --bidder kava1... --close-after 2020-01-01T00:00:00Z --close-before 2020-01-02T00:00:00Z --page 1 --limit 50
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			page, _ := cmd.Flags().GetInt(flagPage)
+			limit, _ := cmd.Flags().GetInt(flagLimit)
+			bidder, err := bidderFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			closeAfter, err := timeFromFlags(cmd, flagCloseAfter)
+			if err != nil {
+				return err
+			}
+			closeBefore, err := timeFromFlags(cmd, flagCloseBefore)
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryAuctionResultsParams(page, limit, bidder, closeAfter, closeBefore))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAuctionResults), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var results types.AuctionResults
+			cdc.MustUnmarshalJSON(res, &results)
+			return cliCtx.PrintOutput(results)
+		},
+	}
+
+	cmd.Flags().String(flagBidder, "", "only show auctions won by this bidder")
+	cmd.Flags().String(flagCloseAfter, "", "only show auctions closed at or after this time (RFC3339)")
+	cmd.Flags().String(flagCloseBefore, "", "only show auctions closed before this time (RFC3339)")
+	cmd.Flags().Int(flagPage, rest.DefaultPage, "page of results to show")
+	cmd.Flags().Int(flagLimit, 0, "number of results per page, 0 shows all")
+
+	return cmd
+}
+
+// bidderFromFlags reads the bidder filter of a query from the command's flags, returning nil if it is not set
+func bidderFromFlags(cmd *cobra.Command) (sdk.AccAddress, error) {
+	bidderStr, _ := cmd.Flags().GetString(flagBidder)
+	if bidderStr == "" {
+		return nil, nil
+	}
+	bidder, err := sdk.AccAddressFromBech32(bidderStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bidder address %s: %s", bidderStr, err)
+	}
+	return bidder, nil
+}
+
+// timeFromFlags reads an RFC3339 time filter of a query from the command's flags, returning the zero time if it is not set
+func timeFromFlags(cmd *cobra.Command, flag string) (time.Time, error) {
+	timeStr, _ := cmd.Flags().GetString(flag)
+	if timeStr == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, timeStr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s time %s: %s", flag, timeStr, err)
+	}
+	return t, nil
 }
 
 // QueryParamsCmd queries the auction module parameters
@@ -20,9 +20,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 
 	totalAuctionCoins := sdk.NewCoins()
 	for _, a := range gs.Auctions {
+		a = types.WithDefaultDebtCovered(a)
 		keeper.SetAuction(ctx, a)
 		// find the total coins that should be present in the module account
-		totalAuctionCoins.Add(a.GetModuleAccountCoins())
+		totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins())
+	}
+
+	for _, r := range gs.Results {
+		keeper.SetAuctionResult(ctx, r)
 	}
 
 	// check if the module account exists
@@ -33,7 +38,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	// check module coins match auction coins
 	// Note: Other sdk modules do not check this, instead just using the existing module account coins, or if zero, setting them.
 	if !moduleAcc.GetCoins().IsEqual(totalAuctionCoins) {
-		panic(fmt.Sprintf("total auction coins (%s) do not equal (%s) module account (%s) ", moduleAcc.GetCoins(), ModuleName, totalAuctionCoins))
+		panic(fmt.Sprintf("total auction coins (%s) do not equal (%s) module account (%s) ", totalAuctionCoins, ModuleName, moduleAcc.GetCoins()))
 	}
 }
 
@@ -56,5 +61,11 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		return false
 	})
 
-	return NewGenesisState(nextAuctionID, params, genAuctions)
+	results := AuctionResults{} // return empty list instead of nil if no results
+	keeper.IterateAuctionResults(ctx, func(r AuctionResult) bool {
+		results = append(results, r)
+		return false
+	})
+
+	return NewGenesisState(nextAuctionID, params, genAuctions, results)
 }
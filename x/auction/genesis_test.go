@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -22,6 +23,7 @@ var testAuction = auction.NewCollateralAuction(
 	auction.WeightedAddresses{},
 	c("debt", 1000),
 ).WithID(3).(auction.GenesisAuction)
+var testResult = auction.NewAuctionResult(testAuction.WithID(2), testTime)
 
 func TestInitGenesis(t *testing.T) {
 	t.Run("valid", func(t *testing.T) {
@@ -29,16 +31,22 @@ func TestInitGenesis(t *testing.T) {
 		tApp := app.NewTestApp()
 		keeper := tApp.GetAuctionKeeper()
 		ctx := tApp.NewContext(true, abci.Header{})
+		// fund the module account with the auction's coins
+		sk := tApp.GetSupplyKeeper()
+		macc := sk.GetModuleAccount(ctx, auction.ModuleName)
+		require.NoError(t, macc.SetCoins(testAuction.GetModuleAccountCoins()))
+		sk.SetModuleAccount(ctx, macc)
 		// create genesis
 		gs := auction.NewGenesisState(
 			10,
 			auction.DefaultParams(),
 			auction.GenesisAuctions{testAuction},
+			auction.AuctionResults{testResult},
 		)
 
 		// run init
 		require.NotPanics(t, func() {
-			auction.InitGenesis(ctx, keeper, tApp.GetSupplyKeeper(), gs)
+			auction.InitGenesis(ctx, keeper, sk, gs)
 		})
 
 		// check state is as expected
@@ -58,6 +66,32 @@ func TestInitGenesis(t *testing.T) {
 			i++
 			return false
 		})
+		r, found := keeper.GetAuctionResult(ctx, testResult.ID)
+		require.True(t, found)
+		require.Equal(t, testResult, r)
+	})
+	t.Run("missing debt covered", func(t *testing.T) {
+		// setup keepers
+		tApp := app.NewTestApp()
+		keeper := tApp.GetAuctionKeeper()
+		ctx := tApp.NewContext(true, abci.Header{})
+		sk := tApp.GetSupplyKeeper()
+		macc := sk.GetModuleAccount(ctx, auction.ModuleName)
+		require.NoError(t, macc.SetCoins(testAuction.GetModuleAccountCoins()))
+		sk.SetModuleAccount(ctx, macc)
+		// create genesis with an auction exported before the debt covered was recorded
+		oldAuction := testAuction.(auction.CollateralAuction)
+		oldAuction.DebtCovered = sdk.Coin{}
+		gs := auction.NewGenesisState(10, auction.DefaultParams(), auction.GenesisAuctions{oldAuction}, auction.AuctionResults{})
+
+		require.NotPanics(t, func() {
+			auction.InitGenesis(ctx, keeper, sk, gs)
+		})
+
+		// the debt covered is set to zero of the debt denom
+		a, found := keeper.GetAuction(ctx, oldAuction.GetID())
+		require.True(t, found)
+		require.Equal(t, c("debt", 0), a.(auction.CollateralAuction).DebtCovered)
 	})
 	t.Run("invalid", func(t *testing.T) {
 		// setup keepers
@@ -69,6 +103,25 @@ func TestInitGenesis(t *testing.T) {
 			0, // next id < testAuction ID
 			auction.DefaultParams(),
 			auction.GenesisAuctions{testAuction},
+			auction.AuctionResults{},
+		)
+
+		// check init fails
+		require.Panics(t, func() {
+			auction.InitGenesis(ctx, tApp.GetAuctionKeeper(), tApp.GetSupplyKeeper(), gs)
+		})
+	})
+	t.Run("invalid (module account not funded)", func(t *testing.T) {
+		// setup keepers
+		tApp := app.NewTestApp()
+		ctx := tApp.NewContext(true, abci.Header{})
+
+		// create genesis with an auction whose coins are not held by the module account
+		gs := auction.NewGenesisState(
+			10,
+			auction.DefaultParams(),
+			auction.GenesisAuctions{testAuction},
+			auction.AuctionResults{},
 		)
 
 		// check init fails
@@ -106,4 +159,19 @@ func TestExportGenesis(t *testing.T) {
 		expectedGenesisState.Auctions = append(expectedGenesisState.Auctions, testAuction)
 		require.Equal(t, expectedGenesisState, gs)
 	})
+	t.Run("one result", func(t *testing.T) {
+		// setup state
+		tApp := app.NewTestApp()
+		ctx := tApp.NewContext(true, abci.Header{})
+		tApp.InitializeFromGenesisStates()
+		tApp.GetAuctionKeeper().SetAuctionResult(ctx, testResult)
+
+		// export
+		gs := auction.ExportGenesis(ctx, tApp.GetAuctionKeeper())
+
+		// check state matches
+		expectedGenesisState := auction.DefaultGenesisState()
+		expectedGenesisState.Results = append(expectedGenesisState.Results, testResult)
+		require.Equal(t, expectedGenesisState, gs)
+	})
 }
@@ -119,6 +119,59 @@ func (k Keeper) StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.C
 	return auctionID, nil
 }
 
+// StartDutchCollateralAuction starts a new dutch collateral (descending price) auction.
+// The price of one unit of lot starts at the reference price, in units of the maxBid denom, plus the dutch start premium param,
+// and decays by the dutch step decay param every dutch step duration, down to the dutch floor price param times the reference price.
+// The auction ends after the dutch max duration param, when it restarts if it is unsold.
+func (k Keeper) StartDutchCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, referencePrice sdk.Dec, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, sdk.Error) {
+
+	if referencePrice.IsNil() || !referencePrice.IsPositive() {
+		return 0, sdk.ErrInternal("reference price must be positive")
+	}
+	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
+	if err != nil {
+		return 0, err
+	}
+	params := k.GetParams(ctx)
+	auction := types.NewDutchCollateralAuction(
+		seller,
+		lot,
+		ctx.BlockTime().Add(params.DutchMaxDuration),
+		maxBid,
+		weightedAddresses,
+		debt,
+		ctx.BlockTime(),
+		referencePrice.Mul(sdk.OneDec().Add(params.DutchStartPremium)),
+		params.DutchStepDuration,
+		params.DutchStepDecay,
+		referencePrice.Mul(params.DutchFloorPrice))
+
+	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
+	if err != nil {
+		return 0, err
+	}
+	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(debt))
+	if err != nil {
+		return 0, err
+	}
+
+	auctionID, err := k.StoreNewAuction(ctx, auction)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.GetID())),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBidDenom, auction.Bid.Denom),
+			sdk.NewAttribute(types.AttributeKeyLotDenom, auction.Lot.Denom),
+		),
+	)
+	return auctionID, nil
+}
+
 // PlaceBid places a bid on any auction.
 func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) sdk.Error {
 
@@ -153,12 +206,21 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		if err != nil {
 			return err
 		}
+	case types.DutchCollateralAuction:
+		if updatedAuction, err = k.PlaceBidDutchCollateral(ctx, a, bidder, newAmount); err != nil {
+			return err
+		}
 	default:
 		return types.ErrUnrecognizedAuctionType(k.codespace)
 	}
 
 	k.SetAuction(ctx, updatedAuction)
 
+	// dutch auctions are won by the first accepted bid, so close immediately
+	if _, ok := updatedAuction.(types.DutchCollateralAuction); ok {
+		return k.CloseAuction(ctx, auctionID)
+	}
+
 	return nil
 }
 
@@ -168,8 +230,9 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, a types.SurplusAuction, bidder
 	if bid.Denom != a.Bid.Denom {
 		return a, types.ErrInvalidBidDenom(k.codespace, bid.Denom, a.Bid.Denom)
 	}
-	if !a.Bid.IsLT(bid) {
-		return a, types.ErrBidTooSmall(k.codespace, bid, a.Bid)
+	minBid := sdk.NewCoin(a.Bid.Denom, types.MinForwardBid(a.Bid.Amount, k.GetParams(ctx).MinBidIncrement))
+	if bid.IsLT(minBid) {
+		return a, types.ErrBidTooSmall(k.codespace, bid, minBid)
 	}
 
 	// New bidder pays back old bidder
@@ -197,10 +260,11 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, a types.SurplusAuction, bidder
 	// Update Auction
 	a.Bidder = bidder
 	a.Bid = bid
+	maxAuctionDuration, bidDuration := k.GetParams(ctx).DurationsFor(a.GetType(), a.Lot.Denom)
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(maxAuctionDuration) // set maximum ending time on receipt of first bid
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.EndTime = earliestTime(ctx.BlockTime().Add(bidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -225,8 +289,10 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	if a.IsReversePhase() {
 		return a, types.ErrCollateralAuctionIsInReversePhase(k.codespace, a.ID)
 	}
-	if !a.Bid.IsLT(bid) {
-		return a, types.ErrBidTooSmall(k.codespace, bid, a.Bid)
+	// bidding the max bid is always allowed, even if it is less than the minimum increment
+	minBid := sdk.NewCoin(a.Bid.Denom, sdk.MinInt(types.MinForwardBid(a.Bid.Amount, k.GetParams(ctx).MinBidIncrement), a.MaxBid.Amount))
+	if bid.IsLT(minBid) {
+		return a, types.ErrBidTooSmall(k.codespace, bid, minBid)
 	}
 	if a.MaxBid.IsLT(bid) {
 		return a, types.ErrBidTooLarge(k.codespace, bid, a.MaxBid)
@@ -261,15 +327,17 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, a types.CollateralAuc
 			return a, err
 		}
 		a.CorrespondingDebt = a.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ a.CorrespondingDebt from the MinInt above
+		a.DebtCovered = a.DebtCovered.Add(debtToReturn)
 	}
 
 	// Update Auction
 	a.Bidder = bidder
 	a.Bid = bid
+	maxAuctionDuration, bidDuration := k.GetParams(ctx).DurationsFor(a.GetType(), a.Lot.Denom)
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(maxAuctionDuration) // set maximum ending time on receipt of first bid
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.EndTime = earliestTime(ctx.BlockTime().Add(bidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -294,8 +362,9 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	if !a.IsReversePhase() {
 		return a, types.ErrCollateralAuctionIsInForwardPhase(k.codespace, a.ID)
 	}
-	if !lot.IsLT(a.Lot) {
-		return a, types.ErrLotTooLarge(k.codespace, lot, a.Lot)
+	maxLot := sdk.NewCoin(a.Lot.Denom, types.MaxReverseLot(a.Lot.Amount, k.GetParams(ctx).MinLotDecrement))
+	if !lot.IsLT(a.Lot) || maxLot.IsLT(lot) {
+		return a, types.ErrLotTooLarge(k.codespace, lot, maxLot)
 	}
 
 	// New bidder pays back old bidder
@@ -326,10 +395,76 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	// Update Auction
 	a.Bidder = bidder
 	a.Lot = lot
+	maxAuctionDuration, bidDuration := k.GetParams(ctx).DurationsFor(a.GetType(), a.Lot.Denom)
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(maxAuctionDuration) // set maximum ending time on receipt of first bid
+	}
+	a.EndTime = earliestTime(ctx.BlockTime().Add(bidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.HasReceivedBids = true
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", a.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, a.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyLotAmount, a.Lot.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
+		),
+	)
+
+	return a, nil
+}
+
+// PlaceBidDutchCollateral accepts the current price of a dutch collateral auction, moving coins and returning the updated auction.
+// The bid is the most the bidder is willing to pay, the bidder only pays the current cost of the lot.
+func (k Keeper) PlaceBidDutchCollateral(ctx sdk.Context, a types.DutchCollateralAuction, bidder sdk.AccAddress, bid sdk.Coin) (types.DutchCollateralAuction, sdk.Error) {
+	// Validate new bid
+	if bid.Denom != a.Bid.Denom {
+		return a, types.ErrInvalidBidDenom(k.codespace, bid.Denom, a.Bid.Denom)
+	}
+	cost, lot := a.SaleAt(ctx.BlockTime())
+	if bid.IsLT(cost) {
+		return a, types.ErrBidBelowPrice(k.codespace, bid, cost)
+	}
+
+	// Cost sent to auction initiator
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, a.Initiator, sdk.NewCoins(cost))
+	if err != nil {
+		return a, err
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	// Debt coins are sent to liquidator. Amount sent is equal to cost (or whatever is left if < cost).
+	if a.CorrespondingDebt.IsPositive() {
+
+		debtAmountToReturn := sdk.MinInt(cost.Amount, a.CorrespondingDebt.Amount)
+		debtToReturn := sdk.NewCoin(a.CorrespondingDebt.Denom, debtAmountToReturn)
+
+		err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return a, err
+		}
+		a.CorrespondingDebt = a.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ a.CorrespondingDebt from the MinInt above
+		a.DebtCovered = a.DebtCovered.Add(debtToReturn)
+	}
+	// Lot that is not needed to raise the max bid is sent to weighted addresses (normally the CDP depositors)
+	if lot.IsLT(a.Lot) {
+		lotPayouts, err := splitCoinIntoWeightedBuckets(a.Lot.Sub(lot), a.LotReturns.Weights)
+		if err != nil {
+			return a, err
+		}
+		for i, payout := range lotPayouts {
+			err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.LotReturns.Addresses[i], sdk.NewCoins(payout))
+			if err != nil {
+				return a, err
+			}
+		}
+	}
+
+	// Update Auction
+	a.Bidder = bidder
+	a.Bid = cost
+	a.Lot = lot
+	a.MaxEndTime = ctx.BlockTime() // the first accepted bid wins the auction
+	a.EndTime = ctx.BlockTime()
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -337,6 +472,7 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, a types.CollateralAuc
 			types.EventTypeAuctionBid,
 			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", a.ID)),
 			sdk.NewAttribute(types.AttributeKeyBidder, a.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBidAmount, a.Bid.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyLotAmount, a.Lot.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
 		),
@@ -351,8 +487,9 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, a types.DebtAuction, bidder sdk.Ac
 	if lot.Denom != a.Lot.Denom {
 		return a, types.ErrInvalidLotDenom(k.codespace, lot.Denom, a.Lot.Denom)
 	}
-	if !lot.IsLT(a.Lot) {
-		return a, types.ErrLotTooLarge(k.codespace, lot, a.Lot)
+	maxLot := sdk.NewCoin(a.Lot.Denom, types.MaxReverseLot(a.Lot.Amount, k.GetParams(ctx).MinLotDecrement))
+	if !lot.IsLT(a.Lot) || maxLot.IsLT(lot) {
+		return a, types.ErrLotTooLarge(k.codespace, lot, maxLot)
 	}
 
 	// New bidder pays back old bidder
@@ -378,15 +515,17 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, a types.DebtAuction, bidder sdk.Ac
 			return a, err
 		}
 		a.CorrespondingDebt = a.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ a.CorrespondingDebt from the MinInt above
+		a.DebtCovered = a.DebtCovered.Add(debtToReturn)
 	}
 
 	// Update Auction
 	a.Bidder = bidder
 	a.Lot = lot
+	maxAuctionDuration, bidDuration := k.GetParams(ctx).DurationsFor(a.GetType(), a.Lot.Denom)
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(maxAuctionDuration) // set maximum ending time on receipt of first bid
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.EndTime = earliestTime(ctx.BlockTime().Add(bidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -428,10 +567,20 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) sdk.Error {
 		if err := k.PayoutCollateralAuction(ctx, auc); err != nil {
 			return err
 		}
+	case types.DutchCollateralAuction:
+		// unsold dutch auctions have no winner to pay out to, so they restart instead of closing
+		if !auc.HasReceivedBids {
+			k.RestartDutchCollateralAuction(ctx, auc)
+			return nil
+		}
+		if err := k.PayoutDutchCollateralAuction(ctx, auc); err != nil {
+			return err
+		}
 	default:
 		return types.ErrUnrecognizedAuctionType(k.codespace)
 	}
 
+	k.SetAuctionResult(ctx, types.NewAuctionResult(auction, ctx.BlockTime()))
 	k.DeleteAuction(ctx, auctionID)
 
 	ctx.EventManager().EmitEvent(
@@ -486,6 +635,38 @@ func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, a types.CollateralAucti
 	return nil
 }
 
+// PayoutDutchCollateralAuction pays out the proceeds for a dutch collateral auction.
+func (k Keeper) PayoutDutchCollateralAuction(ctx sdk.Context, a types.DutchCollateralAuction) sdk.Error {
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(a.Lot))
+	if err != nil {
+		return err
+	}
+	if a.CorrespondingDebt.IsPositive() {
+		err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(a.CorrespondingDebt))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestartDutchCollateralAuction restarts the price decay of an unsold dutch collateral auction from its start price,
+// and extends its end time by the dutch max duration param.
+func (k Keeper) RestartDutchCollateralAuction(ctx sdk.Context, a types.DutchCollateralAuction) {
+	a.StartTime = ctx.BlockTime()
+	a.EndTime = ctx.BlockTime().Add(k.GetParams(ctx).DutchMaxDuration)
+	a.MaxEndTime = a.EndTime
+	k.SetAuction(ctx, a)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionRestart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", a.ID)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
+		),
+	)
+}
+
 // CloseExpiredAuctions finds all auctions that are past (or at) their ending times and closes them, paying out to the highest bidder.
 func (k Keeper) CloseExpiredAuctions(ctx sdk.Context) sdk.Error {
 	var expiredAuctions []uint64
@@ -502,6 +683,19 @@ func (k Keeper) CloseExpiredAuctions(ctx sdk.Context) sdk.Error {
 	return nil
 }
 
+// PruneAuctionResults deletes the results of auctions that closed more than the ResultRetention param ago.
+func (k Keeper) PruneAuctionResults(ctx sdk.Context) {
+	cutoff := ctx.BlockTime().Add(-k.GetParams(ctx).ResultRetention)
+	var expiredResults []uint64
+	k.IterateAuctionResultsByTime(ctx, cutoff, func(id uint64) bool {
+		expiredResults = append(expiredResults, id)
+		return false
+	})
+	for _, id := range expiredResults {
+		k.DeleteAuctionResult(ctx, id)
+	}
+}
+
 // earliestTime returns the earliest of two times.
 func earliestTime(t1, t2 time.Time) time.Time {
 	if t1.Before(t2) {
@@ -517,6 +711,9 @@ func splitCoinIntoWeightedBuckets(coin sdk.Coin, buckets []sdk.Int) ([]sdk.Coin,
 			return nil, sdk.ErrInternal("cannot split coin into bucket with negative weight")
 		}
 	}
+	if totalInts(buckets...).IsZero() {
+		return nil, sdk.ErrInternal("cannot split coin into buckets with zero total weight")
+	}
 	amounts := splitIntIntoWeightedBuckets(coin.Amount, buckets)
 	result := make([]sdk.Coin, len(amounts))
 	for i, a := range amounts {
@@ -200,6 +200,14 @@ func TestCollateralAuctionBasic(t *testing.T) {
 	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
 	// Check buyer's coins increased
 	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 115), c("token2", 50)))
+	// Check the result is recorded
+	result, found := keeper.GetAuctionResult(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, c("token1", 15), result.Lot)
+	require.Equal(t, c("token2", 50), result.Bid)
+	require.Equal(t, buyer, result.Bidder)
+	require.Equal(t, cs(c("debt", 40)), result.DebtCovered)
+	require.Equal(t, ctx.BlockTime(), result.CloseTime)
 }
 
 func TestCollateralAuctionDebtRemaining(t *testing.T) {
@@ -253,6 +261,148 @@ func TestCollateralAuctionDebtRemaining(t *testing.T) {
 	}
 	// check that token2 has increased by 10, debt by 40, for a net debt increase of 30 debt
 	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 100)))
+	// check the result records only the debt covered by the bid
+	result, found := keeper.GetAuctionResult(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, cs(c("debt", 10)), result.DebtCovered)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), result.Price())
+}
+
+func TestDutchCollateralAuctionBasic(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[1], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[2], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start auction at a reference price of 2 token2 per token1, so the lot starts at 20 * 2 * 1.2 = 48 token2
+	auctionID, err := keeper.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 20), d("2.0"), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	require.NoError(t, err)
+	// Check seller's coins have decreased
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
+	// Check bids below the current price are rejected
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 47)))
+
+	// After ten steps the lot costs ceil(48 * 0.99^10) = 44 token2
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * types.DefaultDutchStepDuration))
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 43)))
+	// Accept the price with a bid above it
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 50)))
+
+	// Check auction closed immediately
+	_, found := keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	// Check bidder paid the current price and received the lot
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 120), c("token2", 56)))
+	// Check seller received the proceeds and all the debt
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 144), c("debt", 100)))
+	// Check return addresses have not received coins
+	for _, ra := range returnAddrs {
+		tApp.CheckBalance(t, ctx, ra, cs(c("token1", 100), c("token2", 100)))
+	}
+}
+
+func TestDutchCollateralAuctionMaxBid(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[1], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[2], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start auction at a reference price of 5 token2 per token1, so the lot starts at a price of 6, worth more than the max bid
+	auctionID, err := keeper.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 20), d("5.0"), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	require.NoError(t, err)
+
+	// Accept the price, paying the max bid for floor(50 / 6) = 8 token1
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 50)))
+	_, found := keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 108), c("token2", 50)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 150), c("debt", 100)))
+	// Check the unsold lot was returned by weight
+	tApp.CheckBalance(t, ctx, returnAddrs[0], cs(c("token1", 106), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[1], cs(c("token1", 104), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[2], cs(c("token1", 102), c("token2", 100)))
+}
+
+func TestDutchCollateralAuctionFloorAndRestart(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+	startTime := ctx.BlockTime()
+
+	// Start auction at a reference price of 2 token2 per token1, so the floor is 20 * 2 * 0.5 = 20 token2 for the lot
+	auctionID, err := keeper.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 20), d("2.0"), c("token2", 50), returnAddrs, is(1), c("debt", 40))
+	require.NoError(t, err)
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, startTime.Add(types.DefaultDutchMaxDuration), auction.GetEndTime())
+
+	// Just before the end time the price has decayed to the floor, but no further
+	ctx = ctx.WithBlockTime(startTime.Add(types.DefaultDutchMaxDuration - time.Second))
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 19)))
+
+	// The unsold auction restarts from its start price at the end time, rather than closing
+	ctx = ctx.WithBlockTime(startTime.Add(types.DefaultDutchMaxDuration))
+	require.NoError(t, keeper.CloseExpiredAuctions(ctx))
+	auction, found = keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime(), auction.(types.DutchCollateralAuction).StartTime)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultDutchMaxDuration), auction.GetEndTime())
+	_, found = keeper.GetAuctionResult(ctx, auctionID)
+	require.False(t, found)
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 47)))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 48)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 148), c("debt", 100)))
 }
 
 func TestStartSurplusAuction(t *testing.T) {
@@ -407,3 +557,120 @@ func TestCloseExpiredAuctions(t *testing.T) {
 	err = keeper.CloseExpiredAuctions(ctx)
 	require.NoError(t, err)
 }
+
+func TestPruneAuctionResults(t *testing.T) {
+	// Set up
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	buyer := addrs[0]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner) // forward auctions burn proceeds
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start, bid on, and close an auction
+	id, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2") // lot, bid denom
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlaceBid(ctx, id, buyer, c("token2", 10)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseExpiredAuctions(ctx))
+	_, found := keeper.GetAuction(ctx, id)
+	require.False(t, found)
+	_, found = keeper.GetAuctionResult(ctx, id)
+	require.True(t, found)
+
+	// Result is kept until the retention window has passed
+	closeTime := ctx.BlockTime()
+	ctx = ctx.WithBlockTime(closeTime.Add(types.DefaultResultRetention).Add(-1))
+	keeper.PruneAuctionResults(ctx)
+	_, found = keeper.GetAuctionResult(ctx, id)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(closeTime.Add(types.DefaultResultRetention))
+	keeper.PruneAuctionResults(ctx)
+	_, found = keeper.GetAuctionResult(ctx, id)
+	require.False(t, found)
+	keeper.IterateAuctionResultsByTime(ctx, ctx.BlockTime(), func(uint64) bool {
+		t.Fatal("pruned result left in byTime index")
+		return true
+	})
+}
+
+func TestAuctionDurationOverrides(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Minter, supply.Burner) // debt auctions mint their lot
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Override the durations for collateral auctions, and for surplus auctions of token1
+	params := keeper.GetParams(ctx)
+	params.AuctionDurations = types.AuctionDurations{
+		types.NewAuctionDuration(types.CollateralAuctionType, "", 6*time.Hour, 10*time.Minute),
+		types.NewAuctionDuration(types.SurplusAuctionType, "token1", 7*24*time.Hour, 6*time.Hour),
+	}
+	keeper.SetParams(ctx, params)
+
+	// Start auctions
+	collateralID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, is(1), c("debt", 40))
+	require.NoError(t, err)
+	surplusID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2")
+	require.NoError(t, err)
+	otherSurplusID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token2", 20), "token1")
+	require.NoError(t, err)
+	debtID, err := keeper.StartDebtAuction(ctx, sellerModName, c("token1", 10), c("token2", 100), c("debt", 10))
+	require.NoError(t, err)
+
+	// Bid on the auctions and check their end times use the durations for their type and lot denom
+	require.NoError(t, keeper.PlaceBid(ctx, collateralID, buyer, c("token2", 10)))
+	require.NoError(t, keeper.PlaceBid(ctx, surplusID, buyer, c("token2", 10)))
+	require.NoError(t, keeper.PlaceBid(ctx, otherSurplusID, buyer, c("token1", 10)))
+
+	collateralAuction, found := keeper.GetAuction(ctx, collateralID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(10*time.Minute), collateralAuction.GetEndTime())
+	require.Equal(t, ctx.BlockTime().Add(6*time.Hour), collateralAuction.(types.CollateralAuction).MaxEndTime)
+
+	surplusAuction, found := keeper.GetAuction(ctx, surplusID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(6*time.Hour), surplusAuction.GetEndTime())
+	require.Equal(t, ctx.BlockTime().Add(7*24*time.Hour), surplusAuction.(types.SurplusAuction).MaxEndTime)
+
+	otherSurplusAuction, found := keeper.GetAuction(ctx, otherSurplusID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultBidDuration), otherSurplusAuction.GetEndTime())
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultMaxAuctionDuration), otherSurplusAuction.(types.SurplusAuction).MaxEndTime)
+
+	// Param changes apply to running auctions
+	params.AuctionDurations = types.AuctionDurations{
+		types.NewAuctionDuration(types.DebtAuctionType, "", 12*time.Hour, 30*time.Minute),
+	}
+	keeper.SetParams(ctx, params)
+	require.NoError(t, keeper.PlaceBid(ctx, debtID, buyer, c("token2", 90)))
+
+	debtAuction, found := keeper.GetAuction(ctx, debtID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(30*time.Minute), debtAuction.GetEndTime())
+	require.Equal(t, ctx.BlockTime().Add(12*time.Hour), debtAuction.(types.DebtAuction).MaxEndTime)
+}
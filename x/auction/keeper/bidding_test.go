@@ -13,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction/keeper"
 	"github.com/kava-labs/kava/x/auction/types"
 )
 
@@ -346,3 +347,65 @@ func TestAuctionBidding(t *testing.T) {
 		})
 	}
 }
+
+func TestAuctionBidIncrements(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	buyer := addrs[0]
+	secondBuyer := addrs[1]
+	returnAddrs := addrs[2:]
+	modName := "liquidator"
+
+	setup := func() (sdk.Context, keeper.Keeper) {
+		tApp := app.NewTestApp()
+		sellerAcc := supply.NewEmptyModuleAccount(modName, supply.Minter, supply.Burner)
+		require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 1000), c("token2", 1000), c("debt", 1000))))
+		tApp.InitializeFromGenesisStates(
+			NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+				auth.NewBaseAccount(buyer, cs(c("token1", 1000), c("token2", 1000)), nil, 0, 0),
+				auth.NewBaseAccount(secondBuyer, cs(c("token1", 1000), c("token2", 1000)), nil, 0, 0),
+				auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 1000), c("token2", 1000)), nil, 0, 0),
+				sellerAcc,
+			}),
+		)
+		return tApp.NewContext(false, abci.Header{}), tApp.GetAuctionKeeper()
+	}
+
+	t.Run("surplus", func(t *testing.T) {
+		ctx, k := setup()
+		id, err := k.StartSurplusAuction(ctx, modName, c("token1", 100), "token2")
+		require.NoError(t, err)
+		require.NoError(t, k.PlaceBid(ctx, id, buyer, c("token2", 100)))
+		// bids must increase by the default 5%
+		err = k.PlaceBid(ctx, id, secondBuyer, c("token2", 104))
+		require.Equal(t, types.CodeBidTooSmall, err.Result().Code)
+		require.Contains(t, err.Error(), c("token2", 105).String())
+		require.NoError(t, k.PlaceBid(ctx, id, secondBuyer, c("token2", 105)))
+	})
+
+	t.Run("debt", func(t *testing.T) {
+		ctx, k := setup()
+		id, err := k.StartDebtAuction(ctx, modName, c("token2", 100), c("token1", 100), c("debt", 100))
+		require.NoError(t, err)
+		// lots must decrease by the default 5%
+		err = k.PlaceBid(ctx, id, buyer, c("token1", 96))
+		require.Equal(t, types.CodeLotTooLarge, err.Result().Code)
+		require.Contains(t, err.Error(), c("token1", 95).String())
+		require.NoError(t, k.PlaceBid(ctx, id, buyer, c("token1", 95)))
+	})
+
+	t.Run("collateral", func(t *testing.T) {
+		ctx, k := setup()
+		id, err := k.StartCollateralAuction(ctx, modName, c("token1", 100), c("token2", 100), returnAddrs, is(1), c("debt", 100))
+		require.NoError(t, err)
+		require.NoError(t, k.PlaceBid(ctx, id, buyer, c("token2", 90)))
+		err = k.PlaceBid(ctx, id, secondBuyer, c("token2", 94))
+		require.Equal(t, types.CodeBidTooSmall, err.Result().Code)
+		require.NoError(t, k.PlaceBid(ctx, id, secondBuyer, c("token2", 96)))
+		// the max bid can always be bid, even when it is less than the minimum increment
+		require.NoError(t, k.PlaceBid(ctx, id, buyer, c("token2", 100)))
+		// reverse phase lots must decrease by the default 5%
+		err = k.PlaceBid(ctx, id, secondBuyer, c("token1", 96))
+		require.Equal(t, types.CodeLotTooLarge, err.Result().Code)
+		require.NoError(t, k.PlaceBid(ctx, id, secondBuyer, c("token1", 95)))
+	})
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// RegisterInvariants registers all auction invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-index", ValidIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-auctions", ValidAuctionInvariant(k))
+}
+
+// AllInvariants runs all invariants of the auction module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, broken := ModuleAccountInvariant(k)(ctx)
+		if broken {
+			return res, broken
+		}
+		res, broken = ValidIndexInvariant(k)(ctx)
+		if broken {
+			return res, broken
+		}
+		return ValidAuctionInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the coins held by the auction module account equal the sum of the coins held by all auctions
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		totalAuctionCoins := sdk.NewCoins()
+		k.IterateAuctions(ctx, func(a types.Auction) bool {
+			ga, ok := a.(types.GenesisAuction)
+			if !ok {
+				broken = true
+				msg += fmt.Sprintf("\tauction %d could not be converted to GenesisAuction type\n", a.GetID())
+				return false
+			}
+			totalAuctionCoins = totalAuctionCoins.Add(ga.GetModuleAccountCoins())
+			return false
+		})
+		moduleCoins := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+		if !moduleCoins.IsEqual(totalAuctionCoins) {
+			broken = true
+			msg += fmt.Sprintf("\tauction module account balance: %s, sum of auction coins: %s\n", moduleCoins, totalAuctionCoins)
+		}
+		return sdk.FormatInvariant(types.ModuleName, "module account", msg), broken
+	}
+}
+
+// ValidIndexInvariant checks that every entry in the by-time index refers to an existing auction with a matching end time
+func ValidIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionByTimeKeyPrefix)
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			id := types.Uint64FromBytes(iterator.Value())
+			a, found := k.GetAuction(ctx, id)
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("\tauction %d is indexed but does not exist\n", id)
+				continue
+			}
+			if !bytes.Equal(iterator.Key(), types.GetAuctionByTimeKey(a.GetEndTime(), id)) {
+				broken = true
+				msg += fmt.Sprintf("\tauction %d is indexed under a different end time than %s\n", id, a.GetEndTime())
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "valid index", msg), broken
+	}
+}
+
+// ValidAuctionInvariant checks that no auction has an end time after its max end time
+func ValidAuctionInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		k.IterateAuctions(ctx, func(a types.Auction) bool {
+			ga, ok := a.(types.GenesisAuction)
+			if !ok {
+				broken = true
+				msg += fmt.Sprintf("\tauction %d could not be converted to GenesisAuction type\n", a.GetID())
+				return false
+			}
+			if err := ga.Validate(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tauction %d is invalid: %s\n", a.GetID(), err)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "valid auctions", msg), broken
+	}
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction/keeper"
+	"github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp"
+)
+
+type InvariantTestSuite struct {
+	suite.Suite
+
+	keeper    keeper.Keeper
+	app       app.TestApp
+	ctx       sdk.Context
+	addrs     []sdk.AccAddress
+	auctionID uint64
+}
+
+func (suite *InvariantTestSuite) SetupTest() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(cdp.LiquidatorMacc, supply.Minter, supply.Burner)
+	suite.NoError(sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(addrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetAuctionKeeper()
+	suite.ctx = tApp.NewContext(false, abci.Header{Height: 1, Time: time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)})
+	suite.addrs = addrs
+
+	auctionID, err := suite.keeper.StartSurplusAuction(suite.ctx, cdp.LiquidatorMacc, c("token1", 20), "token2")
+	suite.NoError(err)
+	suite.auctionID = auctionID
+}
+
+func (suite *InvariantTestSuite) TestInvariantsHold() {
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	suite.NoError(suite.keeper.PlaceBid(suite.ctx, suite.auctionID, suite.addrs[0], c("token2", 10)))
+	_, err := suite.keeper.StartDebtAuction(suite.ctx, cdp.LiquidatorMacc, c("token2", 10), c("token1", 50), c("debt", 10))
+	suite.NoError(err)
+	_, broken = keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.DefaultBidDuration))
+	suite.NoError(suite.keeper.CloseAuction(suite.ctx, suite.auctionID))
+	_, broken = keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+}
+
+func (suite *InvariantTestSuite) TestModuleAccountInvariant() {
+	sk := suite.app.GetSupplyKeeper()
+	suite.NoError(sk.SendCoinsFromAccountToModule(suite.ctx, suite.addrs[0], types.ModuleName, cs(c("token1", 1))))
+	_, broken := keeper.ModuleAccountInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *InvariantTestSuite) TestValidIndexInvariant() {
+	suite.keeper.InsertIntoByTimeIndex(suite.ctx, suite.ctx.BlockTime(), 100)
+	_, broken := keeper.ValidIndexInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *InvariantTestSuite) TestValidAuctionInvariant() {
+	a, found := suite.keeper.GetAuction(suite.ctx, suite.auctionID)
+	suite.True(found)
+	sa := a.(types.SurplusAuction)
+	sa.EndTime = sa.MaxEndTime.Add(time.Hour)
+	suite.keeper.SetAuction(suite.ctx, sa)
+	_, broken := keeper.ValidAuctionInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
+
+func TestInvariantTestSuite(t *testing.T) {
+	suite.Run(t, new(InvariantTestSuite))
+}
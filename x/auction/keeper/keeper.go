@@ -169,3 +169,112 @@ func (k Keeper) IterateAuctions(ctx sdk.Context, cb func(auction types.Auction)
 		}
 	}
 }
+
+// SetAuctionResult puts the result of a closed auction into the store, and updates the byTime index.
+func (k Keeper) SetAuctionResult(ctx sdk.Context, result types.AuctionResult) {
+	existingResult, found := k.GetAuctionResult(ctx, result.ID)
+	if found {
+		k.removeFromResultByTimeIndex(ctx, existingResult.CloseTime, existingResult.ID)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionResultKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(result)
+	store.Set(types.GetAuctionResultKey(result.ID), bz)
+
+	byTimeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionResultByTimeKeyPrefix)
+	byTimeStore.Set(types.GetAuctionResultByTimeKey(result.CloseTime, result.ID), types.Uint64ToBytes(result.ID))
+}
+
+// GetAuctionResult gets the result of a closed auction from the store.
+func (k Keeper) GetAuctionResult(ctx sdk.Context, auctionID uint64) (types.AuctionResult, bool) {
+	var result types.AuctionResult
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionResultKeyPrefix)
+	bz := store.Get(types.GetAuctionResultKey(auctionID))
+	if bz == nil {
+		return result, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &result)
+	return result, true
+}
+
+// DeleteAuctionResult removes the result of a closed auction from the store, and the byTime index.
+func (k Keeper) DeleteAuctionResult(ctx sdk.Context, auctionID uint64) {
+	result, found := k.GetAuctionResult(ctx, auctionID)
+	if found {
+		k.removeFromResultByTimeIndex(ctx, result.CloseTime, auctionID)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionResultKeyPrefix)
+	store.Delete(types.GetAuctionResultKey(auctionID))
+}
+
+// removeFromResultByTimeIndex removes an auction ID and close time from the result byTime index.
+func (k Keeper) removeFromResultByTimeIndex(ctx sdk.Context, closeTime time.Time, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionResultByTimeKeyPrefix)
+	store.Delete(types.GetAuctionResultByTimeKey(closeTime, auctionID))
+}
+
+// IterateAuctionResultsByTime provides an iterator over auction results ordered by close time.
+// For each result cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAuctionResultsByTime(ctx sdk.Context, inclusiveCutoffTime time.Time, cb func(auctionID uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionResultByTimeKeyPrefix)
+	iterator := store.Iterator(
+		nil, // start at the very start of the prefix store
+		sdk.PrefixEndBytes(sdk.FormatTimeBytes(inclusiveCutoffTime)), // include any keys with times equal to inclusiveCutoffTime
+	)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+
+		auctionID := types.Uint64FromBytes(iterator.Value())
+
+		if cb(auctionID) {
+			break
+		}
+	}
+}
+
+// IterateAuctionResultsInTimeRange provides an iterator over the auction results closed at or after start and before end, ordered by close time.
+// A zero start or end time leaves that end of the range open. For each result cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAuctionResultsInTimeRange(ctx sdk.Context, start, end time.Time, cb func(result types.AuctionResult) (stop bool)) {
+	var startKey, endKey []byte // nil keys iterate from the very start or to the very end of the prefix store
+	if !start.IsZero() {
+		startKey = sdk.FormatTimeBytes(start)
+	}
+	if !end.IsZero() {
+		endKey = sdk.FormatTimeBytes(end) // excludes any keys with times equal to end, as they are longer
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionResultByTimeKeyPrefix)
+	iterator := store.Iterator(startKey, endKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+
+		result, found := k.GetAuctionResult(ctx, types.Uint64FromBytes(iterator.Value()))
+		if !found {
+			panic(fmt.Sprintf("auction result %d in the by time index not found", types.Uint64FromBytes(iterator.Value())))
+		}
+
+		if cb(result) {
+			break
+		}
+	}
+}
+
+// IterateAuctionResults provides an iterator over all stored auction results.
+// For each result, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateAuctionResults(ctx sdk.Context, cb func(result types.AuctionResult) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AuctionResultKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.AuctionResult
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &result)
+
+		if cb(result) {
+			break
+		}
+	}
+}
@@ -16,6 +16,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAuction(ctx, req, keeper)
 		case types.QueryGetAuctions:
 			return queryAuctions(ctx, req, keeper)
+		case types.QueryGetAuctionResult:
+			return queryAuctionResult(ctx, req, keeper)
+		case types.QueryGetAuctionResults:
+			return queryAuctionResults(ctx, req, keeper)
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, keeper)
 		default:
@@ -48,13 +52,31 @@ func queryAuction(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte
 }
 
 func queryAuctions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	// Get all auctions
+	// Decode request
+	var requestParams types.QueryAllAuctionParams
+	// a query without data has no filters
+	if len(req.Data) > 0 {
+		err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+		if err != nil {
+			return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+		}
+	}
+	if err := requestParams.Validate(); err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+
+	// Get all auctions matching the filters
 	auctionsList := types.Auctions{}
 	keeper.IterateAuctions(ctx, func(a types.Auction) bool {
-		auctionsList = append(auctionsList, a)
+		if requestParams.Matches(a) {
+			auctionsList = append(auctionsList, a)
+		}
 		return false
 	})
 
+	start, end := paginate(len(auctionsList), requestParams.Page, requestParams.Limit)
+	auctionsList = auctionsList[start:end]
+
 	// Encode Results
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, auctionsList)
 	if err != nil {
@@ -64,6 +86,89 @@ func queryAuctions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byt
 	return bz, nil
 }
 
+// query the result of a closed auction by its ID
+func queryAuctionResult(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// Decode request
+	var requestParams types.QueryAuctionParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	// Lookup result
+	result, found := keeper.GetAuctionResult(ctx, requestParams.AuctionID)
+	if !found {
+		return nil, types.ErrAuctionNotFound(types.DefaultCodespace, requestParams.AuctionID)
+	}
+
+	// Encode results
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryAuctionResults(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// Decode request
+	var requestParams types.QueryAuctionResultsParams
+	// a query without data has no filters
+	if len(req.Data) > 0 {
+		err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+		if err != nil {
+			return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+		}
+	}
+	if err := requestParams.Validate(); err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+
+	// Get all results in the time range matching the filters
+	results := types.AuctionResults{}
+	keeper.IterateAuctionResultsInTimeRange(ctx, requestParams.CloseAfter, requestParams.CloseBefore, func(r types.AuctionResult) bool {
+		if requestParams.Matches(r) {
+			results = append(results, r)
+		}
+		return false
+	})
+
+	start, end := paginate(len(results), requestParams.Page, requestParams.Limit)
+	results = results[start:end]
+
+	// Encode Results
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, results)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// paginate returns the bounds of a page of a list of numObjs items.
+// Pages are numbered from one, with pages below one treated as the first page. A limit of zero puts all items on the first page.
+func paginate(numObjs, page, limit int) (start, end int) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = numObjs
+	}
+	if limit == 0 || page-1 > numObjs/limit {
+		// page is out of bounds
+		return numObjs, numObjs
+	}
+	start = (page - 1) * limit
+	end = start + limit
+	if start > numObjs {
+		start = numObjs
+	}
+	if end > numObjs {
+		end = numObjs
+	}
+	return start, end
+}
+
 // query params in the auction store
 func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	// Get params
@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -29,6 +30,7 @@ type QuerierTestSuite struct {
 
 	keeper   keeper.Keeper
 	app      app.TestApp
+	buyer    sdk.AccAddress
 	auctions types.Auctions
 	ctx      sdk.Context
 	querier  sdk.Querier
@@ -56,6 +58,7 @@ func (suite *QuerierTestSuite) SetupTest() {
 
 	suite.ctx = ctx
 	suite.app = tApp
+	suite.buyer = buyer
 	suite.keeper = tApp.GetAuctionKeeper()
 
 	// Populate with auctions
@@ -104,7 +107,7 @@ func (suite *QuerierTestSuite) TestQueryAuctions() {
 	// Set up request query
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctions}, "/"),
-		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAllAuctionParams(1, TestAuctionCount)),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAllAuctionParams(1, TestAuctionCount, "", "", "", "", nil, time.Time{})),
 	}
 
 	// Execute query and check the []byte result
@@ -129,6 +132,150 @@ func (suite *QuerierTestSuite) TestQueryAuctions() {
 	}
 }
 
+func (suite *QuerierTestSuite) TestQueryAuctionsFiltered() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	// Add a debt auction and a bid on the first surplus auction
+	debtID, err := suite.keeper.StartDebtAuction(ctx, cdp.LiquidatorMacc, c("token1", 100), c("token2", 1000), c("debt", 100))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.PlaceBid(ctx, suite.auctions[0].GetID(), suite.buyer, c("token2", 10)))
+
+	queryAuctions := func(params types.QueryAllAuctionParams) types.Auctions {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctions}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(params),
+		}
+		bz, err := suite.querier(ctx, []string{types.QueryGetAuctions}, query)
+		suite.NoError(err)
+		var auctions types.Auctions
+		suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &auctions))
+		return auctions
+	}
+	ids := func(auctions types.Auctions) []uint64 {
+		var ids []uint64
+		for _, a := range auctions {
+			ids = append(ids, a.GetID())
+		}
+		return ids
+	}
+	endTime := ctx.BlockTime().Add(types.DefaultBidDuration)
+
+	// by type
+	auctions := queryAuctions(types.NewQueryAllAuctionParams(1, 100, types.DebtAuctionType, "", "", "", nil, time.Time{}))
+	suite.Equal([]uint64{debtID}, ids(auctions))
+	auctions = queryAuctions(types.NewQueryAllAuctionParams(1, 100, types.SurplusAuctionType, "", "", "", nil, time.Time{}))
+	suite.Len(auctions, TestAuctionCount)
+	// by phase
+	auctions = queryAuctions(types.NewQueryAllAuctionParams(1, 100, "", types.ReverseAuctionPhase, "", "", nil, time.Time{}))
+	suite.Equal([]uint64{debtID}, ids(auctions))
+	// by lot and bid denom
+	auctions = queryAuctions(types.NewQueryAllAuctionParams(1, 100, "", "", "token2", "token1", nil, time.Time{}))
+	suite.Equal([]uint64{debtID}, ids(auctions))
+	auctions = queryAuctions(types.NewQueryAllAuctionParams(1, 100, "", "", "token2", "token2", nil, time.Time{}))
+	suite.Empty(auctions)
+	// by bidder
+	auctions = queryAuctions(types.NewQueryAllAuctionParams(1, 100, "", "", "", "", suite.buyer, time.Time{}))
+	suite.Equal([]uint64{suite.auctions[0].GetID()}, ids(auctions))
+	// by end time, only the bid on auction has had its end time brought forward
+	auctions = queryAuctions(types.NewQueryAllAuctionParams(1, 100, "", "", "", "", nil, endTime.Add(time.Second)))
+	suite.Equal([]uint64{suite.auctions[0].GetID()}, ids(auctions))
+	// paginated
+	auctions = queryAuctions(types.NewQueryAllAuctionParams(2, 4, types.SurplusAuctionType, "", "", "", nil, time.Time{}))
+	suite.Equal(ids(suite.auctions[4:8]), ids(auctions))
+	auctions = queryAuctions(types.NewQueryAllAuctionParams(4, 4, types.SurplusAuctionType, "", "", "", nil, time.Time{}))
+	suite.Empty(auctions)
+	// page zero is the first page, and limit zero returns all matching auctions
+	auctions = queryAuctions(types.NewQueryAllAuctionParams(0, 4, types.SurplusAuctionType, "", "", "", nil, time.Time{}))
+	suite.Equal(ids(suite.auctions[:4]), ids(auctions))
+	auctions = queryAuctions(types.NewQueryAllAuctionParams(1, 0, "", "", "", "", nil, time.Time{}))
+	suite.Len(auctions, TestAuctionCount+1)
+	auctions = queryAuctions(types.NewQueryAllAuctionParams(2, 0, "", "", "", "", nil, time.Time{}))
+	suite.Empty(auctions)
+
+	// a query without data returns all auctions
+	query := abci.RequestQuery{Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctions}, "/")}
+	bz, err := suite.querier(ctx, []string{types.QueryGetAuctions}, query)
+	suite.NoError(err)
+	suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &auctions))
+	suite.Len(auctions, TestAuctionCount+1)
+
+	// unknown filters are rejected
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctions}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAllAuctionParams(1, 100, "lottery", "", "", "", nil, time.Time{})),
+	}
+	_, err = suite.querier(ctx, []string{types.QueryGetAuctions}, query)
+	suite.Error(err)
+}
+
+func (suite *QuerierTestSuite) TestQueryAuctionResults() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	// Close the first two auctions, one with a bid and one without
+	suite.NoError(suite.keeper.PlaceBid(ctx, suite.auctions[0].GetID(), suite.buyer, c("token2", 10)))
+	firstClose := ctx.BlockTime().Add(types.DefaultBidDuration)
+	suite.NoError(suite.keeper.CloseAuction(ctx.WithBlockTime(firstClose), suite.auctions[0].GetID()))
+	secondClose := types.DistantFuture // auctions without bids end at the distant future
+	suite.NoError(suite.keeper.CloseAuction(ctx.WithBlockTime(secondClose), suite.auctions[1].GetID()))
+
+	// Query one result
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctionResult}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.QueryAuctionParams{AuctionID: suite.auctions[0].GetID()}),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetAuctionResult}, query)
+	suite.NoError(err)
+	var result types.AuctionResult
+	suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &result))
+	suite.Equal(suite.auctions[0].GetID(), result.ID)
+	suite.Equal(suite.buyer, result.Bidder)
+	suite.Equal(c("token2", 10), result.Bid)
+	suite.Equal(firstClose, result.CloseTime)
+
+	// Query a result for an open auction
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.QueryAuctionParams{AuctionID: suite.auctions[2].GetID()})
+	_, err = suite.querier(ctx, []string{types.QueryGetAuctionResult}, query)
+	suite.Error(err)
+
+	queryResults := func(params types.QueryAuctionResultsParams) types.AuctionResults {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctionResults}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(params),
+		}
+		bz, err := suite.querier(ctx, []string{types.QueryGetAuctionResults}, query)
+		suite.NoError(err)
+		var results types.AuctionResults
+		suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &results))
+		return results
+	}
+
+	// all
+	results := queryResults(types.NewQueryAuctionResultsParams(1, 100, nil, time.Time{}, time.Time{}))
+	suite.Len(results, 2)
+	// by bidder
+	results = queryResults(types.NewQueryAuctionResultsParams(1, 100, suite.buyer, time.Time{}, time.Time{}))
+	suite.Len(results, 1)
+	suite.Equal(suite.auctions[0].GetID(), results[0].ID)
+	// by time range
+	results = queryResults(types.NewQueryAuctionResultsParams(1, 100, nil, secondClose, time.Time{}))
+	suite.Len(results, 1)
+	suite.Equal(suite.auctions[1].GetID(), results[0].ID)
+	results = queryResults(types.NewQueryAuctionResultsParams(1, 100, nil, firstClose, secondClose))
+	suite.Len(results, 1)
+	suite.Equal(suite.auctions[0].GetID(), results[0].ID)
+	// paginated
+	results = queryResults(types.NewQueryAuctionResultsParams(2, 1, nil, time.Time{}, time.Time{}))
+	suite.Len(results, 1)
+	suite.Equal(suite.auctions[1].GetID(), results[0].ID)
+	results = queryResults(types.NewQueryAuctionResultsParams(0, 0, nil, time.Time{}, time.Time{}))
+	suite.Len(results, 2)
+
+	// a query without data returns all results
+	query = abci.RequestQuery{Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctionResults}, "/")}
+	bz, err = suite.querier(ctx, []string{types.QueryGetAuctionResults}, query)
+	suite.NoError(err)
+	suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &results))
+	suite.Len(results, 2)
+}
+
 func TestQuerierTestSuite(t *testing.T) {
 	suite.Run(t, new(QuerierTestSuite))
 }
@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding auction type
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.AuctionKeyPrefix):
+		var auctionA, auctionB types.Auction
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &auctionA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &auctionB)
+		return fmt.Sprintf("%s\n%s", auctionA, auctionB)
+	case bytes.Equal(kvA.Key[:1], types.AuctionByTimeKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.NextAuctionIDKey):
+		idA := types.Uint64FromBytes(kvA.Value)
+		idB := types.Uint64FromBytes(kvB.Value)
+		return fmt.Sprintf("%d\n%d", idA, idB)
+	default:
+		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+	}
+}
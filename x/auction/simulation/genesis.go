@@ -0,0 +1,183 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// Simulation parameter constants
+const (
+	MaxAuctionDuration = "max_auction_duration"
+	BidDuration        = "bid_duration"
+	DutchStartPremium  = "dutch_start_premium"
+	DutchStepDuration  = "dutch_step_duration"
+	DutchStepDecay     = "dutch_step_decay"
+	DutchFloorPrice    = "dutch_floor_price"
+	DutchMaxDuration   = "dutch_max_duration"
+	MinBidIncrement    = "min_bid_increment"
+	MinLotDecrement    = "min_lot_decrement"
+	ResultRetention    = "result_retention"
+	AuctionDurations   = "auction_durations"
+)
+
+// GenMaxAuctionDuration randomized MaxAuctionDuration, between one and seven days
+func GenMaxAuctionDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 8)) * 24 * time.Hour
+}
+
+// GenBidDuration randomized BidDuration, between one and twenty four hours so that it never exceeds the MaxAuctionDuration
+func GenBidDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 25)) * time.Hour
+}
+
+// GenDutchStartPremium randomized DutchStartPremium, between 0% and 50%
+func GenDutchStartPremium(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(51)), 2)
+}
+
+// GenDutchStepDuration randomized DutchStepDuration, between one and ten minutes
+func GenDutchStepDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 11)) * time.Minute
+}
+
+// GenDutchStepDecay randomized DutchStepDecay, between 0.9 and 0.999
+func GenDutchStepDecay(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 900, 1000)), 3)
+}
+
+// GenDutchFloorPrice randomized DutchFloorPrice, between 10% and 100%
+func GenDutchFloorPrice(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 10, 101)), 2)
+}
+
+// GenDutchMaxDuration randomized DutchMaxDuration, between one and twenty four hours
+func GenDutchMaxDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 25)) * time.Hour
+}
+
+// GenMinBidIncrement randomized MinBidIncrement, between 0% and 10%
+func GenMinBidIncrement(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(11)), 2)
+}
+
+// GenMinLotDecrement randomized MinLotDecrement, between 0% and 10%
+func GenMinLotDecrement(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(11)), 2)
+}
+
+// GenAuctionDurations randomized AuctionDurations, overriding the durations of up to three auction types,
+// some only for one lot denom
+func GenAuctionDurations(r *rand.Rand) types.AuctionDurations {
+	// lot denoms that are auctioned in the simulation
+	lotDenoms := map[string][]string{
+		types.SurplusAuctionType:    {"", "usdx"},
+		types.DebtAuctionType:       {"", sdk.DefaultBondDenom},
+		types.CollateralAuctionType: {"", "btc", "xrp"},
+	}
+	durations := types.AuctionDurations{}
+	for _, auctionType := range []string{types.SurplusAuctionType, types.DebtAuctionType, types.CollateralAuctionType} {
+		if r.Intn(2) == 0 {
+			continue
+		}
+		denoms := lotDenoms[auctionType]
+		durations = append(durations, types.NewAuctionDuration(
+			auctionType,
+			denoms[r.Intn(len(denoms))],
+			GenMaxAuctionDuration(r),
+			GenBidDuration(r),
+		))
+	}
+	return durations
+}
+
+// GenResultRetention randomized ResultRetention, between one hour and seven days
+func GenResultRetention(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 7*24+1)) * time.Hour
+}
+
+// RandomizedGenState generates a random GenesisState for auction
+func RandomizedGenState(simState *module.SimulationState) {
+	var maxAuctionDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAuctionDuration, &maxAuctionDuration, simState.Rand,
+		func(r *rand.Rand) { maxAuctionDuration = GenMaxAuctionDuration(r) },
+	)
+
+	var bidDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BidDuration, &bidDuration, simState.Rand,
+		func(r *rand.Rand) { bidDuration = GenBidDuration(r) },
+	)
+
+	var dutchStartPremium sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DutchStartPremium, &dutchStartPremium, simState.Rand,
+		func(r *rand.Rand) { dutchStartPremium = GenDutchStartPremium(r) },
+	)
+
+	var dutchStepDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DutchStepDuration, &dutchStepDuration, simState.Rand,
+		func(r *rand.Rand) { dutchStepDuration = GenDutchStepDuration(r) },
+	)
+
+	var dutchStepDecay sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DutchStepDecay, &dutchStepDecay, simState.Rand,
+		func(r *rand.Rand) { dutchStepDecay = GenDutchStepDecay(r) },
+	)
+
+	var dutchFloorPrice sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DutchFloorPrice, &dutchFloorPrice, simState.Rand,
+		func(r *rand.Rand) { dutchFloorPrice = GenDutchFloorPrice(r) },
+	)
+
+	var dutchMaxDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DutchMaxDuration, &dutchMaxDuration, simState.Rand,
+		func(r *rand.Rand) { dutchMaxDuration = GenDutchMaxDuration(r) },
+	)
+
+	var minBidIncrement sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinBidIncrement, &minBidIncrement, simState.Rand,
+		func(r *rand.Rand) { minBidIncrement = GenMinBidIncrement(r) },
+	)
+
+	var minLotDecrement sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinLotDecrement, &minLotDecrement, simState.Rand,
+		func(r *rand.Rand) { minLotDecrement = GenMinLotDecrement(r) },
+	)
+
+	var resultRetention time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ResultRetention, &resultRetention, simState.Rand,
+		func(r *rand.Rand) { resultRetention = GenResultRetention(r) },
+	)
+
+	var auctionDurations types.AuctionDurations
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AuctionDurations, &auctionDurations, simState.Rand,
+		func(r *rand.Rand) { auctionDurations = GenAuctionDurations(r) },
+	)
+
+	auctionGenesis := types.NewGenesisState(
+		types.DefaultNextAuctionID,
+		types.NewParams(maxAuctionDuration, bidDuration, dutchStartPremium, dutchStepDuration, dutchStepDecay, dutchFloorPrice, dutchMaxDuration, minBidIncrement, minLotDecrement, resultRetention, auctionDurations),
+		types.GenesisAuctions{},
+		types.AuctionResults{},
+	)
+
+	fmt.Printf("Selected randomly generated auction parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, auctionGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(auctionGenesis)
+}
@@ -0,0 +1,111 @@
+package operations
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/auction"
+)
+
+// SimulateMsgPlaceBid generates a MsgPlaceBid from a random account on a random open auction,
+// with a bid or lot that is valid for the type and phase of the auction
+func SimulateMsgPlaceBid(ak auth.AccountKeeper, k auction.Keeper) simulation.Operation {
+	handler := auction.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		var openAuctions auction.Auctions
+		k.IterateAuctions(ctx, func(a auction.Auction) bool {
+			if !ctx.BlockTime().After(a.GetEndTime()) {
+				openAuctions = append(openAuctions, a)
+			}
+			return false
+		})
+		if len(openAuctions) == 0 {
+			return simulation.NoOpMsg(auction.ModuleName), nil, nil
+		}
+		a := openAuctions[r.Intn(len(openAuctions))]
+
+		bidder := simulation.RandomAcc(r, accs)
+		balance := ak.GetAccount(ctx, bidder.Address).SpendableCoins(ctx.BlockTime()).AmountOf(a.GetBid().Denom)
+		// bidders other than the current one must also pay back the current bid
+		if bidder.Address.Equals(a.GetBidder()) {
+			balance = balance.Add(a.GetBid().Amount)
+		}
+		if balance.LT(a.GetBid().Amount) {
+			return simulation.NoOpMsg(auction.ModuleName), nil, nil
+		}
+
+		var amount sdk.Coin
+		var goErr error
+		params := k.GetParams(ctx)
+		switch at := a.(type) {
+		case auction.SurplusAuction:
+			minBid := auction.MinForwardBid(at.Bid.Amount, params.MinBidIncrement)
+			amount, goErr = randomForwardBid(r, at.Bid.Denom, minBid, balance)
+		case auction.CollateralAuction:
+			if !at.IsReversePhase() {
+				minBid := sdk.MinInt(auction.MinForwardBid(at.Bid.Amount, params.MinBidIncrement), at.MaxBid.Amount)
+				amount, goErr = randomForwardBid(r, at.Bid.Denom, minBid, sdk.MinInt(balance, at.MaxBid.Amount))
+			} else {
+				amount, goErr = randomReverseBid(r, at.Lot.Denom, auction.MaxReverseLot(at.Lot.Amount, params.MinLotDecrement))
+			}
+		case auction.DebtAuction:
+			amount, goErr = randomReverseBid(r, at.Lot.Denom, auction.MaxReverseLot(at.Lot.Amount, params.MinLotDecrement))
+		case auction.DutchCollateralAuction:
+			amount, goErr = acceptPrice(ctx.BlockTime(), at, balance)
+		default:
+			return simulation.NoOpMsg(auction.ModuleName), nil, nil
+		}
+		if goErr != nil {
+			return simulation.NoOpMsg(auction.ModuleName), nil, nil
+		}
+
+		msg := auction.NewMsgPlaceBid(a.GetID(), bidder.Address, amount)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(auction.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// randomForwardBid returns a bid no smaller than min and no larger than max
+func randomForwardBid(r *rand.Rand, denom string, min sdk.Int, max sdk.Int) (sdk.Coin, error) {
+	offset, err := simulation.RandPositiveInt(r, max.Sub(min).AddRaw(1))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(denom, min.Add(offset).SubRaw(1)), nil
+}
+
+// randomReverseBid returns a positive lot no larger than max
+func randomReverseBid(r *rand.Rand, denom string, max sdk.Int) (sdk.Coin, error) {
+	lot, err := simulation.RandPositiveInt(r, max)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(denom, lot), nil
+}
+
+// acceptPrice returns a bid of the current cost of a dutch auction, if it is no larger than max
+func acceptPrice(blockTime time.Time, a auction.DutchCollateralAuction, max sdk.Int) (sdk.Coin, error) {
+	cost, _ := a.SaleAt(blockTime)
+	if max.LT(cost.Amount) {
+		return sdk.Coin{}, fmt.Errorf("cost %s is larger than max %s", cost, max)
+	}
+	return cost, nil
+}
@@ -0,0 +1,86 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+const (
+	keyMaxAuctionDuration = "MaxAuctionDuration"
+	keyBidDuration        = "BidDuration"
+	keyDutchStartPremium  = "DutchStartPremium"
+	keyDutchStepDuration  = "DutchStepDuration"
+	keyDutchStepDecay     = "DutchStepDecay"
+	keyDutchFloorPrice    = "DutchFloorPrice"
+	keyDutchMaxDuration   = "DutchMaxDuration"
+	keyMinBidIncrement    = "MinBidIncrement"
+	keyMinLotDecrement    = "MinLotDecrement"
+	keyResultRetention    = "ResultRetention"
+	keyAuctionDurations   = "AuctionDurations"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keyMaxAuctionDuration, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxAuctionDuration(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyBidDuration, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenBidDuration(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyDutchStartPremium, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenDutchStartPremium(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyDutchStepDuration, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenDutchStepDuration(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyDutchStepDecay, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenDutchStepDecay(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyDutchFloorPrice, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenDutchFloorPrice(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyDutchMaxDuration, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenDutchMaxDuration(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMinBidIncrement, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMinBidIncrement(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMinLotDecrement, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMinLotDecrement(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyResultRetention, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenResultRetention(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyAuctionDurations, "",
+			func(r *rand.Rand) string {
+				return string(types.ModuleCdc.MustMarshalJSON(GenAuctionDurations(r)))
+			},
+		),
+	}
+}
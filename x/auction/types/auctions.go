@@ -13,6 +13,17 @@ import (
 // Also amino panics when encoding times ≥ the start of year 10000.
 var DistantFuture = time.Date(9000, 1, 1, 0, 0, 0, 0, time.UTC)
 
+// Auction types and phases, as returned by Auction.GetType and Auction.GetPhase
+const (
+	SurplusAuctionType         = "surplus"
+	DebtAuctionType            = "debt"
+	CollateralAuctionType      = "collateral"
+	DutchCollateralAuctionType = "dutch_collateral"
+
+	ForwardAuctionPhase = "forward"
+	ReverseAuctionPhase = "reverse"
+)
+
 // Auction is an interface for handling common actions on auctions.
 type Auction interface {
 	GetID() uint64
@@ -96,7 +107,7 @@ type SurplusAuction struct {
 func (a SurplusAuction) WithID(id uint64) Auction { a.ID = id; return a }
 
 // GetType returns the auction type. Used to identify auctions in event attributes.
-func (a SurplusAuction) GetType() string { return "surplus" }
+func (a SurplusAuction) GetType() string { return SurplusAuctionType }
 
 // GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
 // It is used in genesis initialize the module account correctly.
@@ -106,7 +117,7 @@ func (a SurplusAuction) GetModuleAccountCoins() sdk.Coins {
 }
 
 // GetPhase returns the direction of a surplus auction, which never changes.
-func (a SurplusAuction) GetPhase() string { return "forward" }
+func (a SurplusAuction) GetPhase() string { return ForwardAuctionPhase }
 
 // NewSurplusAuction returns a new surplus auction.
 func NewSurplusAuction(seller string, lot sdk.Coin, bidDenom string, endTime time.Time) SurplusAuction {
@@ -129,13 +140,14 @@ type DebtAuction struct {
 	BaseAuction `json:"base_auction" yaml:"base_auction"`
 
 	CorrespondingDebt sdk.Coin `json:"corresponding_debt" yaml:"corresponding_debt"`
+	DebtCovered       sdk.Coin `json:"debt_covered" yaml:"debt_covered"` // Debt returned to the initiator by bids so far.
 }
 
 // WithID returns an auction with the ID set.
 func (a DebtAuction) WithID(id uint64) Auction { a.ID = id; return a }
 
 // GetType returns the auction type. Used to identify auctions in event attributes.
-func (a DebtAuction) GetType() string { return "debt" }
+func (a DebtAuction) GetType() string { return DebtAuctionType }
 
 // GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
 // It is used in genesis initialize the module account correctly.
@@ -146,7 +158,15 @@ func (a DebtAuction) GetModuleAccountCoins() sdk.Coins {
 }
 
 // GetPhase returns the direction of a debt auction, which never changes.
-func (a DebtAuction) GetPhase() string { return "reverse" }
+func (a DebtAuction) GetPhase() string { return ReverseAuctionPhase }
+
+// Validate verifies the base auction and that the debt covered is in the denom of the corresponding debt
+func (a DebtAuction) Validate() error {
+	if err := a.BaseAuction.Validate(); err != nil {
+		return err
+	}
+	return validateDebtCovered(a.CorrespondingDebt, a.DebtCovered)
+}
 
 // NewDebtAuction returns a new debt auction.
 func NewDebtAuction(buyerModAccName string, bid sdk.Coin, initialLot sdk.Coin, endTime time.Time, debt sdk.Coin) DebtAuction {
@@ -164,6 +184,7 @@ func NewDebtAuction(buyerModAccName string, bid sdk.Coin, initialLot sdk.Coin, e
 			EndTime:         endTime,
 			MaxEndTime:      endTime},
 		CorrespondingDebt: debt,
+		DebtCovered:       sdk.NewInt64Coin(debt.Denom, 0),
 	}
 	return auction
 }
@@ -177,6 +198,7 @@ type CollateralAuction struct {
 	BaseAuction `json:"base_auction" yaml:"base_auction"`
 
 	CorrespondingDebt sdk.Coin          `json:"corresponding_debt" yaml:"corresponding_debt"`
+	DebtCovered       sdk.Coin          `json:"debt_covered" yaml:"debt_covered"` // Debt returned to the initiator by bids so far.
 	MaxBid            sdk.Coin          `json:"max_bid" yaml:"max_bid"`
 	LotReturns        WeightedAddresses `json:"lot_returns" yaml:"lot_returns"`
 }
@@ -185,7 +207,7 @@ type CollateralAuction struct {
 func (a CollateralAuction) WithID(id uint64) Auction { a.ID = id; return a }
 
 // GetType returns the auction type. Used to identify auctions in event attributes.
-func (a CollateralAuction) GetType() string { return "collateral" }
+func (a CollateralAuction) GetType() string { return CollateralAuctionType }
 
 // GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
 // It is used in genesis initialize the module account correctly.
@@ -203,9 +225,17 @@ func (a CollateralAuction) IsReversePhase() bool {
 // GetPhase returns the direction of a collateral auction.
 func (a CollateralAuction) GetPhase() string {
 	if a.IsReversePhase() {
-		return "reverse"
+		return ReverseAuctionPhase
+	}
+	return ForwardAuctionPhase
+}
+
+// Validate verifies the base auction and that the debt covered is in the denom of the corresponding debt
+func (a CollateralAuction) Validate() error {
+	if err := a.BaseAuction.Validate(); err != nil {
+		return err
 	}
-	return "forward"
+	return validateDebtCovered(a.CorrespondingDebt, a.DebtCovered)
 }
 
 func (a CollateralAuction) String() string {
@@ -237,12 +267,215 @@ func NewCollateralAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid
 			EndTime:         endTime,
 			MaxEndTime:      endTime},
 		CorrespondingDebt: debt,
+		DebtCovered:       sdk.NewInt64Coin(debt.Denom, 0),
+		MaxBid:            maxBid,
+		LotReturns:        lotReturns,
+	}
+	return auction
+}
+
+// DutchCollateralAuction is a descending price auction.
+// The price of the lot starts above the reference price it was started with and decays by a fixed factor every step, down to a floor price,
+// until a bidder accepts the current price. The first accepted bid takes the lot and closes the auction immediately.
+// An auction that is still unsold at its end time restarts its price decay.
+// If the lot is worth more than MaxBid at the accepted price, only enough of the lot to raise MaxBid is sold,
+// and the rest is sent to LotReturns, being divided among the addresses by weight.
+type DutchCollateralAuction struct {
+	BaseAuction `json:"base_auction" yaml:"base_auction"`
+
+	CorrespondingDebt sdk.Coin          `json:"corresponding_debt" yaml:"corresponding_debt"`
+	DebtCovered       sdk.Coin          `json:"debt_covered" yaml:"debt_covered"` // Debt returned to the initiator by bids so far.
+	MaxBid            sdk.Coin          `json:"max_bid" yaml:"max_bid"`
+	LotReturns        WeightedAddresses `json:"lot_returns" yaml:"lot_returns"`
+	StartTime         time.Time         `json:"start_time" yaml:"start_time"`       // Time the price starts decaying from.
+	StartPrice        sdk.Dec           `json:"start_price" yaml:"start_price"`     // Price of one unit of lot, in units of the bid denom, at the start time.
+	StepDuration      time.Duration     `json:"step_duration" yaml:"step_duration"` // Time between decreases in price.
+	StepDecay         sdk.Dec           `json:"step_decay" yaml:"step_decay"`       // Factor the price is multiplied by every step.
+	FloorPrice        sdk.Dec           `json:"floor_price" yaml:"floor_price"`     // Price the price never decays below.
+}
+
+// WithID returns an auction with the ID set.
+func (a DutchCollateralAuction) WithID(id uint64) Auction { a.ID = id; return a }
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a DutchCollateralAuction) GetType() string { return DutchCollateralAuctionType }
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+func (a DutchCollateralAuction) GetModuleAccountCoins() sdk.Coins {
+	// a.Bid is paid out on bids, so is never stored in the module account
+	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt))
+}
+
+// GetPhase returns the direction of a dutch collateral auction, which never changes.
+// Bids are placed in the bid denom, so it is always in forward phase.
+func (a DutchCollateralAuction) GetPhase() string { return ForwardAuctionPhase }
+
+// PriceAt returns the price of one unit of lot, in units of the bid denom, at the input time.
+// The price is multiplied by StepDecay once for every full StepDuration elapsed since StartTime, down to FloorPrice.
+func (a DutchCollateralAuction) PriceAt(t time.Time) sdk.Dec {
+	if !t.After(a.StartTime) || a.StepDuration <= 0 {
+		return a.StartPrice
+	}
+	steps := uint64(t.Sub(a.StartTime) / a.StepDuration)
+	price := a.StartPrice.Mul(decPow(a.StepDecay, steps))
+	if price.LT(a.FloorPrice) {
+		return a.FloorPrice
+	}
+	return price
+}
+
+// SaleAt returns the amount a bidder accepting the price at the input time pays, and the lot they receive.
+// The cost is the price of the whole lot, rounded up to at least one unit, capped at MaxBid.
+// When the cost is capped, the lot is reduced to the amount that MaxBid buys at the price.
+func (a DutchCollateralAuction) SaleAt(t time.Time) (cost sdk.Coin, lot sdk.Coin) {
+	price := a.PriceAt(t)
+	costAmount := sdk.MaxInt(price.MulInt(a.Lot.Amount).Ceil().TruncateInt(), sdk.OneInt())
+	if costAmount.LT(a.MaxBid.Amount) {
+		return sdk.NewCoin(a.MaxBid.Denom, costAmount), a.Lot
+	}
+	// the lot that MaxBid buys can't be calculated at a zero price, so the whole lot is sold
+	if !price.IsPositive() {
+		return a.MaxBid, a.Lot
+	}
+	lotAmount := sdk.NewDecFromInt(a.MaxBid.Amount).Quo(price).TruncateInt()
+	return a.MaxBid, sdk.NewCoin(a.Lot.Denom, sdk.MinInt(lotAmount, a.Lot.Amount))
+}
+
+// decPow raises x to the power of n by repeated squaring.
+func decPow(x sdk.Dec, n uint64) sdk.Dec {
+	z := sdk.OneDec()
+	for ; n > 0 && !z.IsZero(); n /= 2 {
+		if n%2 == 1 {
+			z = z.Mul(x)
+		}
+		x = x.Mul(x)
+	}
+	return z
+}
+
+// Validate verifies that the auction end time is before max end time, that the price curve is valid,
+// and that the debt covered is in the denom of the corresponding debt
+func (a DutchCollateralAuction) Validate() error {
+	if err := a.BaseAuction.Validate(); err != nil {
+		return err
+	}
+	if a.StartPrice.IsNil() || !a.StartPrice.IsPositive() {
+		return fmt.Errorf("start price must be positive, is %s", a.StartPrice)
+	}
+	if a.StepDuration <= 0 {
+		return fmt.Errorf("step duration must be positive, is %s", a.StepDuration)
+	}
+	if a.StepDecay.IsNil() || !a.StepDecay.IsPositive() || a.StepDecay.GT(sdk.OneDec()) {
+		return fmt.Errorf("step decay must be between 0 and 1, is %s", a.StepDecay)
+	}
+	if a.FloorPrice.IsNil() || !a.FloorPrice.IsPositive() || a.FloorPrice.GT(a.StartPrice) {
+		return fmt.Errorf("floor price must be positive and at most the start price, is %s", a.FloorPrice)
+	}
+	return validateDebtCovered(a.CorrespondingDebt, a.DebtCovered)
+}
+
+func (a DutchCollateralAuction) String() string {
+	return fmt.Sprintf(`Auction %d:
+  Initiator:              %s
+  Lot:               			%s
+  Bidder:            		  %s
+  Bid:        						%s
+  End Time:   						%s
+	Max End Time:      			%s
+	Max Bid									%s
+	LotReturns						%s
+	Start Time:							%s
+	Start Price:						%s
+	Step Duration:					%s
+	Step Decay:							%s
+	Floor Price:						%s`,
+		a.GetID(), a.Initiator, a.Lot,
+		a.Bidder, a.Bid, a.GetEndTime().String(),
+		a.MaxEndTime.String(), a.MaxBid, a.LotReturns,
+		a.StartTime, a.StartPrice, a.StepDuration, a.StepDecay, a.FloorPrice,
+	)
+}
+
+// NewDutchCollateralAuction returns a new dutch collateral auction.
+func NewDutchCollateralAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid sdk.Coin, lotReturns WeightedAddresses, debt sdk.Coin,
+	startTime time.Time, startPrice sdk.Dec, stepDuration time.Duration, stepDecay sdk.Dec, floorPrice sdk.Dec) DutchCollateralAuction {
+	auction := DutchCollateralAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(maxBid.Denom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime},
+		CorrespondingDebt: debt,
+		DebtCovered:       sdk.NewInt64Coin(debt.Denom, 0),
 		MaxBid:            maxBid,
 		LotReturns:        lotReturns,
+		StartTime:         startTime,
+		StartPrice:        startPrice,
+		StepDuration:      stepDuration,
+		StepDecay:         stepDecay,
+		FloorPrice:        floorPrice,
 	}
 	return auction
 }
 
+// isDebtCoveredMissing returns true if the debt covered is unset, as it is in auctions exported before it was recorded.
+func isDebtCoveredMissing(covered sdk.Coin) bool {
+	return covered.Denom == "" && (covered.Amount == (sdk.Int{}) || covered.Amount.IsZero())
+}
+
+// validateDebtCovered verifies that the debt covered is a valid amount of the debt denom, or missing.
+func validateDebtCovered(debt sdk.Coin, covered sdk.Coin) error {
+	if isDebtCoveredMissing(covered) {
+		return nil
+	}
+	if !covered.IsValid() || covered.Denom != debt.Denom {
+		return fmt.Errorf("debt covered must be a valid amount of the debt denom %s, is %s", debt.Denom, covered)
+	}
+	return nil
+}
+
+// WithDefaultDebtCovered returns the auction with its debt covered set to zero of the debt denom if it is missing.
+// It is used to import auctions exported before the debt covered was recorded.
+func WithDefaultDebtCovered(a GenesisAuction) GenesisAuction {
+	switch auc := a.(type) {
+	case DebtAuction:
+		if isDebtCoveredMissing(auc.DebtCovered) {
+			auc.DebtCovered = sdk.NewInt64Coin(auc.CorrespondingDebt.Denom, 0)
+		}
+		return auc
+	case CollateralAuction:
+		if isDebtCoveredMissing(auc.DebtCovered) {
+			auc.DebtCovered = sdk.NewInt64Coin(auc.CorrespondingDebt.Denom, 0)
+		}
+		return auc
+	case DutchCollateralAuction:
+		if isDebtCoveredMissing(auc.DebtCovered) {
+			auc.DebtCovered = sdk.NewInt64Coin(auc.CorrespondingDebt.Denom, 0)
+		}
+		return auc
+	}
+	return a
+}
+
+// MinForwardBid returns the smallest bid amount allowed to follow the input bid amount in a forward auction.
+// Bids must increase by at least the input increment fraction of the current bid, and by at least one unit.
+func MinForwardBid(bid sdk.Int, increment sdk.Dec) sdk.Int {
+	incremented := sdk.NewDecFromInt(bid).Mul(sdk.OneDec().Add(increment)).Ceil().TruncateInt()
+	return sdk.MaxInt(incremented, bid.AddRaw(1))
+}
+
+// MaxReverseLot returns the largest lot amount allowed to follow the input lot amount in a reverse auction.
+// Lots must decrease by at least the input decrement fraction of the current lot, and by at least one unit, but never below zero.
+func MaxReverseLot(lot sdk.Int, decrement sdk.Dec) sdk.Int {
+	decremented := sdk.NewDecFromInt(lot).Mul(sdk.OneDec().Sub(decrement)).TruncateInt()
+	return sdk.MaxInt(sdk.MinInt(decremented, lot.SubRaw(1)), sdk.ZeroInt())
+}
+
 // WeightedAddresses is a type for storing some addresses and associated weights.
 type WeightedAddresses struct {
 	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
@@ -193,3 +193,104 @@ func TestNewCollateralAuction(t *testing.T) {
 	require.Equal(t, collateralAuction.LotReturns, weightedAddresses)
 	require.Equal(t, collateralAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
 }
+
+func TestDutchCollateralAuctionSaleAt(t *testing.T) {
+	startTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	weightedAddresses, err := NewWeightedAddresses([]sdk.AccAddress{sdk.AccAddress([]byte(TestAccAddress1))}, []sdk.Int{sdk.NewInt(1)})
+	require.NoError(t, err)
+	auction := NewDutchCollateralAuction(
+		TestInitiatorModuleName, sdk.NewInt64Coin(TestLotDenom, TestLotAmount), DistantFuture, sdk.NewInt64Coin(TestBidDenom, 150),
+		weightedAddresses, sdk.NewInt64Coin(TestDebtDenom, TestDebtAmount1),
+		startTime, sdk.MustNewDecFromStr("2.0"), time.Minute, sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.2"),
+	)
+	require.NoError(t, auction.Validate())
+
+	tests := []struct {
+		name         string
+		time         time.Time
+		expectedCost sdk.Coin
+		expectedLot  sdk.Coin
+	}{
+		// price of 2.0 values the lot at 200, so MaxBid only buys 75 of the lot
+		{"start", startTime, sdk.NewInt64Coin(TestBidDenom, 150), sdk.NewInt64Coin(TestLotDenom, 75)},
+		{"within first step", startTime.Add(59 * time.Second), sdk.NewInt64Coin(TestBidDenom, 150), sdk.NewInt64Coin(TestLotDenom, 75)},
+		{"one step", startTime.Add(time.Minute), sdk.NewInt64Coin(TestBidDenom, 100), sdk.NewInt64Coin(TestLotDenom, TestLotAmount)},
+		{"three steps", startTime.Add(3 * time.Minute), sdk.NewInt64Coin(TestBidDenom, 25), sdk.NewInt64Coin(TestLotDenom, TestLotAmount)},
+		// price decays no further than the floor price
+		{"many steps", startTime.Add(24 * time.Hour), sdk.NewInt64Coin(TestBidDenom, 20), sdk.NewInt64Coin(TestLotDenom, TestLotAmount)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cost, lot := auction.SaleAt(tc.time)
+			require.Equal(t, tc.expectedCost, cost)
+			require.Equal(t, tc.expectedLot, lot)
+		})
+	}
+
+	// a price that has decayed to zero sells the whole lot for at least one unit, up to MaxBid
+	auction.FloorPrice = sdk.ZeroDec()
+	auction.MaxBid = sdk.NewInt64Coin(TestBidDenom, 1)
+	require.NotPanics(t, func() {
+		cost, lot := auction.SaleAt(startTime.Add(1000 * 24 * time.Hour))
+		require.Equal(t, sdk.NewInt64Coin(TestBidDenom, 1), cost)
+		require.Equal(t, sdk.NewInt64Coin(TestLotDenom, TestLotAmount), lot)
+	})
+}
+
+func TestDutchCollateralAuctionValidate(t *testing.T) {
+	startTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	newAuction := func(startPrice sdk.Dec, stepDuration time.Duration, stepDecay sdk.Dec, floorPrice sdk.Dec) DutchCollateralAuction {
+		return NewDutchCollateralAuction(
+			TestInitiatorModuleName, sdk.NewInt64Coin(TestLotDenom, TestLotAmount), DistantFuture, sdk.NewInt64Coin(TestBidDenom, TestBidAmount),
+			WeightedAddresses{}, sdk.NewInt64Coin(TestDebtDenom, TestDebtAmount1), startTime, startPrice, stepDuration, stepDecay, floorPrice,
+		)
+	}
+	half := sdk.MustNewDecFromStr("0.5")
+	require.NoError(t, newAuction(sdk.OneDec(), time.Minute, sdk.OneDec(), half).Validate())
+	require.NoError(t, newAuction(sdk.OneDec(), time.Minute, sdk.OneDec(), sdk.OneDec()).Validate())
+	require.Error(t, newAuction(sdk.ZeroDec(), time.Minute, sdk.OneDec(), half).Validate())
+	require.Error(t, newAuction(sdk.OneDec(), 0, sdk.OneDec(), half).Validate())
+	require.Error(t, newAuction(sdk.OneDec(), time.Minute, sdk.ZeroDec(), half).Validate())
+	require.Error(t, newAuction(sdk.OneDec(), time.Minute, sdk.MustNewDecFromStr("1.1"), half).Validate())
+	require.Error(t, newAuction(sdk.OneDec(), time.Minute, sdk.OneDec(), sdk.ZeroDec()).Validate())
+	require.Error(t, newAuction(sdk.OneDec(), time.Minute, sdk.OneDec(), sdk.MustNewDecFromStr("1.1")).Validate())
+}
+
+func TestMinForwardBid(t *testing.T) {
+	tests := []struct {
+		name      string
+		bid       int64
+		increment string
+		expected  int64
+	}{
+		{"zero bid", 0, "0.05", 1},
+		{"rounds up", 10, "0.05", 11},
+		{"exact", 100, "0.05", 105},
+		{"zero increment", 100, "0", 101},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, sdk.NewInt(tc.expected), MinForwardBid(sdk.NewInt(tc.bid), sdk.MustNewDecFromStr(tc.increment)))
+		})
+	}
+}
+
+func TestMaxReverseLot(t *testing.T) {
+	tests := []struct {
+		name      string
+		lot       int64
+		decrement string
+		expected  int64
+	}{
+		{"zero lot", 0, "0.05", 0},
+		{"one lot", 1, "0.05", 0},
+		{"rounds down", 10, "0.05", 9},
+		{"exact", 100, "0.05", 95},
+		{"zero decrement", 100, "0", 99},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, sdk.NewInt(tc.expected), MaxReverseLot(sdk.NewInt(tc.lot), sdk.MustNewDecFromStr(tc.decrement)))
+		})
+	}
+}
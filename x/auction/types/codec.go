@@ -20,4 +20,5 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(SurplusAuction{}, "auction/SurplusAuction", nil)
 	cdc.RegisterConcrete(DebtAuction{}, "auction/DebtAuction", nil)
 	cdc.RegisterConcrete(CollateralAuction{}, "auction/CollateralAuction", nil)
+	cdc.RegisterConcrete(DutchCollateralAuction{}, "auction/DutchCollateralAuction", nil)
 }
@@ -24,6 +24,7 @@ const (
 	CodeLotTooLarge                       sdk.CodeType      = 11
 	CodeCollateralAuctionIsInReversePhase sdk.CodeType      = 12
 	CodeCollateralAuctionIsInForwardPhase sdk.CodeType      = 13
+	CodeBidBelowPrice                     sdk.CodeType      = 14
 )
 
 // ErrInvalidInitialAuctionID error for when the initial auction ID hasn't been set
@@ -66,9 +67,9 @@ func ErrInvalidLotDenom(codespace sdk.CodespaceType, lotDenom string, auctionLot
 	return sdk.NewError(codespace, CodeInvalidLotDenom, fmt.Sprintf("lot denom %s doesn't match auction lot denom %s", lotDenom, auctionLotDenom))
 }
 
-// ErrBidTooSmall error for when bid is not greater than auction's last bid
-func ErrBidTooSmall(codespace sdk.CodespaceType, bid sdk.Coin, lastBid sdk.Coin) sdk.Error {
-	return sdk.NewError(codespace, CodeBidTooSmall, fmt.Sprintf("bid %s is not greater than auction's last bid %s", bid.String(), lastBid.String()))
+// ErrBidTooSmall error for when bid is smaller than the auction's minimum next bid
+func ErrBidTooSmall(codespace sdk.CodespaceType, bid sdk.Coin, minBid sdk.Coin) sdk.Error {
+	return sdk.NewError(codespace, CodeBidTooSmall, fmt.Sprintf("bid %s is smaller than auction's minimum bid %s", bid.String(), minBid.String()))
 }
 
 // ErrBidTooLarge error for when bid is larger than auction's maximum allowed bid
@@ -76,9 +77,9 @@ func ErrBidTooLarge(codespace sdk.CodespaceType, bid sdk.Coin, maxBid sdk.Coin)
 	return sdk.NewError(codespace, CodeBidTooLarge, fmt.Sprintf("bid %s is greater than auction's max bid %s", bid.String(), maxBid.String()))
 }
 
-// ErrLotTooLarge error for when lot is not smaller than auction's last lot
-func ErrLotTooLarge(codespace sdk.CodespaceType, lot sdk.Coin, lastLot sdk.Coin) sdk.Error {
-	return sdk.NewError(codespace, CodeLotTooLarge, fmt.Sprintf("lot %s is not less than auction's last lot %s", lot.String(), lastLot.String()))
+// ErrLotTooLarge error for when lot is larger than the auction's maximum next lot
+func ErrLotTooLarge(codespace sdk.CodespaceType, lot sdk.Coin, maxLot sdk.Coin) sdk.Error {
+	return sdk.NewError(codespace, CodeLotTooLarge, fmt.Sprintf("lot %s is larger than auction's maximum lot %s", lot.String(), maxLot.String()))
 }
 
 // ErrCollateralAuctionIsInReversePhase error for when attempting to place a forward bid on a collateral auction in reverse phase
@@ -90,3 +91,8 @@ func ErrCollateralAuctionIsInReversePhase(codespace sdk.CodespaceType, id uint64
 func ErrCollateralAuctionIsInForwardPhase(codespace sdk.CodespaceType, id uint64) sdk.Error {
 	return sdk.NewError(codespace, CodeCollateralAuctionIsInForwardPhase, fmt.Sprintf("invalid bid - auction %d is in forward phase", id))
 }
+
+// ErrBidBelowPrice error for when a bid on a dutch auction is less than the auction's current price
+func ErrBidBelowPrice(codespace sdk.CodespaceType, bid sdk.Coin, price sdk.Coin) sdk.Error {
+	return sdk.NewError(codespace, CodeBidBelowPrice, fmt.Sprintf("bid %s is less than auction's current price %s", bid.String(), price.String()))
+}
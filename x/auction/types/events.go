@@ -2,9 +2,10 @@ package types
 
 // Events for auction module
 const (
-	EventTypeAuctionStart = "auction_start"
-	EventTypeAuctionBid   = "auction_bid"
-	EventTypeAuctionClose = "auction_close"
+	EventTypeAuctionStart   = "auction_start"
+	EventTypeAuctionBid     = "auction_bid"
+	EventTypeAuctionClose   = "auction_close"
+	EventTypeAuctionRestart = "auction_restart"
 
 	AttributeValueCategory  = ModuleName
 	AttributeKeyAuctionID   = "auction_id"
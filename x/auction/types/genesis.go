@@ -25,14 +25,16 @@ type GenesisState struct {
 	NextAuctionID uint64          `json:"next_auction_id" yaml:"next_auction_id"`
 	Params        Params          `json:"params" yaml:"params"`
 	Auctions      GenesisAuctions `json:"auctions" yaml:"auctions"`
+	Results       AuctionResults  `json:"results" yaml:"results"`
 }
 
 // NewGenesisState returns a new genesis state object for auctions module.
-func NewGenesisState(nextID uint64, ap Params, ga GenesisAuctions) GenesisState {
+func NewGenesisState(nextID uint64, ap Params, ga GenesisAuctions, ar AuctionResults) GenesisState {
 	return GenesisState{
 		NextAuctionID: nextID,
 		Params:        ap,
 		Auctions:      ga,
+		Results:       ar,
 	}
 }
 
@@ -42,6 +44,7 @@ func DefaultGenesisState() GenesisState {
 		DefaultNextAuctionID,
 		DefaultParams(),
 		GenesisAuctions{},
+		AuctionResults{},
 	)
 }
 
@@ -79,5 +82,22 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("found auction ID >= the nextAuctionID (%d >= %d)", a.GetID(), gs.NextAuctionID)
 		}
 	}
+
+	resultIDs := map[uint64]bool{}
+	for _, r := range gs.Results {
+
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("found invalid auction result: %w", err)
+		}
+
+		if resultIDs[r.ID] || ids[r.ID] {
+			return fmt.Errorf("found duplicate auction result ID (%d)", r.ID)
+		}
+		resultIDs[r.ID] = true
+
+		if r.ID >= gs.NextAuctionID {
+			return fmt.Errorf("found auction result ID >= the nextAuctionID (%d >= %d)", r.ID, gs.NextAuctionID)
+		}
+	}
 	return nil
 }
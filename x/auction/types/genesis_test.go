@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -9,29 +10,39 @@ import (
 
 var testCoin = sdk.NewInt64Coin("test", 20)
 
+var testResult = AuctionResult{ID: 105, Type: SurplusAuctionType, Lot: testCoin, Bid: testCoin, CloseTime: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)}
+
 func TestGenesisState_Validate(t *testing.T) {
 	testCases := []struct {
 		name       string
 		nextID     uint64
 		auctions   GenesisAuctions
+		results    AuctionResults
 		expectPass bool
 	}{
-		{"default", DefaultGenesisState().NextAuctionID, DefaultGenesisState().Auctions, true},
-		{"invalid next ID", 54, GenesisAuctions{SurplusAuction{BaseAuction{ID: 105}}}, false},
+		{"default", DefaultGenesisState().NextAuctionID, DefaultGenesisState().Auctions, DefaultGenesisState().Results, true},
+		{"invalid next ID", 54, GenesisAuctions{SurplusAuction{BaseAuction{ID: 105}}}, AuctionResults{}, false},
 		{
 			"repeated ID",
 			1000,
 			GenesisAuctions{
 				SurplusAuction{BaseAuction{ID: 105}},
-				DebtAuction{BaseAuction{ID: 105}, testCoin},
+				DebtAuction{BaseAuction{ID: 105}, testCoin, testCoin},
 			},
+			AuctionResults{},
 			false,
 		},
+		{"missing debt covered", 1000, GenesisAuctions{DebtAuction{BaseAuction: BaseAuction{ID: 105}, CorrespondingDebt: testCoin}}, AuctionResults{}, true},
+		{"debt covered in wrong denom", 1000, GenesisAuctions{DebtAuction{BaseAuction{ID: 105}, testCoin, sdk.NewInt64Coin("other", 1)}}, AuctionResults{}, false},
+		{"valid result", 1000, GenesisAuctions{}, AuctionResults{testResult}, true},
+		{"invalid result next ID", 54, GenesisAuctions{}, AuctionResults{testResult}, false},
+		{"repeated result ID", 1000, GenesisAuctions{}, AuctionResults{testResult, testResult}, false},
+		{"result ID of open auction", 1000, GenesisAuctions{SurplusAuction{BaseAuction{ID: 105}}}, AuctionResults{testResult}, false},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			gs := NewGenesisState(tc.nextID, DefaultParams(), tc.auctions)
+			gs := NewGenesisState(tc.nextID, DefaultParams(), tc.auctions, tc.results)
 
 			err := gs.Validate()
 
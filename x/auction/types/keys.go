@@ -30,6 +30,9 @@ var (
 	AuctionByTimeKeyPrefix = []byte{0x01} // prefix for keys that are part of the auctionsByTime index
 
 	NextAuctionIDKey = []byte{0x02} // key for the next auction id
+
+	AuctionResultKeyPrefix       = []byte{0x03} // prefix for keys that store the results of closed auctions
+	AuctionResultByTimeKeyPrefix = []byte{0x04} // prefix for keys that are part of the auctionResultsByTime index
 )
 
 // GetAuctionKey returns the bytes of an auction key
@@ -42,6 +45,16 @@ func GetAuctionByTimeKey(endTime time.Time, auctionID uint64) []byte {
 	return append(sdk.FormatTimeBytes(endTime), Uint64ToBytes(auctionID)...)
 }
 
+// GetAuctionResultKey returns the bytes of an auction result key
+func GetAuctionResultKey(auctionID uint64) []byte {
+	return Uint64ToBytes(auctionID)
+}
+
+// GetAuctionResultByTimeKey returns the key for iterating auction results by close time
+func GetAuctionResultByTimeKey(closeTime time.Time, auctionID uint64) []byte {
+	return append(sdk.FormatTimeBytes(closeTime), Uint64ToBytes(auctionID)...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func Uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -15,6 +15,29 @@ const (
 	DefaultMaxAuctionDuration time.Duration = 2 * 24 * time.Hour
 	// DefaultBidDuration how long an auction gets extended when someone bids
 	DefaultBidDuration time.Duration = 1 * time.Hour
+	// DefaultDutchStepDuration how often the price of a dutch auction decreases
+	DefaultDutchStepDuration time.Duration = 1 * time.Minute
+	// DefaultDutchMaxDuration how long a dutch auction runs before it restarts unsold
+	DefaultDutchMaxDuration time.Duration = 6 * time.Hour
+	// DefaultResultRetention how long records of closed auctions are kept
+	DefaultResultRetention time.Duration = 30 * 24 * time.Hour
+)
+
+// Defaults for dutch auction params
+var (
+	// DefaultDutchStartPremium how far above the reference price dutch auctions start
+	DefaultDutchStartPremium = sdk.MustNewDecFromStr("0.2")
+	// DefaultDutchStepDecay factor the price of a dutch auction is multiplied by every step
+	DefaultDutchStepDecay = sdk.MustNewDecFromStr("0.99")
+	// DefaultDutchFloorPrice fraction of the reference price that the price of a dutch auction never decays below
+	DefaultDutchFloorPrice = sdk.MustNewDecFromStr("0.5")
+	// DefaultMinBidIncrement minimum fraction forward bids must increase the bid by
+	DefaultMinBidIncrement = sdk.MustNewDecFromStr("0.05")
+	// DefaultMinLotDecrement minimum fraction reverse bids must decrease the lot by
+	DefaultMinLotDecrement = sdk.MustNewDecFromStr("0.05")
+	// DefaultAuctionDurations no auction types use durations other than MaxAuctionDuration and BidDuration.
+	// It is nil rather than empty as the param store decodes empty lists as nil.
+	DefaultAuctionDurations AuctionDurations
 )
 
 // Parameter keys
@@ -22,21 +45,49 @@ var (
 	// ParamStoreKeyParams Param store key for auction params
 	KeyAuctionBidDuration = []byte("BidDuration")
 	KeyAuctionDuration    = []byte("MaxAuctionDuration")
+	KeyDutchStartPremium  = []byte("DutchStartPremium")
+	KeyDutchStepDuration  = []byte("DutchStepDuration")
+	KeyDutchStepDecay     = []byte("DutchStepDecay")
+	KeyDutchFloorPrice    = []byte("DutchFloorPrice")
+	KeyDutchMaxDuration   = []byte("DutchMaxDuration")
+	KeyMinBidIncrement    = []byte("MinBidIncrement")
+	KeyMinLotDecrement    = []byte("MinLotDecrement")
+	KeyResultRetention    = []byte("ResultRetention")
+	KeyAuctionDurations   = []byte("AuctionDurations")
 )
 
 var _ subspace.ParamSet = &Params{}
 
 // Params is the governance parameters for the auction module.
 type Params struct {
-	MaxAuctionDuration time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
-	BidDuration        time.Duration `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
+	MaxAuctionDuration time.Duration    `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
+	BidDuration        time.Duration    `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
+	DutchStartPremium  sdk.Dec          `json:"dutch_start_premium" yaml:"dutch_start_premium"`   // fraction above the reference price that dutch auctions start at
+	DutchStepDuration  time.Duration    `json:"dutch_step_duration" yaml:"dutch_step_duration"`   // time between decreases in the price of dutch auctions
+	DutchStepDecay     sdk.Dec          `json:"dutch_step_decay" yaml:"dutch_step_decay"`         // factor the price of dutch auctions is multiplied by every step
+	DutchFloorPrice    sdk.Dec          `json:"dutch_floor_price" yaml:"dutch_floor_price"`       // fraction of the reference price that the price of dutch auctions never decays below
+	DutchMaxDuration   time.Duration    `json:"dutch_max_duration" yaml:"dutch_max_duration"`     // time after which unsold dutch auctions restart their price decay
+	MinBidIncrement    sdk.Dec          `json:"min_bid_increment" yaml:"min_bid_increment"`       // minimum fraction of the current bid that forward bids must increase it by
+	MinLotDecrement    sdk.Dec          `json:"min_lot_decrement" yaml:"min_lot_decrement"`       // minimum fraction of the current lot that reverse bids must decrease it by
+	ResultRetention    time.Duration    `json:"result_retention" yaml:"result_retention"`         // time records of closed auctions are kept before being pruned
+	AuctionDurations   AuctionDurations `json:"auction_durations" yaml:"auction_durations"`       // overrides of max auction duration and bid duration for some auction types and lot denoms
 }
 
 // NewParams returns a new Params object.
-func NewParams(maxAuctionDuration time.Duration, bidDuration time.Duration) Params {
+func NewParams(maxAuctionDuration time.Duration, bidDuration time.Duration, dutchStartPremium sdk.Dec, dutchStepDuration time.Duration, dutchStepDecay sdk.Dec,
+	dutchFloorPrice sdk.Dec, dutchMaxDuration time.Duration, minBidIncrement sdk.Dec, minLotDecrement sdk.Dec, resultRetention time.Duration, auctionDurations AuctionDurations) Params {
 	return Params{
 		MaxAuctionDuration: maxAuctionDuration,
 		BidDuration:        bidDuration,
+		DutchStartPremium:  dutchStartPremium,
+		DutchStepDuration:  dutchStepDuration,
+		DutchStepDecay:     dutchStepDecay,
+		DutchFloorPrice:    dutchFloorPrice,
+		DutchMaxDuration:   dutchMaxDuration,
+		MinBidIncrement:    minBidIncrement,
+		MinLotDecrement:    minLotDecrement,
+		ResultRetention:    resultRetention,
+		AuctionDurations:   auctionDurations,
 	}
 }
 
@@ -45,6 +96,15 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultMaxAuctionDuration,
 		DefaultBidDuration,
+		DefaultDutchStartPremium,
+		DefaultDutchStepDuration,
+		DefaultDutchStepDecay,
+		DefaultDutchFloorPrice,
+		DefaultDutchMaxDuration,
+		DefaultMinBidIncrement,
+		DefaultMinLotDecrement,
+		DefaultResultRetention,
+		DefaultAuctionDurations,
 	)
 }
 
@@ -58,6 +118,15 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
 		{Key: KeyAuctionBidDuration, Value: &p.BidDuration},
 		{Key: KeyAuctionDuration, Value: &p.MaxAuctionDuration},
+		{Key: KeyDutchStartPremium, Value: &p.DutchStartPremium},
+		{Key: KeyDutchStepDuration, Value: &p.DutchStepDuration},
+		{Key: KeyDutchStepDecay, Value: &p.DutchStepDecay},
+		{Key: KeyDutchFloorPrice, Value: &p.DutchFloorPrice},
+		{Key: KeyDutchMaxDuration, Value: &p.DutchMaxDuration},
+		{Key: KeyMinBidIncrement, Value: &p.MinBidIncrement},
+		{Key: KeyMinLotDecrement, Value: &p.MinLotDecrement},
+		{Key: KeyResultRetention, Value: &p.ResultRetention},
+		{Key: KeyAuctionDurations, Value: &p.AuctionDurations},
 	}
 }
 
@@ -72,7 +141,17 @@ func (p Params) Equal(p2 Params) bool {
 func (p Params) String() string {
 	return fmt.Sprintf(`Auction Params:
 	Max Auction Duration: %s
-	Bid Duration: %s`, p.MaxAuctionDuration, p.BidDuration)
+	Bid Duration: %s
+	Dutch Start Premium: %s
+	Dutch Step Duration: %s
+	Dutch Step Decay: %s
+	Dutch Floor Price: %s
+	Dutch Max Duration: %s
+	Min Bid Increment: %s
+	Min Lot Decrement: %s
+	Result Retention: %s
+	Auction Durations: %s`, p.MaxAuctionDuration, p.BidDuration, p.DutchStartPremium, p.DutchStepDuration, p.DutchStepDecay, p.DutchFloorPrice, p.DutchMaxDuration,
+		p.MinBidIncrement, p.MinLotDecrement, p.ResultRetention, p.AuctionDurations)
 }
 
 // Validate checks that the parameters have valid values.
@@ -86,5 +165,126 @@ func (p Params) Validate() error {
 	if p.BidDuration > p.MaxAuctionDuration {
 		return sdk.ErrInternal("bid duration param cannot be larger than max auction duration")
 	}
+	if p.DutchStartPremium.IsNil() || p.DutchStartPremium.IsNegative() {
+		return sdk.ErrInternal("dutch start premium cannot be negative")
+	}
+	if p.DutchStepDuration <= 0 {
+		return sdk.ErrInternal("dutch step duration must be positive")
+	}
+	if p.DutchStepDecay.IsNil() || !p.DutchStepDecay.IsPositive() || !p.DutchStepDecay.LT(sdk.OneDec()) {
+		return sdk.ErrInternal("dutch step decay must be between 0 and 1")
+	}
+	if p.DutchFloorPrice.IsNil() || !p.DutchFloorPrice.IsPositive() || p.DutchFloorPrice.GT(sdk.OneDec()) {
+		return sdk.ErrInternal("dutch floor price must be greater than 0 and at most 1")
+	}
+	if p.DutchMaxDuration <= 0 {
+		return sdk.ErrInternal("dutch max duration must be positive")
+	}
+	if p.MinBidIncrement.IsNil() || p.MinBidIncrement.IsNegative() {
+		return sdk.ErrInternal("min bid increment cannot be negative")
+	}
+	if p.MinLotDecrement.IsNil() || p.MinLotDecrement.IsNegative() || !p.MinLotDecrement.LT(sdk.OneDec()) {
+		return sdk.ErrInternal("min lot decrement must be at least 0 and less than 1")
+	}
+	if p.ResultRetention <= 0 {
+		return sdk.ErrInternal("result retention must be positive")
+	}
+	if err := p.AuctionDurations.Validate(); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// DurationsFor returns the max auction duration and bid duration for auctions of the input type selling the input lot denom.
+// An override for the type and lot denom takes precedence over one for the whole type, which takes precedence over the
+// MaxAuctionDuration and BidDuration params.
+func (p Params) DurationsFor(auctionType string, lotDenom string) (maxAuctionDuration time.Duration, bidDuration time.Duration) {
+	maxAuctionDuration, bidDuration = p.MaxAuctionDuration, p.BidDuration
+	for _, ad := range p.AuctionDurations {
+		if ad.AuctionType != auctionType {
+			continue
+		}
+		if ad.LotDenom == lotDenom {
+			return ad.MaxAuctionDuration, ad.BidDuration
+		}
+		if ad.LotDenom == "" {
+			maxAuctionDuration, bidDuration = ad.MaxAuctionDuration, ad.BidDuration
+		}
+	}
+	return maxAuctionDuration, bidDuration
+}
+
+// AuctionDuration overrides the max auction duration and bid duration params for auctions of one type,
+// either for all lot denoms or, if LotDenom is set, only for auctions selling that denom.
+type AuctionDuration struct {
+	AuctionType        string        `json:"auction_type" yaml:"auction_type"`
+	LotDenom           string        `json:"lot_denom" yaml:"lot_denom"` // empty to apply to every lot denom
+	MaxAuctionDuration time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"`
+	BidDuration        time.Duration `json:"bid_duration" yaml:"bid_duration"`
+}
+
+// NewAuctionDuration returns a new AuctionDuration
+func NewAuctionDuration(auctionType string, lotDenom string, maxAuctionDuration time.Duration, bidDuration time.Duration) AuctionDuration {
+	return AuctionDuration{
+		AuctionType:        auctionType,
+		LotDenom:           lotDenom,
+		MaxAuctionDuration: maxAuctionDuration,
+		BidDuration:        bidDuration,
+	}
+}
+
+// String implements fmt.Stringer
+func (ad AuctionDuration) String() string {
+	return fmt.Sprintf(`Auction Duration:
+	Auction Type: %s
+	Lot Denom: %s
+	Max Auction Duration: %s
+	Bid Duration: %s`, ad.AuctionType, ad.LotDenom, ad.MaxAuctionDuration, ad.BidDuration)
+}
+
+// Validate checks the auction type is one with a bid duration and the durations are valid
+func (ad AuctionDuration) Validate() error {
+	switch ad.AuctionType {
+	case SurplusAuctionType, DebtAuctionType, CollateralAuctionType:
+	default:
+		return fmt.Errorf("invalid auction duration auction type %s", ad.AuctionType)
+	}
+	if ad.BidDuration < 0 {
+		return fmt.Errorf("bid duration cannot be negative, is %s for %s", ad.BidDuration, ad.AuctionType)
+	}
+	if ad.MaxAuctionDuration < 0 {
+		return fmt.Errorf("max auction duration cannot be negative, is %s for %s", ad.MaxAuctionDuration, ad.AuctionType)
+	}
+	if ad.BidDuration > ad.MaxAuctionDuration {
+		return fmt.Errorf("bid duration cannot be larger than max auction duration for %s", ad.AuctionType)
+	}
+	return nil
+}
+
+// AuctionDurations is a slice of AuctionDuration
+type AuctionDurations []AuctionDuration
+
+// String implements fmt.Stringer
+func (ads AuctionDurations) String() string {
+	out := "Auction Durations\n"
+	for _, ad := range ads {
+		out += fmt.Sprintf("%s\n", ad)
+	}
+	return out
+}
+
+// Validate checks each auction duration is valid and that there is at most one per auction type and lot denom
+func (ads AuctionDurations) Validate() error {
+	seen := map[string]bool{}
+	for _, ad := range ads {
+		if err := ad.Validate(); err != nil {
+			return err
+		}
+		key := ad.AuctionType + "/" + ad.LotDenom
+		if seen[key] {
+			return fmt.Errorf("duplicate auction duration for auction type %s and lot denom %q", ad.AuctionType, ad.LotDenom)
+		}
+		seen[key] = true
+	}
 	return nil
 }
@@ -1,9 +1,11 @@
 package types
 
 import (
-	"github.com/stretchr/testify/require"
 	"testing"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParams_Validate(t *testing.T) {
@@ -13,19 +15,51 @@ func TestParams_Validate(t *testing.T) {
 		name               string
 		MaxAuctionDuration time.Duration
 		BidDuration        time.Duration
+		DutchStartPremium  sdk.Dec
+		DutchStepDuration  time.Duration
+		DutchStepDecay     sdk.Dec
+		DutchFloorPrice    sdk.Dec
+		DutchMaxDuration   time.Duration
+		MinBidIncrement    sdk.Dec
+		MinLotDecrement    sdk.Dec
+		ResultRetention    time.Duration
 		expectErr          bool
 	}{
-		{"normal", 24 * time.Hour, 1 * time.Hour, false},
-		{"negativeBid", 24 * time.Hour, -1 * time.Hour, true},
-		{"negativeAuction", -24 * time.Hour, 1 * time.Hour, true},
-		{"bid>auction", 1 * time.Hour, 24 * time.Hour, true},
-		{"zeros", 0, 0, false},
+		{"normal", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, false},
+		{"negativeBid", 24 * time.Hour, -1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"negativeAuction", -24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"bid>auction", 1 * time.Hour, 24 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"zeros", 0, 0, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, false},
+		{"zeroPremium", 24 * time.Hour, 1 * time.Hour, sdk.ZeroDec(), DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, false},
+		{"negativePremium", 24 * time.Hour, 1 * time.Hour, sdk.MustNewDecFromStr("-0.1"), DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"nilPremium", 24 * time.Hour, 1 * time.Hour, sdk.Dec{}, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"zeroStep", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, 0, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"zeroDecay", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, sdk.ZeroDec(), DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"zeroIncrements", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, sdk.ZeroDec(), sdk.ZeroDec(), DefaultResultRetention, false},
+		{"negativeIncrement", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, sdk.MustNewDecFromStr("-0.01"), DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"negativeDecrement", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, sdk.MustNewDecFromStr("-0.01"), DefaultResultRetention, true},
+		{"oneDecrement", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, sdk.OneDec(), DefaultResultRetention, true},
+		{"oneDecay", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, sdk.OneDec(), DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"zeroFloor", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, sdk.ZeroDec(), DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"oneFloor", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, sdk.OneDec(), DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, false},
+		{"floorAboveReference", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, sdk.MustNewDecFromStr("1.1"), DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"zeroDutchDuration", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, 0, DefaultMinBidIncrement, DefaultMinLotDecrement, DefaultResultRetention, true},
+		{"zeroRetention", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, 0, true},
+		{"negativeRetention", 24 * time.Hour, 1 * time.Hour, DefaultDutchStartPremium, DefaultDutchStepDuration, DefaultDutchStepDecay, DefaultDutchFloorPrice, DefaultDutchMaxDuration, DefaultMinBidIncrement, DefaultMinLotDecrement, -1 * time.Hour, true},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			p := Params{
 				MaxAuctionDuration: tc.MaxAuctionDuration,
 				BidDuration:        tc.BidDuration,
+				DutchStartPremium:  tc.DutchStartPremium,
+				DutchStepDuration:  tc.DutchStepDuration,
+				DutchStepDecay:     tc.DutchStepDecay,
+				DutchFloorPrice:    tc.DutchFloorPrice,
+				DutchMaxDuration:   tc.DutchMaxDuration,
+				MinBidIncrement:    tc.MinBidIncrement,
+				MinLotDecrement:    tc.MinLotDecrement,
+				ResultRetention:    tc.ResultRetention,
 			}
 			err := p.Validate()
 			if tc.expectErr {
@@ -36,3 +70,68 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestAuctionDurations_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		durations AuctionDurations
+		expectErr bool
+	}{
+		{"empty", AuctionDurations{}, false},
+		{"normal", AuctionDurations{
+			NewAuctionDuration(SurplusAuctionType, "", 24*time.Hour, time.Hour),
+			NewAuctionDuration(SurplusAuctionType, "ukava", 12*time.Hour, time.Hour),
+			NewAuctionDuration(CollateralAuctionType, "bnb", time.Hour, 10*time.Minute),
+		}, false},
+		{"zeros", AuctionDurations{NewAuctionDuration(DebtAuctionType, "", 0, 0)}, false},
+		{"dutchType", AuctionDurations{NewAuctionDuration(DutchCollateralAuctionType, "", 24*time.Hour, time.Hour)}, true},
+		{"unknownType", AuctionDurations{NewAuctionDuration("lottery", "", 24*time.Hour, time.Hour)}, true},
+		{"negativeBid", AuctionDurations{NewAuctionDuration(DebtAuctionType, "", 24*time.Hour, -time.Hour)}, true},
+		{"negativeAuction", AuctionDurations{NewAuctionDuration(DebtAuctionType, "", -24*time.Hour, time.Hour)}, true},
+		{"bid>auction", AuctionDurations{NewAuctionDuration(DebtAuctionType, "", time.Hour, 24*time.Hour)}, true},
+		{"duplicate", AuctionDurations{
+			NewAuctionDuration(CollateralAuctionType, "bnb", 24*time.Hour, time.Hour),
+			NewAuctionDuration(CollateralAuctionType, "bnb", 12*time.Hour, time.Hour),
+		}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := DefaultParams()
+			p.AuctionDurations = tc.durations
+			err := p.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_DurationsFor(t *testing.T) {
+	p := DefaultParams()
+	p.AuctionDurations = AuctionDurations{
+		NewAuctionDuration(CollateralAuctionType, "bnb", 2*time.Hour, 10*time.Minute),
+		NewAuctionDuration(CollateralAuctionType, "", 12*time.Hour, 30*time.Minute),
+		NewAuctionDuration(SurplusAuctionType, "ukava", 7*24*time.Hour, 6*time.Hour),
+	}
+	testCases := []struct {
+		auctionType     string
+		lotDenom        string
+		expectedAuction time.Duration
+		expectedBid     time.Duration
+	}{
+		{CollateralAuctionType, "bnb", 2 * time.Hour, 10 * time.Minute},
+		{CollateralAuctionType, "xrp", 12 * time.Hour, 30 * time.Minute},
+		{SurplusAuctionType, "ukava", 7 * 24 * time.Hour, 6 * time.Hour},
+		{SurplusAuctionType, "usdx", DefaultMaxAuctionDuration, DefaultBidDuration},
+		{DebtAuctionType, "bnb", DefaultMaxAuctionDuration, DefaultBidDuration},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.auctionType+"/"+tc.lotDenom, func(t *testing.T) {
+			maxAuctionDuration, bidDuration := p.DurationsFor(tc.auctionType, tc.lotDenom)
+			require.Equal(t, tc.expectedAuction, maxAuctionDuration)
+			require.Equal(t, tc.expectedBid, bidDuration)
+		})
+	}
+}
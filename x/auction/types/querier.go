@@ -1,10 +1,21 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// QueryGetAuction is the query path for querying one auction
 	QueryGetAuction = "auction"
 	// QueryGetAuctions is the query path for querying all auctions
 	QueryGetAuctions = "auctions"
+	// QueryGetAuctionResult is the query path for querying the result of one closed auction
+	QueryGetAuctionResult = "result"
+	// QueryGetAuctionResults is the query path for querying the results of closed auctions
+	QueryGetAuctionResults = "results"
 	// QueryGetParams is the query path for querying the global auction params
 	QueryGetParams = "params"
 )
@@ -14,18 +25,115 @@ type QueryAuctionParams struct {
 	AuctionID uint64
 }
 
-// QueryAllAuctionParams is the params for an auctions query
+// QueryAllAuctionParams is the params for an auctions query.
+// Empty filters match every auction.
 type QueryAllAuctionParams struct {
-	Page  int `json:"page" yaml:"page"`
-	Limit int `json:"limit" yaml:"limit"`
+	Page      int            `json:"page" yaml:"page"`             // page of matching auctions, pages below one are the first page
+	Limit     int            `json:"limit" yaml:"limit"`           // number of auctions per page, zero returns all matching auctions
+	Type      string         `json:"type" yaml:"type"`             // only auctions of this type
+	Phase     string         `json:"phase" yaml:"phase"`           // only auctions in this phase
+	LotDenom  string         `json:"lot_denom" yaml:"lot_denom"`   // only auctions selling this denom
+	BidDenom  string         `json:"bid_denom" yaml:"bid_denom"`   // only auctions bid on in this denom
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`         // only auctions with this current bidder
+	EndBefore time.Time      `json:"end_before" yaml:"end_before"` // only auctions ending before this time
 }
 
 // NewQueryAllAuctionParams creates a new QueryAllAuctionParams
-func NewQueryAllAuctionParams(page int, limit int) QueryAllAuctionParams {
+func NewQueryAllAuctionParams(page int, limit int, auctionType string, phase string, lotDenom string, bidDenom string, bidder sdk.AccAddress, endBefore time.Time) QueryAllAuctionParams {
 	return QueryAllAuctionParams{
-		Page:  page,
-		Limit: limit,
+		Page:      page,
+		Limit:     limit,
+		Type:      auctionType,
+		Phase:     phase,
+		LotDenom:  lotDenom,
+		BidDenom:  bidDenom,
+		Bidder:    bidder,
+		EndBefore: endBefore,
+	}
+}
+
+// Validate checks that the limit is not negative and the type and phase filters are known auction types and phases
+func (p QueryAllAuctionParams) Validate() error {
+	if p.Limit < 0 {
+		return fmt.Errorf("limit cannot be negative: %d", p.Limit)
+	}
+	switch p.Type {
+	case "", SurplusAuctionType, DebtAuctionType, CollateralAuctionType, DutchCollateralAuctionType:
+	default:
+		return fmt.Errorf("invalid auction type %s", p.Type)
+	}
+	switch p.Phase {
+	case "", ForwardAuctionPhase, ReverseAuctionPhase:
+	default:
+		return fmt.Errorf("invalid auction phase %s", p.Phase)
+	}
+	return nil
+}
+
+// Matches returns true if the input auction passes all the filters
+func (p QueryAllAuctionParams) Matches(a Auction) bool {
+	if p.Type != "" && a.GetType() != p.Type {
+		return false
+	}
+	if p.Phase != "" && a.GetPhase() != p.Phase {
+		return false
+	}
+	if p.LotDenom != "" && a.GetLot().Denom != p.LotDenom {
+		return false
+	}
+	if p.BidDenom != "" && a.GetBid().Denom != p.BidDenom {
+		return false
+	}
+	if !p.Bidder.Empty() && !a.GetBidder().Equals(p.Bidder) {
+		return false
+	}
+	if !p.EndBefore.IsZero() && !a.GetEndTime().Before(p.EndBefore) {
+		return false
+	}
+	return true
+}
+
+// QueryAuctionResultsParams is the params for an auction results query.
+// Empty filters match every result.
+type QueryAuctionResultsParams struct {
+	Page        int            `json:"page" yaml:"page"`                 // page of matching results, pages below one are the first page
+	Limit       int            `json:"limit" yaml:"limit"`               // number of results per page, zero returns all matching results
+	Bidder      sdk.AccAddress `json:"bidder" yaml:"bidder"`             // only results won by this bidder
+	CloseAfter  time.Time      `json:"close_after" yaml:"close_after"`   // only results of auctions closed at or after this time
+	CloseBefore time.Time      `json:"close_before" yaml:"close_before"` // only results of auctions closed before this time
+}
+
+// NewQueryAuctionResultsParams creates a new QueryAuctionResultsParams
+func NewQueryAuctionResultsParams(page int, limit int, bidder sdk.AccAddress, closeAfter time.Time, closeBefore time.Time) QueryAuctionResultsParams {
+	return QueryAuctionResultsParams{
+		Page:        page,
+		Limit:       limit,
+		Bidder:      bidder,
+		CloseAfter:  closeAfter,
+		CloseBefore: closeBefore,
+	}
+}
+
+// Validate checks that the limit is not negative
+func (p QueryAuctionResultsParams) Validate() error {
+	if p.Limit < 0 {
+		return fmt.Errorf("limit cannot be negative: %d", p.Limit)
+	}
+	return nil
+}
+
+// Matches returns true if the input result passes all the filters
+func (p QueryAuctionResultsParams) Matches(r AuctionResult) bool {
+	if !p.Bidder.Empty() && !r.Bidder.Equals(p.Bidder) {
+		return false
+	}
+	if !p.CloseAfter.IsZero() && r.CloseTime.Before(p.CloseAfter) {
+		return false
+	}
+	if !p.CloseBefore.IsZero() && !r.CloseTime.Before(p.CloseBefore) {
+		return false
 	}
+	return true
 }
 
 // AuctionWithPhase augmented type for collateral auctions which includes auction phase for querying
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuctionResult is a compact record of a closed auction.
+// Results are kept in the store for the ResultRetention param after the auction closes.
+type AuctionResult struct {
+	ID          uint64         `json:"id" yaml:"id"`
+	Type        string         `json:"type" yaml:"type"`
+	Initiator   string         `json:"initiator" yaml:"initiator"`
+	Lot         sdk.Coin       `json:"lot" yaml:"lot"`                   // Coins paid out to the winner.
+	Bid         sdk.Coin       `json:"bid" yaml:"bid"`                   // Final price paid for the lot.
+	Bidder      sdk.AccAddress `json:"bidder" yaml:"bidder"`             // Winner of the auction.
+	DebtCovered sdk.Coins      `json:"debt_covered" yaml:"debt_covered"` // Debt paid back by the bids. Empty for auctions that don't cover debt.
+	CloseTime   time.Time      `json:"close_time" yaml:"close_time"`
+}
+
+// NewAuctionResult returns a record of the input auction closed at the input time.
+func NewAuctionResult(a Auction, closeTime time.Time) AuctionResult {
+	var debtCovered sdk.Coins
+	switch auc := a.(type) {
+	case DebtAuction:
+		debtCovered = sdk.NewCoins(auc.DebtCovered)
+	case CollateralAuction:
+		debtCovered = sdk.NewCoins(auc.DebtCovered)
+	case DutchCollateralAuction:
+		debtCovered = sdk.NewCoins(auc.DebtCovered)
+	}
+	if debtCovered.Empty() {
+		debtCovered = nil // the codec decodes empty coins as nil, so store them that way
+	}
+	return AuctionResult{
+		ID:          a.GetID(),
+		Type:        a.GetType(),
+		Initiator:   a.GetInitiator(),
+		Lot:         a.GetLot(),
+		Bid:         a.GetBid(),
+		Bidder:      a.GetBidder(),
+		DebtCovered: debtCovered,
+		CloseTime:   closeTime,
+	}
+}
+
+// Price returns the realized price of one unit of lot, in units of the bid denom.
+// It returns zero if no lot was sold.
+func (r AuctionResult) Price() sdk.Dec {
+	if !r.Lot.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(r.Bid.Amount).Quo(sdk.NewDecFromInt(r.Lot.Amount))
+}
+
+// Validate performs basic validation of an auction result.
+func (r AuctionResult) Validate() error {
+	if r.ID == 0 {
+		return fmt.Errorf("auction result ID cannot be zero")
+	}
+	switch r.Type {
+	case SurplusAuctionType, DebtAuctionType, CollateralAuctionType, DutchCollateralAuctionType:
+	default:
+		return fmt.Errorf("invalid auction result type %s", r.Type)
+	}
+	if !r.Lot.IsValid() {
+		return fmt.Errorf("invalid auction result lot: %s", r.Lot)
+	}
+	if !r.Bid.IsValid() {
+		return fmt.Errorf("invalid auction result bid: %s", r.Bid)
+	}
+	if !r.DebtCovered.IsValid() {
+		return fmt.Errorf("invalid auction result debt covered: %s", r.DebtCovered)
+	}
+	if r.CloseTime.IsZero() {
+		return fmt.Errorf("auction result close time cannot be zero")
+	}
+	return nil
+}
+
+func (r AuctionResult) String() string {
+	return fmt.Sprintf(`Auction Result %d:
+  Type:          %s
+  Initiator:     %s
+  Lot:           %s
+  Bidder:        %s
+  Bid:           %s
+  Price:         %s
+  Debt Covered:  %s
+  Close Time:    %s`,
+		r.ID, r.Type, r.Initiator, r.Lot, r.Bidder, r.Bid, r.Price(), r.DebtCovered, r.CloseTime,
+	)
+}
+
+// AuctionResults is a slice of auction results.
+type AuctionResults []AuctionResult
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuctionResult(t *testing.T) {
+	closeTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	auction := NewCollateralAuction("seller", c("lotdenom", 20), closeTime, c("biddenom", 100), WeightedAddresses{}, c("debt", 100)).WithID(5).(CollateralAuction)
+	auction.Bid = c("biddenom", 50)
+	auction.DebtCovered = c("debt", 50)
+
+	result := NewAuctionResult(auction, closeTime)
+	require.Equal(t, uint64(5), result.ID)
+	require.Equal(t, CollateralAuctionType, result.Type)
+	require.Equal(t, sdk.NewCoins(c("debt", 50)), result.DebtCovered)
+	require.Equal(t, sdk.MustNewDecFromStr("2.5"), result.Price())
+	require.NoError(t, result.Validate())
+
+	// auctions that don't cover debt record none
+	surplusResult := NewAuctionResult(NewSurplusAuction("seller", c("lotdenom", 20), "biddenom", closeTime).WithID(6), closeTime)
+	require.Nil(t, surplusResult.DebtCovered)
+	require.NoError(t, surplusResult.Validate())
+
+	// no lot sold has no price
+	auction.Lot = c("lotdenom", 0)
+	require.Equal(t, sdk.ZeroDec(), NewAuctionResult(auction, closeTime).Price())
+
+	// invalid results
+	invalid := result
+	invalid.ID = 0
+	require.Error(t, invalid.Validate())
+	invalid = result
+	invalid.Type = "lottery"
+	require.Error(t, invalid.Validate())
+	invalid = result
+	invalid.CloseTime = time.Time{}
+	require.Error(t, invalid.Validate())
+}
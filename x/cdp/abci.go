@@ -8,17 +8,41 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-// BeginBlocker compounds the debt in outstanding cdps and liquidates cdps that are below the required collateralization ratio
+// BeginBlocker compounds the debt in outstanding cdps and liquidates cdps that are below the required collateralization ratio.
+// While the circuit breaker is tripped fees continue to accrue, but no cdps are liquidated.
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 	params := k.GetParams(ctx)
+	if params.CircuitBreaker {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeCircuitBreaker,
+				sdk.NewAttribute(sdk.AttributeKeyModule, fmt.Sprintf("%s", ModuleName)),
+			),
+		)
+	}
 	previousBlockTime, found := k.GetPreviousBlockTime(ctx)
 	if !found {
 		previousBlockTime = ctx.BlockTime()
 	}
 	timeElapsed := sdk.NewInt(ctx.BlockTime().Unix() - previousBlockTime.Unix())
+	// cdps can also be liquidated by keepers with MsgLiquidate, so the scan for cdps to liquidate may be rate limited
+	// param changes are not validated, so intervals below one scan every block rather than divide by zero
+	scanForLiquidations := params.LiquidationBlockInterval <= 1 || ctx.BlockHeight()%params.LiquidationBlockInterval == 0
 	for _, cp := range params.CollateralParams {
 		for _, dp := range params.DebtParams {
-			k.HandleNewDebt(ctx, cp.Denom, dp.Denom, timeElapsed)
+			err := k.HandleNewDebt(ctx, cp.Denom, dp.Denom, timeElapsed)
+			if err != nil {
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						EventTypeBeginBlockerFatal,
+						sdk.NewAttribute(sdk.AttributeKeyModule, fmt.Sprintf("%s", ModuleName)),
+						sdk.NewAttribute(types.AttributeKeyError, fmt.Sprintf("%s", err)),
+					),
+				)
+			}
+		}
+		if params.CircuitBreaker || !scanForLiquidations {
+			continue
 		}
 
 		err := k.LiquidateCdps(ctx, cp.MarketID, cp.Denom, cp.LiquidationRatio)
@@ -145,10 +145,73 @@ func (suite *ModuleTestSuite) TestBeginBlock() {
 	suite.Equal(len(suite.liquidations.btc), btcLiquidations)
 
 	acc = sk.GetModuleAccount(suite.ctx, auction.ModuleName)
-	suite.Equal(suite.liquidations.debt, acc.GetCoins().AmountOf("debt").Int64())
+	suite.Equal(suite.liquidations.debt, acc.GetCoins().AmountOf("debtusdx").Int64())
 
 }
 
+func (suite *ModuleTestSuite) TestBeginBlockCircuitBreaker() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CircuitBreaker = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	sk := suite.app.GetSupplyKeeper()
+	acc := sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
+	originalXrpCollateral := acc.GetCoins().AmountOf("xrp")
+	suite.setPrice(d("0.2"), "xrp:usd")
+	cdp.BeginBlocker(suite.ctx, abci.RequestBeginBlock{Header: suite.ctx.BlockHeader()}, suite.keeper)
+	acc = sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
+	suite.Equal(originalXrpCollateral, acc.GetCoins().AmountOf("xrp"))
+	suite.Equal(len(suite.cdps), len(suite.keeper.GetAllCdps(suite.ctx)))
+
+	params.CircuitBreaker = false
+	suite.keeper.SetParams(suite.ctx, params)
+	cdp.BeginBlocker(suite.ctx, abci.RequestBeginBlock{Header: suite.ctx.BlockHeader()}, suite.keeper)
+	acc = sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
+	seizedXrpCollateral := originalXrpCollateral.Sub(acc.GetCoins().AmountOf("xrp"))
+	suite.Equal(len(suite.liquidations.xrp), int(seizedXrpCollateral.Quo(i(10000000000)).Int64()))
+}
+
+func (suite *ModuleTestSuite) TestBeginBlockLiquidationInterval() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	params.LiquidationBlockInterval = 2
+	suite.keeper.SetParams(suite.ctx, params)
+
+	sk := suite.app.GetSupplyKeeper()
+	acc := sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
+	originalXrpCollateral := acc.GetCoins().AmountOf("xrp")
+	suite.setPrice(d("0.2"), "xrp:usd")
+	cdp.BeginBlocker(suite.ctx, abci.RequestBeginBlock{Header: suite.ctx.BlockHeader()}, suite.keeper)
+	acc = sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
+	suite.Equal(originalXrpCollateral, acc.GetCoins().AmountOf("xrp"))
+
+	suite.ctx = suite.ctx.WithBlockHeight(2)
+	cdp.BeginBlocker(suite.ctx, abci.RequestBeginBlock{Header: suite.ctx.BlockHeader()}, suite.keeper)
+	acc = sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
+	seizedXrpCollateral := originalXrpCollateral.Sub(acc.GetCoins().AmountOf("xrp"))
+	suite.Equal(len(suite.liquidations.xrp), int(seizedXrpCollateral.Quo(i(10000000000)).Int64()))
+}
+
+func (suite *ModuleTestSuite) TestBeginBlockZeroLiquidationInterval() {
+	suite.createCdps()
+	// param change proposals are not validated, so the interval may be set to zero
+	params := suite.keeper.GetParams(suite.ctx)
+	params.LiquidationBlockInterval = 0
+	suite.keeper.SetParams(suite.ctx, params)
+
+	sk := suite.app.GetSupplyKeeper()
+	acc := sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
+	originalXrpCollateral := acc.GetCoins().AmountOf("xrp")
+	suite.setPrice(d("0.2"), "xrp:usd")
+	suite.NotPanics(func() {
+		cdp.BeginBlocker(suite.ctx, abci.RequestBeginBlock{Header: suite.ctx.BlockHeader()}, suite.keeper)
+	})
+	acc = sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
+	seizedXrpCollateral := originalXrpCollateral.Sub(acc.GetCoins().AmountOf("xrp"))
+	suite.Equal(len(suite.liquidations.xrp), int(seizedXrpCollateral.Quo(i(10000000000)).Int64()))
+}
+
 func (suite *ModuleTestSuite) TestSeizeSingleCdpWithFees() {
 	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], cs(c("xrp", 10000000000)), cs(c("usdx", 1000000000)))
 	suite.NoError(err)
@@ -157,20 +220,22 @@ func (suite *ModuleTestSuite) TestSeizeSingleCdpWithFees() {
 	suite.Equal(i(1000000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx"))
 	sk := suite.app.GetSupplyKeeper()
 	cdpMacc := sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
-	suite.Equal(i(1000000000), cdpMacc.GetCoins().AmountOf("debt"))
+	suite.Equal(i(1000000000), cdpMacc.GetCoins().AmountOf("debtusdx"))
 	for i := 0; i < 100; i++ {
 		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 6))
 		cdp.BeginBlocker(suite.ctx, abci.RequestBeginBlock{Header: suite.ctx.BlockHeader()}, suite.keeper)
 	}
 
 	cdpMacc = sk.GetModuleAccount(suite.ctx, cdp.ModuleName)
-	suite.Equal(i(1000000900), (cdpMacc.GetCoins().AmountOf("debt")))
+	suite.Equal(i(1000000928), (cdpMacc.GetCoins().AmountOf("debtusdx")))
 	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", 1)
 
 	timeElapsed := sdk.NewInt(suite.ctx.BlockTime().Unix() - previousBlockTime.Unix())
 
-	fees := suite.keeper.CalculateFees(suite.ctx, cdp.Principal, timeElapsed, "xrp")
+	fees := suite.keeper.CalculateFees(suite.ctx, cs(c("usdx", 1000000000)), timeElapsed, "xrp")
 	suite.Equal(i(928), fees.AmountOf("usdx"))
+	// fees compounded block by block through the interest factor match the fees compounded over the whole period
+	suite.Equal(cs(c("usdx", 1000000000)).Add(fees), suite.keeper.CalculateDebt(suite.ctx, cdp))
 
 	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
 	suite.NoError(err)
@@ -29,6 +29,16 @@ const (
 	CodeBelowDebtFloor              = types.CodeBelowDebtFloor
 	CodePaymentExceedsDebt          = types.CodePaymentExceedsDebt
 	CodeLoadingAugmentedCDP         = types.CodeLoadingAugmentedCDP
+	CodeCircuitBreakerTripped       = types.CodeCircuitBreakerTripped
+	CodeCdpNotLiquidatable          = types.CodeCdpNotLiquidatable
+	CodePriceStale                  = types.CodePriceStale
+	CodeCollateralExists            = types.CodeCollateralExists
+	CodeMarketNotFound              = types.CodeMarketNotFound
+	CodeMarketInUse                 = types.CodeMarketInUse
+	CodeInvalidProposal             = types.CodeInvalidProposal
+	CodeSavingsDepositNotFound      = types.CodeSavingsDepositNotFound
+	CodeInsufficientSavings         = types.CodeInsufficientSavings
+	CodeInvalidSavingsAmount        = types.CodeInvalidSavingsAmount
 	EventTypeCreateCdp              = types.EventTypeCreateCdp
 	EventTypeCdpDeposit             = types.EventTypeCdpDeposit
 	EventTypeCdpDraw                = types.EventTypeCdpDraw
@@ -37,80 +47,124 @@ const (
 	EventTypeCdpWithdrawal          = types.EventTypeCdpWithdrawal
 	EventTypeCdpLiquidation         = types.EventTypeCdpLiquidation
 	EventTypeBeginBlockerFatal      = types.EventTypeBeginBlockerFatal
+	EventTypeCircuitBreaker         = types.EventTypeCircuitBreaker
+	EventTypeCdpKeeperReward        = types.EventTypeCdpKeeperReward
+	EventTypeSavingsDeposit         = types.EventTypeSavingsDeposit
+	EventTypeSavingsWithdrawal      = types.EventTypeSavingsWithdrawal
+	EventTypeSavingsRate            = types.EventTypeSavingsRate
 	AttributeKeyCdpID               = types.AttributeKeyCdpID
 	AttributeKeyDepositor           = types.AttributeKeyDepositor
 	AttributeValueCategory          = types.AttributeValueCategory
 	AttributeKeyError               = types.AttributeKeyError
+	AttributeKeyKeeper              = types.AttributeKeyKeeper
 	ModuleName                      = types.ModuleName
 	StoreKey                        = types.StoreKey
 	RouterKey                       = types.RouterKey
 	QuerierRoute                    = types.QuerierRoute
 	DefaultParamspace               = types.DefaultParamspace
 	LiquidatorMacc                  = types.LiquidatorMacc
+	SavingsMacc                     = types.SavingsMacc
 	QueryGetCdp                     = types.QueryGetCdp
 	QueryGetCdps                    = types.QueryGetCdps
 	QueryGetCdpsByCollateralization = types.QueryGetCdpsByCollateralization
 	QueryGetParams                  = types.QueryGetParams
+	QueryGetSavingsDeposit          = types.QueryGetSavingsDeposit
+	QueryGetSavingsRate             = types.QueryGetSavingsRate
 	RestOwner                       = types.RestOwner
 	RestCollateralDenom             = types.RestCollateralDenom
 	RestRatio                       = types.RestRatio
+	RestDepositor                   = types.RestDepositor
+	ProposalTypeAddCollateral       = types.ProposalTypeAddCollateral
+	ProposalTypeUpdateCollateral    = types.ProposalTypeUpdateCollateral
+	RateModelFlat                   = types.RateModelFlat
+	RateModelUtilization            = types.RateModelUtilization
+	RateModelKink                   = types.RateModelKink
 )
 
 var (
 	// functions aliases
-	NewCDP                      = types.NewCDP
-	RegisterCodec               = types.RegisterCodec
-	NewDeposit                  = types.NewDeposit
-	ErrCdpAlreadyExists         = types.ErrCdpAlreadyExists
-	ErrInvalidCollateralLength  = types.ErrInvalidCollateralLength
-	ErrCollateralNotSupported   = types.ErrCollateralNotSupported
-	ErrDebtNotSupported         = types.ErrDebtNotSupported
-	ErrExceedsDebtLimit         = types.ErrExceedsDebtLimit
-	ErrInvalidCollateralRatio   = types.ErrInvalidCollateralRatio
-	ErrCdpNotFound              = types.ErrCdpNotFound
-	ErrDepositNotFound          = types.ErrDepositNotFound
-	ErrInvalidDepositDenom      = types.ErrInvalidDepositDenom
-	ErrInvalidPaymentDenom      = types.ErrInvalidPaymentDenom
-	ErrDepositNotAvailable      = types.ErrDepositNotAvailable
-	ErrInvalidCollateralDenom   = types.ErrInvalidCollateralDenom
-	ErrInvalidWithdrawAmount    = types.ErrInvalidWithdrawAmount
-	ErrCdpNotAvailable          = types.ErrCdpNotAvailable
-	ErrBelowDebtFloor           = types.ErrBelowDebtFloor
-	ErrPaymentExceedsDebt       = types.ErrPaymentExceedsDebt
-	ErrLoadingAugmentedCDP      = types.ErrLoadingAugmentedCDP
-	DefaultGenesisState         = types.DefaultGenesisState
-	GetCdpIDBytes               = types.GetCdpIDBytes
-	GetCdpIDFromBytes           = types.GetCdpIDFromBytes
-	CdpKey                      = types.CdpKey
-	SplitCdpKey                 = types.SplitCdpKey
-	DenomIterKey                = types.DenomIterKey
-	SplitDenomIterKey           = types.SplitDenomIterKey
-	DepositKey                  = types.DepositKey
-	SplitDepositKey             = types.SplitDepositKey
-	DepositIterKey              = types.DepositIterKey
-	SplitDepositIterKey         = types.SplitDepositIterKey
-	CollateralRatioBytes        = types.CollateralRatioBytes
-	CollateralRatioKey          = types.CollateralRatioKey
-	SplitCollateralRatioKey     = types.SplitCollateralRatioKey
-	CollateralRatioIterKey      = types.CollateralRatioIterKey
-	SplitCollateralRatioIterKey = types.SplitCollateralRatioIterKey
-	NewMsgCreateCDP             = types.NewMsgCreateCDP
-	NewMsgDeposit               = types.NewMsgDeposit
-	NewMsgWithdraw              = types.NewMsgWithdraw
-	NewMsgDrawDebt              = types.NewMsgDrawDebt
-	NewMsgRepayDebt             = types.NewMsgRepayDebt
-	NewParams                   = types.NewParams
-	DefaultParams               = types.DefaultParams
-	ParamKeyTable               = types.ParamKeyTable
-	NewQueryCdpsParams          = types.NewQueryCdpsParams
-	NewQueryCdpParams           = types.NewQueryCdpParams
-	NewQueryCdpsByRatioParams   = types.NewQueryCdpsByRatioParams
-	ValidSortableDec            = types.ValidSortableDec
-	SortableDecBytes            = types.SortableDecBytes
-	ParseDecBytes               = types.ParseDecBytes
-	RelativePow                 = types.RelativePow
-	NewKeeper                   = keeper.NewKeeper
-	NewQuerier                  = keeper.NewQuerier
+	NewCDP                         = types.NewCDP
+	RegisterCodec                  = types.RegisterCodec
+	NewDeposit                     = types.NewDeposit
+	ErrCdpAlreadyExists            = types.ErrCdpAlreadyExists
+	ErrInvalidCollateralLength     = types.ErrInvalidCollateralLength
+	ErrCollateralNotSupported      = types.ErrCollateralNotSupported
+	ErrDebtNotSupported            = types.ErrDebtNotSupported
+	ErrExceedsDebtLimit            = types.ErrExceedsDebtLimit
+	ErrInvalidCollateralRatio      = types.ErrInvalidCollateralRatio
+	ErrCdpNotFound                 = types.ErrCdpNotFound
+	ErrDepositNotFound             = types.ErrDepositNotFound
+	ErrInvalidDepositDenom         = types.ErrInvalidDepositDenom
+	ErrInvalidPaymentDenom         = types.ErrInvalidPaymentDenom
+	ErrDepositNotAvailable         = types.ErrDepositNotAvailable
+	ErrInvalidCollateralDenom      = types.ErrInvalidCollateralDenom
+	ErrInvalidWithdrawAmount       = types.ErrInvalidWithdrawAmount
+	ErrCdpNotAvailable             = types.ErrCdpNotAvailable
+	ErrBelowDebtFloor              = types.ErrBelowDebtFloor
+	ErrPaymentExceedsDebt          = types.ErrPaymentExceedsDebt
+	ErrLoadingAugmentedCDP         = types.ErrLoadingAugmentedCDP
+	ErrCircuitBreakerTripped       = types.ErrCircuitBreakerTripped
+	ErrCdpIDNotFound               = types.ErrCdpIDNotFound
+	ErrCdpNotLiquidatable          = types.ErrCdpNotLiquidatable
+	ErrPriceStale                  = types.ErrPriceStale
+	ErrCollateralExists            = types.ErrCollateralExists
+	ErrMarketNotFound              = types.ErrMarketNotFound
+	ErrMarketInUse                 = types.ErrMarketInUse
+	ErrInvalidProposal             = types.ErrInvalidProposal
+	ErrSavingsDepositNotFound      = types.ErrSavingsDepositNotFound
+	ErrInsufficientSavings         = types.ErrInsufficientSavings
+	ErrInvalidSavingsAmount        = types.ErrInvalidSavingsAmount
+	DefaultGenesisState            = types.DefaultGenesisState
+	GetCdpIDBytes                  = types.GetCdpIDBytes
+	GetCdpIDFromBytes              = types.GetCdpIDFromBytes
+	CdpKey                         = types.CdpKey
+	SplitCdpKey                    = types.SplitCdpKey
+	DenomIterKey                   = types.DenomIterKey
+	SplitDenomIterKey              = types.SplitDenomIterKey
+	DepositKey                     = types.DepositKey
+	SplitDepositKey                = types.SplitDepositKey
+	DepositIterKey                 = types.DepositIterKey
+	SplitDepositIterKey            = types.SplitDepositIterKey
+	CollateralRatioBytes           = types.CollateralRatioBytes
+	CollateralRatioKey             = types.CollateralRatioKey
+	SplitCollateralRatioKey        = types.SplitCollateralRatioKey
+	CollateralRatioIterKey         = types.CollateralRatioIterKey
+	SplitCollateralRatioIterKey    = types.SplitCollateralRatioIterKey
+	NewMsgCreateCDP                = types.NewMsgCreateCDP
+	NewMsgDeposit                  = types.NewMsgDeposit
+	NewMsgWithdraw                 = types.NewMsgWithdraw
+	NewMsgDrawDebt                 = types.NewMsgDrawDebt
+	NewMsgRepayDebt                = types.NewMsgRepayDebt
+	NewMsgLiquidate                = types.NewMsgLiquidate
+	NewMsgDepositSavings           = types.NewMsgDepositSavings
+	NewMsgWithdrawSavings          = types.NewMsgWithdrawSavings
+	NewAddCollateralProposal       = types.NewAddCollateralProposal
+	NewUpdateCollateralProposal    = types.NewUpdateCollateralProposal
+	NewParams                      = types.NewParams
+	NewInterestRateModel           = types.NewInterestRateModel
+	DefaultParams                  = types.DefaultParams
+	ParamKeyTable                  = types.ParamKeyTable
+	NewQueryCdpsParams             = types.NewQueryCdpsParams
+	NewQueryCdpParams              = types.NewQueryCdpParams
+	NewQueryCdpsByRatioParams      = types.NewQueryCdpsByRatioParams
+	NewQuerySavingsDepositParams   = types.NewQuerySavingsDepositParams
+	NewSavingsDeposit              = types.NewSavingsDeposit
+	NewSavingsPosition             = types.NewSavingsPosition
+	NewInterestFactor              = types.NewInterestFactor
+	ValidSortableDec               = types.ValidSortableDec
+	SortableDecBytes               = types.SortableDecBytes
+	ParseDecBytes                  = types.ParseDecBytes
+	RelativePow                    = types.RelativePow
+	NewKeeper                      = keeper.NewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	RegisterInvariants             = keeper.RegisterInvariants
+	AllInvariants                  = keeper.AllInvariants
+	TotalPrincipalInvariant        = keeper.TotalPrincipalInvariant
+	DepositsInvariant              = keeper.DepositsInvariant
+	CollateralRatioIndexInvariant  = keeper.CollateralRatioIndexInvariant
+	SavingsInvariant               = keeper.SavingsInvariant
+	HandleAddCollateralProposal    = keeper.HandleAddCollateralProposal
+	HandleUpdateCollateralProposal = keeper.HandleUpdateCollateralProposal
 
 	// variable aliases
 	ModuleCdc                  = types.ModuleCdc
@@ -123,14 +177,21 @@ var (
 	DepositKeyPrefix           = types.DepositKeyPrefix
 	PrincipalKeyPrefix         = types.PrincipalKeyPrefix
 	PreviousBlockTimeKey       = types.PreviousBlockTimeKey
+	SavingsDepositKeyPrefix    = types.SavingsDepositKeyPrefix
+	TotalSavingsSharesKey      = types.TotalSavingsSharesKey
+	InterestFactorKeyPrefix    = types.InterestFactorKeyPrefix
 	KeyGlobalDebtLimit         = types.KeyGlobalDebtLimit
 	KeyCollateralParams        = types.KeyCollateralParams
 	KeyDebtParams              = types.KeyDebtParams
 	KeyCircuitBreaker          = types.KeyCircuitBreaker
+	KeyLiquidationInterval     = types.KeyLiquidationInterval
+	KeySavingsRate             = types.KeySavingsRate
 	KeyDebtThreshold           = types.KeyDebtThreshold
 	KeySurplusThreshold        = types.KeySurplusThreshold
 	DefaultGlobalDebt          = types.DefaultGlobalDebt
 	DefaultCircuitBreaker      = types.DefaultCircuitBreaker
+	DefaultLiquidationInterval = types.DefaultLiquidationInterval
+	DefaultSavingsRate         = types.DefaultSavingsRate
 	DefaultCollateralParams    = types.DefaultCollateralParams
 	DefaultDebtParams          = types.DefaultDebtParams
 	DefaultCdpStartingID       = types.DefaultCdpStartingID
@@ -143,27 +204,39 @@ var (
 )
 
 type (
-	CDP                    = types.CDP
-	CDPs                   = types.CDPs
-	AugmentedCDP           = types.AugmentedCDP
-	AugmentedCDPs          = types.AugmentedCDPs
-	Deposit                = types.Deposit
-	Deposits               = types.Deposits
-	SupplyKeeper           = types.SupplyKeeper
-	PricefeedKeeper        = types.PricefeedKeeper
-	GenesisState           = types.GenesisState
-	MsgCreateCDP           = types.MsgCreateCDP
-	MsgDeposit             = types.MsgDeposit
-	MsgWithdraw            = types.MsgWithdraw
-	MsgDrawDebt            = types.MsgDrawDebt
-	MsgRepayDebt           = types.MsgRepayDebt
-	Params                 = types.Params
-	CollateralParam        = types.CollateralParam
-	CollateralParams       = types.CollateralParams
-	DebtParam              = types.DebtParam
-	DebtParams             = types.DebtParams
-	QueryCdpsParams        = types.QueryCdpsParams
-	QueryCdpParams         = types.QueryCdpParams
-	QueryCdpsByRatioParams = types.QueryCdpsByRatioParams
-	Keeper                 = keeper.Keeper
+	CDP                       = types.CDP
+	CDPs                      = types.CDPs
+	AugmentedCDP              = types.AugmentedCDP
+	AugmentedCDPs             = types.AugmentedCDPs
+	Deposit                   = types.Deposit
+	Deposits                  = types.Deposits
+	SupplyKeeper              = types.SupplyKeeper
+	PricefeedKeeper           = types.PricefeedKeeper
+	GenesisState              = types.GenesisState
+	MsgCreateCDP              = types.MsgCreateCDP
+	MsgDeposit                = types.MsgDeposit
+	MsgWithdraw               = types.MsgWithdraw
+	MsgDrawDebt               = types.MsgDrawDebt
+	MsgRepayDebt              = types.MsgRepayDebt
+	MsgLiquidate              = types.MsgLiquidate
+	MsgDepositSavings         = types.MsgDepositSavings
+	MsgWithdrawSavings        = types.MsgWithdrawSavings
+	AddCollateralProposal     = types.AddCollateralProposal
+	UpdateCollateralProposal  = types.UpdateCollateralProposal
+	Params                    = types.Params
+	CollateralParam           = types.CollateralParam
+	CollateralParams          = types.CollateralParams
+	DebtParam                 = types.DebtParam
+	DebtParams                = types.DebtParams
+	QueryCdpsParams           = types.QueryCdpsParams
+	QueryCdpParams            = types.QueryCdpParams
+	QueryCdpsByRatioParams    = types.QueryCdpsByRatioParams
+	QuerySavingsDepositParams = types.QuerySavingsDepositParams
+	SavingsDeposit            = types.SavingsDeposit
+	SavingsDeposits           = types.SavingsDeposits
+	SavingsPosition           = types.SavingsPosition
+	InterestFactor            = types.InterestFactor
+	InterestFactors           = types.InterestFactors
+	Keeper                    = keeper.Keeper
+	Hooks                     = keeper.Hooks
 )
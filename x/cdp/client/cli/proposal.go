@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// CollateralProposalJSON defines a proposal that adds or updates a collateral type, with a deposit
+type CollateralProposalJSON struct {
+	Title           string                `json:"title" yaml:"title"`
+	Description     string                `json:"description" yaml:"description"`
+	CollateralParam types.CollateralParam `json:"collateral_param" yaml:"collateral_param"`
+	Deposit         sdk.Coins             `json:"deposit" yaml:"deposit"`
+}
+
+// GetCmdSubmitAddCollateralProposal implements the command to submit an add-collateral proposal
+func GetCmdSubmitAddCollateralProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-collateral [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add a cdp collateral type",
+		Long:  collateralProposalLong("add-collateral", "Add BNB collateral", "Allow cdps to be opened with bnb as collateral", "add a cdp collateral type"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal CollateralProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewAddCollateralProposal(proposal.Title, proposal.Description, proposal.CollateralParam)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+}
+
+// GetCmdSubmitUpdateCollateralProposal implements the command to submit an update-collateral proposal
+func GetCmdSubmitUpdateCollateralProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-collateral [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the params of a cdp collateral type",
+		Long:  collateralProposalLong("update-collateral", "Update BNB collateral", "Raise the bnb debt limit", "update the params of a cdp collateral type. The prefix and conversion factor can't be changed"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal CollateralProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewUpdateCollateralProposal(proposal.Title, proposal.Description, proposal.CollateralParam)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+}
+
+func collateralProposalLong(use, title, description, action string) string {
+	return strings.TrimSpace(
+		fmt.Sprintf(`Submit a proposal to %s along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal %s <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "%s",
+  "description": "%s",
+  "collateral_param": {
+    "denom": "bnb",
+    "liquidation_ratio": "1.500000000000000000",
+    "debt_limit": [
+      {
+        "denom": "usdx",
+        "amount": "1000000000"
+      }
+    ],
+    "stability_fee": "1.000000001547125958",
+    "auction_size": "100000000",
+    "liquidation_penalty": "0.050000000000000000",
+    "prefix": 3,
+    "market_id": "bnb:usd",
+    "conversion_factor": "8",
+    "interest_rate_model": {
+      "type": "kink",
+      "max_fee": "1.000000003022265980",
+      "kink": "0.800000000000000000",
+      "kink_fee": "1.000000001847694957"
+    }
+  },
+  "deposit": [
+    {
+      "denom": "ukava",
+      "amount": "10000000"
+    }
+  ]
+}
+`, action, version.ClientName, use, title, description))
+}
+
+// parseProposalJSON reads and parses a proposal from a JSON file
+func parseProposalJSON(cdc *codec.Codec, proposalFile string, proposal interface{}) error {
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return err
+	}
+	return cdc.UnmarshalJSON(contents, proposal)
+}
+
+// submitProposal generates or broadcasts a msg submitting the proposal content with a deposit from the sender
+func submitProposal(cdc *codec.Codec, content gov.Content, deposit sdk.Coins) error {
+	txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	msg := gov.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
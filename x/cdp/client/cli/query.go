@@ -29,6 +29,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		QueryCdpsByDenomAndRatioCmd(queryRoute, cdc),
 		QueryCdpDepositsCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
+		QuerySavingsDepositCmd(queryRoute, cdc),
+		QuerySavingsRateCmd(queryRoute, cdc),
 	)...)
 
 	return cdpQueryCmd
@@ -225,3 +227,68 @@ func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// QuerySavingsDepositCmd returns the command handler for querying the savings deposit of a depositor
+func QuerySavingsDepositCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "savings [depositor-addr]",
+		Short: "get the savings deposit of a depositor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the savings shares of a depositor and the balance they can currently be withdrawn for.
+
+Example:
+$ %s query %s savings kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			depositor, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQuerySavingsDepositParams(depositor))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSavingsDeposit)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var position types.SavingsPosition
+			cdc.MustUnmarshalJSON(res, &position)
+			return cliCtx.PrintOutput(position)
+		},
+	}
+}
+
+// QuerySavingsRateCmd returns the command handler for querying the savings rate
+func QuerySavingsRateCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "savings-rate",
+		Short: "get the savings rate",
+		Long:  "Get the fraction of stability fees currently paid to savings depositors.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSavingsRate)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var rate sdk.Dec
+			cdc.MustUnmarshalJSON(res, &rate)
+			return cliCtx.PrintOutput(rate)
+		},
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -30,6 +31,9 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdWithdraw(cdc),
 		GetCmdDraw(cdc),
 		GetCmdRepay(cdc),
+		GetCmdLiquidate(cdc),
+		GetCmdDepositSavings(cdc),
+		GetCmdWithdrawSavings(cdc),
 	)...)
 
 	return cdpTxCmd
@@ -72,20 +76,20 @@ $ %s tx %s create 10000000uatom 1000usdx --from myKeyName
 // GetCmdDeposit cli command for depositing to a cdp.
 func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "deposit [owner-addr] [collateral]",
+		Use:   "deposit [owner-addr] [collateral-name] [collateral]",
 		Short: "deposit collateral to an existing cdp",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Add collateral to an existing cdp.
+			fmt.Sprintf(`Add collateral to an existing cdp. Any supported collateral asset can be deposited to a cdp of any collateral type.
 
 Example:
-$ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --from myKeyName
+$ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw uatom 10000000uatom --from myKeyName
 `, version.ClientName, types.ModuleName)),
-		Args: cobra.ExactArgs(2),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			collateral, err := sdk.ParseCoins(args[1])
+			collateral, err := sdk.ParseCoins(args[2])
 			if err != nil {
 				return err
 			}
@@ -93,7 +97,7 @@ $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --f
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgDeposit(owner, cliCtx.GetFromAddress(), collateral)
+			msg := types.NewMsgDeposit(owner, cliCtx.GetFromAddress(), args[1], collateral)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -106,20 +110,20 @@ $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --f
 // GetCmdWithdraw cli command for withdrawing from a cdp.
 func GetCmdWithdraw(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "withdraw [owner-addr] [collateral]",
+		Use:   "withdraw [owner-addr] [collateral-name] [collateral]",
 		Short: "withdraw collateral from an existing cdp",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Remove collateral from an existing cdp.
 
 Example:
-$ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --from myKeyName
+$ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw uatom 10000000uatom --from myKeyName
 `, version.ClientName, types.ModuleName)),
-		Args: cobra.ExactArgs(2),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			collateral, err := sdk.ParseCoins(args[1])
+			collateral, err := sdk.ParseCoins(args[2])
 			if err != nil {
 				return err
 			}
@@ -127,7 +131,7 @@ $ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgWithdraw(owner, cliCtx.GetFromAddress(), collateral)
+			msg := types.NewMsgWithdraw(owner, cliCtx.GetFromAddress(), args[1], collateral)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -196,3 +200,93 @@ $ %s tx %s repay uatom 1000usdx --from myKeyName
 		},
 	}
 }
+
+// GetCmdLiquidate cli command for liquidating an undercollateralized cdp.
+func GetCmdLiquidate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquidate [collateral-name] [cdp-id]",
+		Short: "liquidate a cdp that is below the liquidation ratio",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Liquidate a cdp that is below the liquidation ratio for its collateral, receiving part of its collateral as a reward.
+
+Example:
+$ %s tx %s liquidate uatom 10 --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgLiquidate(cliCtx.GetFromAddress(), args[0], id)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdDepositSavings cli command for depositing debt assets in savings.
+func GetCmdDepositSavings(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-savings [amount]",
+		Short: "deposit debt assets in savings",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit debt assets in savings to earn the savings rate.
+
+Example:
+$ %s tx %s deposit-savings 1000usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDepositSavings(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWithdrawSavings cli command for withdrawing debt assets from savings.
+func GetCmdWithdrawSavings(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-savings [amount]",
+		Short: "withdraw debt assets from savings",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw debt assets, including the savings rate earned, from savings.
+
+Example:
+$ %s tx %s withdraw-savings 1000usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWithdrawSavings(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/kava-labs/kava/x/cdp/client/cli"
+	"github.com/kava-labs/kava/x/cdp/client/rest"
+)
+
+// cdp proposal handlers
+var (
+	AddCollateralProposalHandler    = govclient.NewProposalHandler(cli.GetCmdSubmitAddCollateralProposal, rest.AddCollateralProposalRESTHandler)
+	UpdateCollateralProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateCollateralProposal, rest.UpdateCollateralProposalRESTHandler)
+)
@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// CollateralProposalReq defines the properties of the body of a request for a proposal that adds or updates a collateral type.
+type CollateralProposalReq struct {
+	BaseReq         rest.BaseReq          `json:"base_req" yaml:"base_req"`
+	Title           string                `json:"title" yaml:"title"`
+	Description     string                `json:"description" yaml:"description"`
+	CollateralParam types.CollateralParam `json:"collateral_param" yaml:"collateral_param"`
+	Proposer        sdk.AccAddress        `json:"proposer" yaml:"proposer"`
+	Deposit         sdk.Coins             `json:"deposit" yaml:"deposit"`
+}
+
+// AddCollateralProposalRESTHandler returns the REST handler for submitting add collateral proposals
+func AddCollateralProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_collateral",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req CollateralProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			content := types.NewAddCollateralProposal(req.Title, req.Description, req.CollateralParam)
+			writeProposalResponse(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// UpdateCollateralProposalRESTHandler returns the REST handler for submitting update collateral proposals
+func UpdateCollateralProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_collateral",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req CollateralProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			content := types.NewUpdateCollateralProposal(req.Title, req.Description, req.CollateralParam)
+			writeProposalResponse(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// writeProposalResponse writes an unsigned tx submitting the proposal content with a deposit from the proposer
+func writeProposalResponse(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq, content gov.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg := gov.NewMsgSubmitProposal(content, deposit, proposer)
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+}
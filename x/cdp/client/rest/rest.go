@@ -27,6 +27,7 @@ type PostDepositReq struct {
 	BaseReq    rest.BaseReq   `json:"base_req" yaml:"base_req"`
 	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`
 	Depositor  sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Denom      string         `json:"denom" yaml:"denom"`
 	Collateral sdk.Coins      `json:"collateral" yaml:"collateral"`
 }
 
@@ -35,6 +36,7 @@ type PostWithdrawalReq struct {
 	BaseReq    rest.BaseReq   `json:"base_req" yaml:"base_req"`
 	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`
 	Depositor  sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Denom      string         `json:"denom" yaml:"denom"`
 	Collateral sdk.Coins      `json:"collateral" yaml:"collateral"`
 }
 
@@ -53,3 +55,18 @@ type PostRepayReq struct {
 	Denom   string         `json:"denom" yaml:"denom"`
 	Payment sdk.Coins      `json:"payment" yaml:"payment"`
 }
+
+// PostLiquidateReq defines the properties of cdp liquidation request's body.
+type PostLiquidateReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Keeper  sdk.AccAddress `json:"keeper" yaml:"keeper"`
+	Denom   string         `json:"denom" yaml:"denom"`
+	ID      uint64         `json:"id" yaml:"id"`
+}
+
+// PostSavingsReq defines the properties of a savings deposit or withdrawal request's body.
+type PostSavingsReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
@@ -26,6 +26,15 @@ func InitGenesis(ctx sdk.Context, k Keeper, pk PricefeedKeeper, gs GenesisState)
 			panic(fmt.Sprintf("%s collateral not found in pricefeed", col.Denom))
 		}
 	}
+	for _, dp := range gs.Params.DebtParams {
+		if dp.MarketID == "" {
+			continue
+		}
+		_, found := collateralMap[dp.MarketID]
+		if !found {
+			panic(fmt.Sprintf("%s debt market not found in pricefeed", dp.Denom))
+		}
+	}
 
 	k.SetParams(ctx, gs.Params)
 
@@ -36,16 +45,20 @@ func InitGenesis(ctx sdk.Context, k Keeper, pk PricefeedKeeper, gs GenesisState)
 		}
 	}
 
-	// add cdps
+	for _, f := range gs.InterestFactors {
+		k.SetInterestFactor(ctx, f.CollateralType, f.DebtDenom, f.Factor)
+	}
+
+	// add cdps, whose normalized principal makes up the total principal of their collateral type
 	for _, cdp := range gs.CDPs {
 		if cdp.ID == gs.StartingCdpID {
 			panic(fmt.Sprintf("starting cdp id is assigned to an existing cdp: %s", cdp))
 		}
 		k.SetCDP(ctx, cdp)
+		k.IncrementNormalizedPrincipal(ctx, cdp)
 		k.IndexCdpByOwner(ctx, cdp)
-		ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
-		k.IndexCdpByCollateralRatio(ctx, cdp.Collateral[0].Denom, cdp.ID, ratio)
-		k.IncrementTotalPrincipal(ctx, cdp.Collateral[0].Denom, cdp.Principal)
+		ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
+		k.IndexCdpByCollateralRatio(ctx, cdp.Type, cdp.ID, ratio)
 	}
 
 	k.SetNextCdpID(ctx, gs.StartingCdpID)
@@ -55,6 +68,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, pk PricefeedKeeper, gs GenesisState)
 	for _, d := range gs.Deposits {
 		k.SetDeposit(ctx, d)
 	}
+
+	for _, sd := range gs.SavingsDeposits {
+		k.SetSavingsDeposit(ctx, sd)
+	}
+	k.SetTotalSavingsShares(ctx, gs.SavingsDeposits.TotalShares())
 	// only set the previous block time if it's different than default
 	if !gs.PreviousBlockTime.Equal(DefaultPreviousBlockTime) {
 		k.SetPreviousBlockTime(ctx, gs.PreviousBlockTime)
@@ -71,12 +89,26 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		previousBlockTime = DefaultPreviousBlockTime
 	}
 	debtDenom := k.GetDebtDenom(ctx)
+	govDenom := k.GetGovDenom(ctx)
+
+	var deposits Deposits
+	k.IterateAllDeposits(ctx, func(deposit Deposit) (stop bool) {
+		deposits = append(deposits, deposit)
+		return false
+	})
+
+	savingsDeposits := k.GetAllSavingsDeposits(ctx)
+	interestFactors := k.GetAllInterestFactors(ctx)
 
 	return GenesisState{
 		Params:            params,
 		StartingCdpID:     cdpID,
 		CDPs:              cdps,
+		Deposits:          deposits,
 		PreviousBlockTime: previousBlockTime,
 		DebtDenom:         debtDenom,
+		GovDenom:          govDenom,
+		SavingsDeposits:   savingsDeposits,
+		InterestFactors:   interestFactors,
 	}
 }
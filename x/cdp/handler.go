@@ -20,6 +20,12 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgDrawDebt(ctx, k, msg)
 		case MsgRepayDebt:
 			return handleMsgRepayDebt(ctx, k, msg)
+		case MsgLiquidate:
+			return handleMsgLiquidate(ctx, k, msg)
+		case MsgDepositSavings:
+			return handleMsgDepositSavings(ctx, k, msg)
+		case MsgWithdrawSavings:
+			return handleMsgWithdrawSavings(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized cdp msg type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -49,7 +55,7 @@ func handleMsgCreateCDP(ctx sdk.Context, k Keeper, msg MsgCreateCDP) sdk.Result
 }
 
 func handleMsgDeposit(ctx sdk.Context, k Keeper, msg MsgDeposit) sdk.Result {
-	err := k.DepositCollateral(ctx, msg.Owner, msg.Depositor, msg.Collateral)
+	err := k.DepositCollateral(ctx, msg.Owner, msg.Depositor, msg.CdpDenom, msg.Collateral)
 	if err != nil {
 		return err.Result()
 	}
@@ -65,7 +71,7 @@ func handleMsgDeposit(ctx sdk.Context, k Keeper, msg MsgDeposit) sdk.Result {
 }
 
 func handleMsgWithdraw(ctx sdk.Context, k Keeper, msg MsgWithdraw) sdk.Result {
-	err := k.WithdrawCollateral(ctx, msg.Owner, msg.Depositor, msg.Collateral)
+	err := k.WithdrawCollateral(ctx, msg.Owner, msg.Depositor, msg.CdpDenom, msg.Collateral)
 	if err != nil {
 		return err.Result()
 	}
@@ -111,3 +117,51 @@ func handleMsgRepayDebt(ctx sdk.Context, k Keeper, msg MsgRepayDebt) sdk.Result
 	)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+func handleMsgLiquidate(ctx sdk.Context, k Keeper, msg MsgLiquidate) sdk.Result {
+	err := k.AttemptKeeperLiquidation(ctx, msg.Keeper, msg.CdpDenom, msg.CdpID)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Keeper.String()),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgDepositSavings(ctx sdk.Context, k Keeper, msg MsgDepositSavings) sdk.Result {
+	err := k.DepositSavings(ctx, msg.Depositor, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor.String()),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgWithdrawSavings(ctx sdk.Context, k Keeper, msg MsgWithdrawSavings) sdk.Result {
+	err := k.WithdrawSavings(ctx, msg.Depositor, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor.String()),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -53,6 +53,40 @@ func (suite *HandlerTestSuite) TestMsgCreateCdp() {
 
 }
 
+func (suite *HandlerTestSuite) TestCircuitBreaker() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.NewAccountWithAddress(suite.ctx, addrs[0])
+	acc.SetCoins(cs(c("xrp", 400000000), c("btc", 500000000), c("usdx", 10000000)))
+	ak.SetAccount(suite.ctx, acc)
+	res := suite.handler(suite.ctx, cdp.NewMsgCreateCDP(addrs[0], cs(c("xrp", 200000000)), cs(c("usdx", 20000000))))
+	suite.True(res.IsOK())
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CircuitBreaker = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	res = suite.handler(suite.ctx, cdp.NewMsgCreateCDP(addrs[0], cs(c("btc", 100000000)), cs(c("usdx", 10000000))))
+	suite.Equal(cdp.CodeCircuitBreakerTripped, res.Code)
+	res = suite.handler(suite.ctx, cdp.NewMsgDrawDebt(addrs[0], "xrp", cs(c("usdx", 10000000))))
+	suite.Equal(cdp.CodeCircuitBreakerTripped, res.Code)
+	res = suite.handler(suite.ctx, cdp.NewMsgWithdraw(addrs[0], addrs[0], "xrp", cs(c("xrp", 10000000))))
+	suite.Equal(cdp.CodeCircuitBreakerTripped, res.Code)
+	res = suite.handler(suite.ctx, cdp.NewMsgLiquidate(addrs[0], "xrp", 1))
+	suite.Equal(cdp.CodeCircuitBreakerTripped, res.Code)
+
+	// deposits and repayments are allowed so that users can de-risk their positions
+	res = suite.handler(suite.ctx, cdp.NewMsgDeposit(addrs[0], addrs[0], "xrp", cs(c("xrp", 100000000))))
+	suite.True(res.IsOK())
+	res = suite.handler(suite.ctx, cdp.NewMsgRepayDebt(addrs[0], "xrp", cs(c("usdx", 10000000))))
+	suite.True(res.IsOK())
+
+	params.CircuitBreaker = false
+	suite.keeper.SetParams(suite.ctx, params)
+	res = suite.handler(suite.ctx, cdp.NewMsgDrawDebt(addrs[0], "xrp", cs(c("usdx", 10000000))))
+	suite.True(res.IsOK())
+}
+
 func (suite *HandlerTestSuite) TestInvalidMsg() {
 	res := suite.handler(suite.ctx, sdk.NewTestMsg())
 	suite.False(res.IsOK())
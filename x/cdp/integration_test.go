@@ -8,7 +8,6 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp"
 	"github.com/kava-labs/kava/x/pricefeed"
-	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 // Avoid cluttering test cases with long function names
@@ -20,6 +19,7 @@ func cs(coins ...sdk.Coin) sdk.Coins        { return sdk.NewCoins(coins...) }
 func NewPricefeedGenState(asset string, price sdk.Dec) app.GenesisState {
 	pfGenesis := pricefeed.GenesisState{
 		Params: pricefeed.Params{
+			MaxTWAPWindow: pricefeed.DefaultMaxTWAPWindow,
 			Markets: []pricefeed.Market{
 				pricefeed.Market{MarketID: asset + ":usd", BaseAsset: asset, QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
 			},
@@ -39,9 +39,10 @@ func NewPricefeedGenState(asset string, price sdk.Dec) app.GenesisState {
 func NewCDPGenState(asset string, liquidationRatio sdk.Dec) app.GenesisState {
 	cdpGenesis := cdp.GenesisState{
 		Params: cdp.Params{
-			GlobalDebtLimit:         sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000)),
-			SurplusAuctionThreshold: cdp.DefaultSurplusThreshold,
-			DebtAuctionThreshold:    cdp.DefaultDebtThreshold,
+			GlobalDebtLimit:          sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000)),
+			SurplusAuctionThreshold:  cdp.DefaultSurplusThreshold,
+			DebtAuctionThreshold:     cdp.DefaultDebtThreshold,
+			LiquidationBlockInterval: cdp.DefaultLiquidationInterval,
 			CollateralParams: cdp.CollateralParams{
 				{
 					Denom:              asset,
@@ -76,6 +77,7 @@ func NewCDPGenState(asset string, liquidationRatio sdk.Dec) app.GenesisState {
 func NewPricefeedGenStateMulti() app.GenesisState {
 	pfGenesis := pricefeed.GenesisState{
 		Params: pricefeed.Params{
+			MaxTWAPWindow: pricefeed.DefaultMaxTWAPWindow,
 			Markets: []pricefeed.Market{
 				pricefeed.Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
 				pricefeed.Market{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
@@ -101,9 +103,10 @@ func NewPricefeedGenStateMulti() app.GenesisState {
 func NewCDPGenStateMulti() app.GenesisState {
 	cdpGenesis := cdp.GenesisState{
 		Params: cdp.Params{
-			GlobalDebtLimit:         sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000), sdk.NewInt64Coin("susd", 1000000000000)),
-			SurplusAuctionThreshold: cdp.DefaultSurplusThreshold,
-			DebtAuctionThreshold:    cdp.DefaultDebtThreshold,
+			GlobalDebtLimit:          sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000), sdk.NewInt64Coin("susd", 1000000000000)),
+			SurplusAuctionThreshold:  cdp.DefaultSurplusThreshold,
+			DebtAuctionThreshold:     cdp.DefaultDebtThreshold,
+			LiquidationBlockInterval: cdp.DefaultLiquidationInterval,
 			CollateralParams: cdp.CollateralParams{
 				{
 					Denom:              "xrp",
@@ -154,10 +157,10 @@ func NewCDPGenStateMulti() app.GenesisState {
 
 func cdps() (cdps cdp.CDPs) {
 	_, addrs := app.GeneratePrivKeyAddressPairs(3)
-	c1 := cdp.NewCDP(uint64(1), addrs[0], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(100000000))), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(8000000))), tmtime.Canonical(time.Now()))
-	c2 := cdp.NewCDP(uint64(2), addrs[1], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(100000000))), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10000000))), tmtime.Canonical(time.Now()))
-	c3 := cdp.NewCDP(uint64(3), addrs[1], sdk.NewCoins(sdk.NewCoin("btc", sdk.NewInt(1000000000))), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10000000))), tmtime.Canonical(time.Now()))
-	c4 := cdp.NewCDP(uint64(4), addrs[2], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(1000000000))), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50000000))), tmtime.Canonical(time.Now()))
+	c1 := cdp.NewCDP(uint64(1), addrs[0], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(100000000))), sdk.NewDecCoins(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(8000000)))))
+	c2 := cdp.NewCDP(uint64(2), addrs[1], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(100000000))), sdk.NewDecCoins(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10000000)))))
+	c3 := cdp.NewCDP(uint64(3), addrs[1], sdk.NewCoins(sdk.NewCoin("btc", sdk.NewInt(1000000000))), sdk.NewDecCoins(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10000000)))))
+	c4 := cdp.NewCDP(uint64(4), addrs[2], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(1000000000))), sdk.NewDecCoins(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50000000)))))
 	cdps = append(cdps, c1, c2, c3, c4)
 	return
 }
@@ -207,6 +210,15 @@ func badGenStates() []badGenState {
 	g13 := baseGenState()
 	g13.GovDenom = ""
 
+	g14 := baseGenState()
+	g14.InterestFactors = cdp.InterestFactors{cdp.NewInterestFactor("xrp", "usdx", d("0.9"))}
+
+	g15 := baseGenState()
+	g15.InterestFactors = cdp.InterestFactors{cdp.NewInterestFactor("xrp", "usdx", d("1.1")), cdp.NewInterestFactor("xrp", "usdx", d("1.2"))}
+
+	g16 := baseGenState()
+	g16.Params.DebtParams[0].MarketID = "eur:usd"
+
 	return []badGenState{
 		badGenState{Genesis: g1, Reason: "duplicate collateral denom"},
 		badGenState{Genesis: g2, Reason: "duplicate collateral prefix"},
@@ -220,15 +232,19 @@ func badGenStates() []badGenState {
 		badGenState{Genesis: g11, Reason: "negative auction size"},
 		badGenState{Genesis: g12, Reason: "invalid liquidation penalty"},
 		badGenState{Genesis: g13, Reason: "gov denom not set"},
+		badGenState{Genesis: g14, Reason: "interest factor below one"},
+		badGenState{Genesis: g15, Reason: "duplicate interest factor"},
+		badGenState{Genesis: g16, Reason: "debt market not found in pricefeed"},
 	}
 }
 
 func baseGenState() cdp.GenesisState {
 	return cdp.GenesisState{
 		Params: cdp.Params{
-			GlobalDebtLimit:         sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000), sdk.NewInt64Coin("susd", 1000000000000)),
-			SurplusAuctionThreshold: cdp.DefaultSurplusThreshold,
-			DebtAuctionThreshold:    cdp.DefaultDebtThreshold,
+			GlobalDebtLimit:          sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000), sdk.NewInt64Coin("susd", 1000000000000)),
+			SurplusAuctionThreshold:  cdp.DefaultSurplusThreshold,
+			DebtAuctionThreshold:     cdp.DefaultDebtThreshold,
+			LiquidationBlockInterval: cdp.DefaultLiquidationInterval,
 			CollateralParams: cdp.CollateralParams{
 				{
 					Denom:            "xrp",
@@ -42,11 +42,120 @@ func (pd partialDeposits) SumDebt() (sum sdk.Int) {
 	return
 }
 
-// AuctionCollateral creates auctions from the input deposits which attempt to raise the corresponding amount of debt
-func (k Keeper) AuctionCollateral(ctx sdk.Context, deposits types.Deposits, debt sdk.Int, bidDenom string) sdk.Error {
-	auctionSize := k.getAuctionSize(ctx, deposits[0].Amount[0].Denom)
+// AuctionCollateral creates auctions from the input deposits which attempt to raise the input debt.
+// Each debt denom is raised by its own auctions: the deposits are split between the debt denoms in proportion to the value
+// of the debt of each denom, and each part is auctioned for the debt of its denom.
+// The auctions raise the liquidation penalty less the input keeper reward percentage, which has already been paid out of the seized collateral.
+func (k Keeper) AuctionCollateral(ctx sdk.Context, deposits types.Deposits, debt sdk.Coins, keeperReward sdk.Dec) sdk.Error {
+	debtDeposits, err := k.splitDepositsByDebtValue(ctx, deposits, debt)
+	if err != nil {
+		return err
+	}
+	for i, dc := range debt {
+		err = k.auctionCollateralForDebt(ctx, debtDeposits[i], dc.Amount, dc.Denom, keeperReward)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitDepositsByDebtValue splits each of the input deposits between the denoms of the input debt in proportion to the value of the debt of each denom.
+// The last denom is assigned the remainder, so that the parts of each deposit always add up to the deposit.
+func (k Keeper) splitDepositsByDebtValue(ctx sdk.Context, deposits types.Deposits, debt sdk.Coins) ([]types.Deposits, sdk.Error) {
+	if len(debt) == 1 {
+		return []types.Deposits{deposits}, nil
+	}
+	totalValue, err := k.calculateDebtValue(ctx, debt, sdk.NewCoins())
+	if err != nil {
+		return nil, err
+	}
+	shares := []sdk.Dec{}
+	for _, dc := range debt {
+		share := sdk.ZeroDec()
+		if totalValue.IsPositive() {
+			value, err := k.calculateDebtValue(ctx, sdk.NewCoins(dc), sdk.NewCoins())
+			if err != nil {
+				return nil, err
+			}
+			share = value.Quo(totalValue)
+		}
+		shares = append(shares, share)
+	}
+	debtDeposits := make([]types.Deposits, len(debt))
+	for _, dep := range deposits {
+		remaining := dep.Amount
+		for i := range debt {
+			amount := remaining
+			if i < len(debt)-1 {
+				amount = sdk.NewCoins()
+				for _, dc := range dep.Amount {
+					share := sdk.MinInt(shares[i].MulInt(dc.Amount).TruncateInt(), remaining.AmountOf(dc.Denom))
+					amount = amount.Add(sdk.NewCoins(sdk.NewCoin(dc.Denom, share)))
+				}
+			}
+			remaining = remaining.Sub(amount)
+			if !amount.IsZero() {
+				debtDeposits[i] = append(debtDeposits[i], types.NewDeposit(dep.CdpID, dep.Depositor, amount))
+			}
+		}
+	}
+	return debtDeposits, nil
+}
+
+// auctionCollateralForDebt creates auctions from the input deposits which attempt to raise the input debt of the input bid denom.
+// Each collateral denom is sold in its own auctions, which attempt to raise a share of the debt proportional to the value of that collateral.
+func (k Keeper) auctionCollateralForDebt(ctx sdk.Context, deposits types.Deposits, debt sdk.Int, bidDenom string, keeperReward sdk.Dec) sdk.Error {
+	collateral := deposits.SumCollateral()
+	debtShares, err := k.splitDebtByCollateralValue(ctx, collateral, debt)
+	if err != nil {
+		return err
+	}
+	for i, cc := range collateral {
+		err = k.auctionCollateralOfDenom(ctx, deposits.FilterDenom(cc.Denom), cc.Denom, debtShares[i], bidDenom, keeperReward)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitDebtByCollateralValue splits the input debt between the input collateral coins in proportion to their value.
+// The last coin is assigned the remainder, so that the shares always add up to the input debt.
+func (k Keeper) splitDebtByCollateralValue(ctx sdk.Context, collateral sdk.Coins, debt sdk.Int) ([]sdk.Int, sdk.Error) {
+	if len(collateral) == 1 {
+		return []sdk.Int{debt}, nil
+	}
+	totalValue, err := k.CalculateCollateralValue(ctx, collateral)
+	if err != nil {
+		return nil, err
+	}
+	debtShares := []sdk.Int{}
+	remainingDebt := debt
+	for i, cc := range collateral {
+		if i == len(collateral)-1 {
+			debtShares = append(debtShares, remainingDebt)
+			break
+		}
+		share := sdk.ZeroInt()
+		if totalValue.IsPositive() {
+			value, err := k.CalculateCollateralValue(ctx, sdk.NewCoins(cc))
+			if err != nil {
+				return nil, err
+			}
+			share = sdk.NewDecFromInt(debt).Mul(value).Quo(totalValue).TruncateInt()
+		}
+		debtShares = append(debtShares, share)
+		remainingDebt = remainingDebt.Sub(share)
+	}
+	return debtShares, nil
+}
+
+// auctionCollateralOfDenom creates auctions from the input deposits, which only hold collateral of the input denom
+func (k Keeper) auctionCollateralOfDenom(ctx sdk.Context, deposits types.Deposits, denom string, debt sdk.Int, bidDenom string, keeperReward sdk.Dec) sdk.Error {
+	auctionSize := k.getAuctionSize(ctx, denom)
 	partialAuctionDeposits := partialDeposits{}
-	totalCollateral := deposits.SumCollateral()
+	totalCollateral := deposits.SumCollateral().AmountOf(denom)
 	for totalCollateral.GT(sdk.ZeroInt()) {
 		for i, dep := range deposits {
 			if dep.Amount.IsZero() {
@@ -55,7 +164,7 @@ func (k Keeper) AuctionCollateral(ctx sdk.Context, deposits types.Deposits, debt
 			collateralAmount := dep.Amount[0].Amount
 			collateralDenom := dep.Amount[0].Denom
 			// create auctions from individual deposits that are larger than the auction size
-			debtChange, collateralChange, err := k.CreateAuctionsFromDeposit(ctx, dep, debt, totalCollateral, auctionSize, bidDenom)
+			debtChange, collateralChange, err := k.CreateAuctionsFromDeposit(ctx, dep, debt, totalCollateral, auctionSize, bidDenom, keeperReward)
 			if err != nil {
 				return err
 			}
@@ -85,7 +194,7 @@ func (k Keeper) AuctionCollateral(ctx sdk.Context, deposits types.Deposits, debt
 					// append it to the partial deposits
 					partialAuctionDeposits = append(partialAuctionDeposits, partialDep)
 					// create an auction from the partial deposits
-					debtChange, collateralChange, err := k.CreateAuctionFromPartialDeposits(ctx, partialAuctionDeposits, debt, totalCollateral, auctionSize, bidDenom)
+					debtChange, collateralChange, err := k.CreateAuctionFromPartialDeposits(ctx, partialAuctionDeposits, debt, totalCollateral, auctionSize, bidDenom, keeperReward)
 					if err != nil {
 						return err
 					}
@@ -97,11 +206,11 @@ func (k Keeper) AuctionCollateral(ctx sdk.Context, deposits types.Deposits, debt
 				}
 			}
 			deposits[i] = dep
-			totalCollateral = deposits.SumCollateral()
+			totalCollateral = deposits.SumCollateral().AmountOf(denom)
 		}
 	}
 	if partialAuctionDeposits.SumCollateral().GT(sdk.ZeroInt()) {
-		_, _, err := k.CreateAuctionFromPartialDeposits(ctx, partialAuctionDeposits, debt, totalCollateral, partialAuctionDeposits.SumCollateral(), bidDenom)
+		_, _, err := k.CreateAuctionFromPartialDeposits(ctx, partialAuctionDeposits, debt, totalCollateral, partialAuctionDeposits.SumCollateral(), bidDenom, keeperReward)
 		if err != nil {
 			return err
 		}
@@ -110,7 +219,7 @@ func (k Keeper) AuctionCollateral(ctx sdk.Context, deposits types.Deposits, debt
 }
 
 // CreateAuctionsFromDeposit creates auctions from the input deposit until there is less than auctionSize left on the deposit
-func (k Keeper) CreateAuctionsFromDeposit(ctx sdk.Context, dep types.Deposit, debt sdk.Int, totalCollateral sdk.Int, auctionSize sdk.Int, principalDenom string) (debtChange sdk.Int, collateralChange sdk.Int, err sdk.Error) {
+func (k Keeper) CreateAuctionsFromDeposit(ctx sdk.Context, dep types.Deposit, debt sdk.Int, totalCollateral sdk.Int, auctionSize sdk.Int, principalDenom string, keeperReward sdk.Dec) (debtChange sdk.Int, collateralChange sdk.Int, err sdk.Error) {
 	debtChange = sdk.ZeroInt()
 	collateralChange = sdk.ZeroInt()
 	depositAmount := dep.Amount[0].Amount
@@ -118,11 +227,11 @@ func (k Keeper) CreateAuctionsFromDeposit(ctx sdk.Context, dep types.Deposit, de
 	for depositAmount.GTE(auctionSize) {
 		// figure out how much debt is covered by one lots worth of collateral
 		depositDebtAmount := (sdk.NewDecFromInt(auctionSize).Quo(sdk.NewDecFromInt(totalCollateral))).Mul(sdk.NewDecFromInt(debt)).RoundInt()
-		penalty := k.ApplyLiquidationPenalty(ctx, depositDenom, depositDebtAmount)
+		penalty := k.applyLiquidationPenalty(ctx, depositDenom, depositDebtAmount, keeperReward)
 		// start an auction for one lot, attempting to raise depositDebtAmount plus the liquidation penalty
-		_, err := k.auctionKeeper.StartCollateralAuction(
-			ctx, types.LiquidatorMacc, sdk.NewCoin(depositDenom, auctionSize), sdk.NewCoin(principalDenom, depositDebtAmount.Add(penalty)), []sdk.AccAddress{dep.Depositor},
-			[]sdk.Int{auctionSize}, sdk.NewCoin(k.GetDebtDenom(ctx), depositDebtAmount))
+		err := k.startCollateralAuction(
+			ctx, sdk.NewCoin(depositDenom, auctionSize), sdk.NewCoin(principalDenom, depositDebtAmount.Add(penalty)), []sdk.AccAddress{dep.Depositor},
+			[]sdk.Int{auctionSize}, sdk.NewCoin(k.getDebtCoinDenom(ctx, principalDenom), depositDebtAmount))
 		if err != nil {
 			return sdk.ZeroInt(), sdk.ZeroInt(), err
 		}
@@ -138,7 +247,7 @@ func (k Keeper) CreateAuctionsFromDeposit(ctx sdk.Context, dep types.Deposit, de
 }
 
 // CreateAuctionFromPartialDeposits creates an auction from the input partial deposits
-func (k Keeper) CreateAuctionFromPartialDeposits(ctx sdk.Context, partialDeps partialDeposits, debt sdk.Int, collateral sdk.Int, auctionSize sdk.Int, bidDenom string) (debtChange, collateralChange sdk.Int, err sdk.Error) {
+func (k Keeper) CreateAuctionFromPartialDeposits(ctx sdk.Context, partialDeps partialDeposits, debt sdk.Int, collateral sdk.Int, auctionSize sdk.Int, bidDenom string, keeperReward sdk.Dec) (debtChange, collateralChange sdk.Int, err sdk.Error) {
 
 	returnAddrs := []sdk.AccAddress{}
 	returnWeights := []sdk.Int{}
@@ -147,8 +256,8 @@ func (k Keeper) CreateAuctionFromPartialDeposits(ctx sdk.Context, partialDeps pa
 		returnAddrs = append(returnAddrs, pd.Depositor)
 		returnWeights = append(returnWeights, pd.DebtShare)
 	}
-	penalty := k.ApplyLiquidationPenalty(ctx, depositDenom, partialDeps.SumDebt())
-	_, err = k.auctionKeeper.StartCollateralAuction(ctx, types.LiquidatorMacc, sdk.NewCoin(partialDeps[0].Amount[0].Denom, auctionSize), sdk.NewCoin(bidDenom, partialDeps.SumDebt().Add(penalty)), returnAddrs, returnWeights, sdk.NewCoin(k.GetDebtDenom(ctx), partialDeps.SumDebt()))
+	penalty := k.applyLiquidationPenalty(ctx, depositDenom, partialDeps.SumDebt(), keeperReward)
+	err = k.startCollateralAuction(ctx, sdk.NewCoin(partialDeps[0].Amount[0].Denom, auctionSize), sdk.NewCoin(bidDenom, partialDeps.SumDebt().Add(penalty)), returnAddrs, returnWeights, sdk.NewCoin(k.getDebtCoinDenom(ctx, bidDenom), partialDeps.SumDebt()))
 	if err != nil {
 		return sdk.ZeroInt(), sdk.ZeroInt(), err
 	}
@@ -157,35 +266,52 @@ func (k Keeper) CreateAuctionFromPartialDeposits(ctx sdk.Context, partialDeps pa
 	return debtChange, collateralChange, nil
 }
 
-// NetSurplusAndDebt burns surplus and debt coins equal to the minimum of surplus and debt balances held by the liquidator module account
-// for example, if there is 1000 debt and 100 surplus, 100 surplus and 100 debt are burned, netting to 900 debt
-func (k Keeper) NetSurplusAndDebt(ctx sdk.Context) sdk.Error {
-	totalSurplus := k.GetTotalSurplus(ctx, types.LiquidatorMacc)
-	debt := k.GetTotalDebt(ctx, types.LiquidatorMacc)
-	netAmount := sdk.MinInt(totalSurplus, debt)
-	if netAmount.IsZero() {
-		return nil
+// startCollateralAuction starts an auction selling the input lot to raise the input max bid for the liquidator.
+// Collateral types that use dutch auctions start them at the market price of the lot in the max bid denom.
+func (k Keeper) startCollateralAuction(ctx sdk.Context, lot sdk.Coin, maxBid sdk.Coin, returnAddrs []sdk.AccAddress, returnWeights []sdk.Int, debt sdk.Coin) sdk.Error {
+	cp, found := k.GetCollateral(ctx, lot.Denom)
+	if !found || !cp.DutchAuction {
+		_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.LiquidatorMacc, lot, maxBid, returnAddrs, returnWeights, debt)
+		return err
 	}
-	err := k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(k.GetDebtDenom(ctx), netAmount)))
+	price, err := k.getLotPrice(ctx, lot, maxBid.Denom)
 	if err != nil {
 		return err
 	}
-	for netAmount.GT(sdk.ZeroInt()) {
-		for _, dp := range k.GetParams(ctx).DebtParams {
-			balance := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins().AmountOf(dp.Denom)
-			if balance.LT(netAmount) {
-				err = k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, balance)))
-				if err != nil {
-					return err
-				}
-				netAmount = netAmount.Sub(balance)
-			} else {
-				err = k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, netAmount)))
-				if err != nil {
-					return err
-				}
-				netAmount = sdk.ZeroInt()
-			}
+	_, err = k.auctionKeeper.StartDutchCollateralAuction(ctx, types.LiquidatorMacc, lot, price, maxBid, returnAddrs, returnWeights, debt)
+	return err
+}
+
+// getLotPrice returns the market price of one unit of the input lot in units of the input debt denom
+func (k Keeper) getLotPrice(ctx sdk.Context, lot sdk.Coin, debtDenom string) (sdk.Dec, sdk.Error) {
+	lotValue, err := k.CalculateCollateralValue(ctx, sdk.NewCoins(lot))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	unitValue, err := k.calculateDebtValue(ctx, sdk.NewCoins(sdk.NewCoin(debtDenom, sdk.OneInt())), sdk.NewCoins())
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return lotValue.Quo(unitValue).QuoInt(lot.Amount), nil
+}
+
+// NetSurplusAndDebt burns, for each debt asset, surplus and debt coins equal to the minimum of the surplus and debt balances of that asset
+// held by the liquidator module account. Each asset's surplus only covers its own debt.
+// for example, if there is 1000 usdx debt and 100 usdx surplus, 100 usdx surplus and 100 usdx debt are burned, netting to 900 usdx debt
+func (k Keeper) NetSurplusAndDebt(ctx sdk.Context) sdk.Error {
+	surplus := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins()
+	for _, dc := range k.GetTotalDebt(ctx, types.LiquidatorMacc) {
+		netAmount := sdk.MinInt(surplus.AmountOf(dc.Denom), dc.Amount)
+		if netAmount.IsZero() {
+			continue
+		}
+		err := k.BurnDebtCoins(ctx, types.LiquidatorMacc, k.GetDebtDenom(ctx), sdk.NewCoins(sdk.NewCoin(dc.Denom, netAmount)))
+		if err != nil {
+			return err
+		}
+		err = k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dc.Denom, netAmount)))
+		if err != nil {
+			return err
 		}
 	}
 	return nil
@@ -202,20 +328,25 @@ func (k Keeper) GetTotalSurplus(ctx sdk.Context, accountName string) sdk.Int {
 	return totalSurplus
 }
 
-// GetTotalDebt returns the total amount of debt tokens held by the liquidator module account
-func (k Keeper) GetTotalDebt(ctx sdk.Context, accountName string) sdk.Int {
-	acc := k.supplyKeeper.GetModuleAccount(ctx, accountName)
-	debt := acc.GetCoins().AmountOf(k.GetDebtDenom(ctx))
+// GetTotalDebt returns the debt of each debt asset backed by the debt tokens held by the input module account
+func (k Keeper) GetTotalDebt(ctx sdk.Context, accountName string) sdk.Coins {
+	debt := sdk.NewCoins()
+	for _, dp := range k.GetParams(ctx).DebtParams {
+		debt = debt.Add(sdk.NewCoins(sdk.NewCoin(dp.Denom, k.getModAccountDebt(ctx, accountName, dp.Denom))))
+	}
 	return debt
 }
 
 // RunSurplusAndDebtAuctions nets the surplus and debt balances and then creates surplus or debt auctions if the remaining balance is above the auction threshold parameter
 func (k Keeper) RunSurplusAndDebtAuctions(ctx sdk.Context) sdk.Error {
 	k.NetSurplusAndDebt(ctx)
-	remainingDebt := k.GetTotalDebt(ctx, types.LiquidatorMacc)
 	params := k.GetParams(ctx)
-	if remainingDebt.GTE(params.DebtAuctionThreshold) {
-		_, err := k.auctionKeeper.StartDebtAuction(ctx, types.LiquidatorMacc, sdk.NewCoin("usdx", remainingDebt), sdk.NewCoin(k.GetGovDenom(ctx), remainingDebt.Mul(sdk.NewInt(dump))), sdk.NewCoin(k.GetDebtDenom(ctx), remainingDebt))
+	for _, remainingDebt := range k.GetTotalDebt(ctx, types.LiquidatorMacc) {
+		if remainingDebt.Amount.LT(params.DebtAuctionThreshold) {
+			continue
+		}
+		// the debt auction raises the debt asset that is uncovered
+		_, err := k.auctionKeeper.StartDebtAuction(ctx, types.LiquidatorMacc, remainingDebt, sdk.NewCoin(k.GetGovDenom(ctx), remainingDebt.Amount.Mul(sdk.NewInt(dump))), sdk.NewCoin(k.getDebtCoinDenom(ctx, remainingDebt.Denom), remainingDebt.Amount))
 		if err != nil {
 			return err
 		}
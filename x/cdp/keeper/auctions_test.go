@@ -40,20 +40,33 @@ func (suite *AuctionTestSuite) SetupTest() {
 
 func (suite *AuctionTestSuite) TestNetDebtSurplus() {
 	sk := suite.app.GetSupplyKeeper()
-	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 100)))
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 100)))
 	suite.NoError(err)
 	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 10)))
 	suite.NoError(err)
 	suite.NotPanics(func() { suite.keeper.NetSurplusAndDebt(suite.ctx) })
 	acc := sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
-	suite.Equal(cs(c("debt", 90)), acc.GetCoins())
+	suite.Equal(cs(c("debtusdx", 90)), acc.GetCoins())
+}
+
+func (suite *AuctionTestSuite) TestNetDebtSurplusMultiDebt() {
+	sk := suite.app.GetSupplyKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 100), c("debtsusd", 10)))
+	suite.NoError(err)
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 10), c("susd", 100)))
+	suite.NoError(err)
+	suite.NotPanics(func() { suite.keeper.NetSurplusAndDebt(suite.ctx) })
+	// surplus of one debt asset doesn't cover debt of another
+	acc := sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+	suite.Equal(cs(c("debtusdx", 90), c("susd", 90)), acc.GetCoins())
+	suite.Equal(cs(c("usdx", 90)), suite.keeper.GetTotalDebt(suite.ctx, types.LiquidatorMacc))
 }
 
 func (suite *AuctionTestSuite) TestSurplusAuction() {
 	sk := suite.app.GetSupplyKeeper()
 	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 10000000000)))
 	suite.NoError(err)
-	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 1000000000)))
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 1000000000)))
 	suite.NoError(err)
 	suite.keeper.RunSurplusAndDebtAuctions(suite.ctx)
 	acc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
@@ -64,11 +77,30 @@ func (suite *AuctionTestSuite) TestDebtAuction() {
 	sk := suite.app.GetSupplyKeeper()
 	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 1000000000)))
 	suite.NoError(err)
-	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 10000000000)))
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 10000000000)))
+	suite.NoError(err)
+	suite.keeper.RunSurplusAndDebtAuctions(suite.ctx)
+	acc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("debtusdx", 9000000000)), acc.GetCoins())
+}
+
+func (suite *AuctionTestSuite) TestDebtAuctionMultiDebt() {
+	sk := suite.app.GetSupplyKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 10000000000), c("debtsusd", 10000000000)))
 	suite.NoError(err)
 	suite.keeper.RunSurplusAndDebtAuctions(suite.ctx)
+	// usdx surplus doesn't net against susd debt, which is raised by a debt auction for susd
 	acc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
-	suite.Equal(cs(c("debt", 9000000000)), acc.GetCoins())
+	suite.Equal(cs(c("debtsusd", 10000000000), c("usdx", 10000000000)), acc.GetCoins())
+	found := false
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		if da, ok := a.(auction.DebtAuction); ok {
+			suite.Equal(c("susd", 10000000000), da.GetBid())
+			found = true
+		}
+		return false
+	})
+	suite.True(found)
 }
 
 func TestAuctionTestSuite(t *testing.T) {
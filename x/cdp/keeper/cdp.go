@@ -3,18 +3,23 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
-// BaseDigitFactor is 10**18, used during coin calculations
-const BaseDigitFactor = 1000000000000000000
-
 // AddCdp adds a cdp for a specific owner and collateral type
 func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coins, principal sdk.Coins) sdk.Error {
 	// validation
+	if k.GetCircuitBreaker(ctx) {
+		return types.ErrCircuitBreakerTripped(k.codespace, "create cdp")
+	}
+	// new cdps are opened with a single collateral type, other collateral assets can be deposited later
+	if len(collateral) != 1 {
+		return types.ErrInvalidCollateralLength(k.codespace, len(collateral))
+	}
 	err := k.ValidateCollateral(ctx, collateral)
 	if err != nil {
 		return err
@@ -27,14 +32,18 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	if err != nil {
 		return err
 	}
-	err = k.ValidateCollateralizationRatio(ctx, collateral, principal, sdk.NewCoins())
+	err = k.ValidatePricesNotStale(ctx, collateral, principal)
+	if err != nil {
+		return err
+	}
+	err = k.ValidateCollateralizationRatio(ctx, collateral[0].Denom, collateral, principal, sdk.NewCoins())
 	if err != nil {
 		return err
 	}
 
 	// send coins from the owners account to the cdp module
 	id := k.GetNextCdpID(ctx)
-	cdp := types.NewCDP(id, owner, collateral, principal, ctx.BlockHeader().Time)
+	cdp := types.NewCDP(id, owner, collateral, k.normalize(ctx, collateral[0].Denom, principal))
 	deposit := types.NewDeposit(cdp.ID, owner, collateral)
 	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, collateral)
 	if err != nil {
@@ -79,12 +88,10 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 		),
 	)
 
-	// update total principal for input collateral type
-	k.IncrementTotalPrincipal(ctx, collateral[0].Denom, principal)
-
-	// set the cdp, deposit, and indexes in the store
-	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, collateral, principal)
+	// set the cdp, deposit, and indexes in the store, and add its debt to the total principal of its collateral type
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, collateral, cdp.NormalizedPrincipal)
 	k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+	k.IncrementNormalizedPrincipal(ctx, cdp)
 	k.IndexCdpByOwner(ctx, cdp)
 	k.SetDeposit(ctx, deposit)
 	k.SetNextCdpID(ctx, id+1)
@@ -94,14 +101,14 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 // SetCdpAndCollateralRatioIndex sets the cdp and collateral ratio index in the store
 func (k Keeper) SetCdpAndCollateralRatioIndex(ctx sdk.Context, cdp types.CDP, ratio sdk.Dec) {
 	k.SetCDP(ctx, cdp)
-	k.IndexCdpByCollateralRatio(ctx, cdp.Collateral[0].Denom, cdp.ID, ratio)
+	k.IndexCdpByCollateralRatio(ctx, cdp.Type, cdp.ID, ratio)
 }
 
-// MintDebtCoins mints debt coins in the cdp module account
+// MintDebtCoins mints debt coins in the cdp module account, with a separate debt coin denom for each principal denom
 func (k Keeper) MintDebtCoins(ctx sdk.Context, moduleAccount string, denom string, principalCoins sdk.Coins) sdk.Error {
 	coinsToMint := sdk.NewCoins()
 	for _, sc := range principalCoins {
-		coinsToMint = coinsToMint.Add(sdk.NewCoins(sdk.NewCoin(denom, sc.Amount)))
+		coinsToMint = coinsToMint.Add(sdk.NewCoins(sdk.NewCoin(types.DebtCoinDenom(denom, sc.Denom), sc.Amount)))
 	}
 	err := k.supplyKeeper.MintCoins(ctx, moduleAccount, coinsToMint)
 	if err != nil {
@@ -110,11 +117,11 @@ func (k Keeper) MintDebtCoins(ctx sdk.Context, moduleAccount string, denom strin
 	return nil
 }
 
-// BurnDebtCoins burns debt coins from the cdp module account
+// BurnDebtCoins burns debt coins from the cdp module account, with a separate debt coin denom for each payment denom
 func (k Keeper) BurnDebtCoins(ctx sdk.Context, moduleAccount string, denom string, paymentCoins sdk.Coins) sdk.Error {
 	coinsToBurn := sdk.NewCoins()
 	for _, pc := range paymentCoins {
-		coinsToBurn = coinsToBurn.Add(sdk.NewCoins(sdk.NewCoin(denom, pc.Amount)))
+		coinsToBurn = coinsToBurn.Add(sdk.NewCoins(sdk.NewCoin(types.DebtCoinDenom(denom, pc.Denom), pc.Amount)))
 	}
 	err := k.supplyKeeper.BurnCoins(ctx, moduleAccount, coinsToBurn)
 	if err != nil {
@@ -187,7 +194,7 @@ func (k Keeper) GetCDP(ctx sdk.Context, collateralDenom string, cdpID uint64) (t
 // SetCDP sets a cdp in the store
 func (k Keeper) SetCDP(ctx sdk.Context, cdp types.CDP) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpKeyPrefix)
-	db, _ := k.GetDenomPrefix(ctx, cdp.Collateral[0].Denom)
+	db, _ := k.GetDenomPrefix(ctx, cdp.Type)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(cdp)
 	store.Set(types.CdpKey(db, cdp.ID), bz)
 	return
@@ -196,7 +203,7 @@ func (k Keeper) SetCDP(ctx sdk.Context, cdp types.CDP) {
 // DeleteCDP deletes a cdp from the store
 func (k Keeper) DeleteCDP(ctx sdk.Context, cdp types.CDP) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpKeyPrefix)
-	db, _ := k.GetDenomPrefix(ctx, cdp.Collateral[0].Denom)
+	db, _ := k.GetDenomPrefix(ctx, cdp.Type)
 	store.Delete(types.CdpKey(db, cdp.ID))
 
 }
@@ -336,12 +343,14 @@ func (k Keeper) SetGovDenom(ctx sdk.Context, denom string) {
 
 // ValidateCollateral validates that a collateral is valid for use in cdps
 func (k Keeper) ValidateCollateral(ctx sdk.Context, collateral sdk.Coins) sdk.Error {
-	if len(collateral) != 1 {
+	if len(collateral) == 0 {
 		return types.ErrInvalidCollateralLength(k.codespace, len(collateral))
 	}
-	_, found := k.GetCollateral(ctx, collateral[0].Denom)
-	if !found {
-		return types.ErrCollateralNotSupported(k.codespace, collateral[0].Denom)
+	for _, cc := range collateral {
+		_, found := k.GetCollateral(ctx, cc.Denom)
+		if !found {
+			return types.ErrCollateralNotSupported(k.codespace, cc.Denom)
+		}
 	}
 	return nil
 }
@@ -360,48 +369,96 @@ func (k Keeper) ValidatePrincipalAdd(ctx sdk.Context, principal sdk.Coins) sdk.E
 	return nil
 }
 
-// ValidatePrincipalDraw validates that an asset is valid for use as debt when drawing debt off an existing cdp
-func (k Keeper) ValidatePrincipalDraw(ctx sdk.Context, principal sdk.Coins) sdk.Error {
+// ValidatePrincipalDraw validates that an asset is valid for use as debt when drawing debt off an existing cdp with the input principal.
+// Each debt asset has its own debt floor, so the cdp's principal of every drawn denom must be at least its debt floor after the draw.
+func (k Keeper) ValidatePrincipalDraw(ctx sdk.Context, principal sdk.Coins, cdpPrincipal sdk.Coins) sdk.Error {
 	for _, dc := range principal {
-		_, found := k.GetDebtParam(ctx, dc.Denom)
+		dp, found := k.GetDebtParam(ctx, dc.Denom)
 		if !found {
 			return types.ErrDebtNotSupported(k.codespace, dc.Denom)
 		}
+		newPrincipal := sdk.NewCoin(dc.Denom, cdpPrincipal.AmountOf(dc.Denom).Add(dc.Amount))
+		if newPrincipal.Amount.LT(dp.DebtFloor) {
+			return types.ErrBelowDebtFloor(k.codespace, sdk.NewCoins(newPrincipal), dp.DebtFloor)
+		}
 	}
 	return nil
 }
 
-// ValidateDebtLimit validates that the input debt amount does not exceed the global debt limit
+// ValidateDebtLimit validates that the input debt amount does not exceed the debt limit of the input collateral type,
+// or the global debt limit for the principal drawn against all collateral types
 func (k Keeper) ValidateDebtLimit(ctx sdk.Context, collateralDenom string, principal sdk.Coins) sdk.Error {
+	params := k.GetParams(ctx)
+	cp, found := k.GetCollateral(ctx, collateralDenom)
+	if !found {
+		return types.ErrCollateralNotSupported(k.codespace, collateralDenom)
+	}
 	for _, dc := range principal {
 		totalPrincipal := k.GetTotalPrincipal(ctx, collateralDenom, dc.Denom).Add(dc.Amount)
-		globalLimit := k.GetParams(ctx).GlobalDebtLimit.AmountOf(dc.Denom)
-		if totalPrincipal.GT(globalLimit) {
-			return types.ErrExceedsDebtLimit(k.codespace, sdk.NewCoins(sdk.NewCoin(dc.Denom, totalPrincipal)), sdk.NewCoins(sdk.NewCoin(dc.Denom, globalLimit)))
+		collateralLimit := cp.DebtLimit.AmountOf(dc.Denom)
+		if totalPrincipal.GT(collateralLimit) {
+			return types.ErrExceedsDebtLimit(k.codespace, sdk.NewCoins(sdk.NewCoin(dc.Denom, totalPrincipal)), sdk.NewCoins(sdk.NewCoin(dc.Denom, collateralLimit)))
+		}
+		globalPrincipal := dc.Amount
+		for _, p := range params.CollateralParams {
+			globalPrincipal = globalPrincipal.Add(k.GetTotalPrincipal(ctx, p.Denom, dc.Denom))
+		}
+		globalLimit := params.GlobalDebtLimit.AmountOf(dc.Denom)
+		if globalPrincipal.GT(globalLimit) {
+			return types.ErrExceedsDebtLimit(k.codespace, sdk.NewCoins(sdk.NewCoin(dc.Denom, globalPrincipal)), sdk.NewCoins(sdk.NewCoin(dc.Denom, globalLimit)))
 		}
 	}
 	return nil
 }
 
-// ValidateCollateralizationRatio validate that adding the input principal doesn't put the cdp below the liquidation ratio
-func (k Keeper) ValidateCollateralizationRatio(ctx sdk.Context, collateral sdk.Coins, principal sdk.Coins, fees sdk.Coins) sdk.Error {
-	//
+// ValidateCollateralizationRatio validate that the input collateral, principal and fees don't put a cdp of the input collateral type below its liquidation ratio
+func (k Keeper) ValidateCollateralizationRatio(ctx sdk.Context, collateralType string, collateral sdk.Coins, principal sdk.Coins, fees sdk.Coins) sdk.Error {
 	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, collateral, principal, fees)
 	if err != nil {
 		return err
 	}
-	liquidationRatio := k.getLiquidationRatio(ctx, collateral[0].Denom)
+	liquidationRatio, err := k.CalculateLiquidationRatio(ctx, collateralType, collateral)
+	if err != nil {
+		return err
+	}
 	if collateralizationRatio.LT(liquidationRatio) {
-		return types.ErrInvalidCollateralRatio(k.codespace, collateral[0].Denom, collateralizationRatio, liquidationRatio)
+		return types.ErrInvalidCollateralRatio(k.codespace, collateralType, collateralizationRatio, liquidationRatio)
+	}
+	return nil
+}
+
+// ValidatePricesNotStale validate that the market prices of the input collateral and principal are not stale, as new debt can't be drawn and collateral can't be withdrawn against stale prices
+func (k Keeper) ValidatePricesNotStale(ctx sdk.Context, collateral sdk.Coins, principal sdk.Coins) sdk.Error {
+	marketIDs := []string{}
+	for _, cc := range collateral {
+		marketIDs = append(marketIDs, k.getMarketID(ctx, cc.Denom))
+	}
+	for _, dc := range principal {
+		dp, found := k.GetDebtParam(ctx, dc.Denom)
+		if found && dp.MarketID != "" {
+			marketIDs = append(marketIDs, dp.MarketID)
+		}
+	}
+	for _, marketID := range marketIDs {
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+		if err != nil {
+			return err
+		}
+		if price.IsStale() {
+			return types.ErrPriceStale(k.codespace, marketID, price.StaleSince)
+		}
 	}
 	return nil
 }
 
-// CalculateCollateralToDebtRatio returns the collateral to debt ratio of the input collateral and debt amounts
-func (k Keeper) CalculateCollateralToDebtRatio(ctx sdk.Context, collateral sdk.Coins, debt sdk.Coins) sdk.Dec {
+// CalculateCollateralToDebtRatio returns the ratio of the collateral of the input collateral type to the input normalized principal.
+// Only collateral of the cdp's own collateral type is counted, as this is the ratio cdps are indexed by. Unlike the ratio
+// to the cdp's debt, it doesn't change as fees accrue, so the index only needs updating when the cdp is.
+func (k Keeper) CalculateCollateralToDebtRatio(ctx sdk.Context, collateralType string, collateral sdk.Coins, normalizedPrincipal sdk.DecCoins) sdk.Dec {
 	debtTotal := sdk.ZeroDec()
-	for _, dc := range debt {
-		debtBaseUnits := k.convertDebtToBaseUnits(ctx, dc)
+	for _, np := range normalizedPrincipal {
+		dp, _ := k.GetDebtParam(ctx, np.Denom)
+		debtBaseUnits := np.Amount.Mul(sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64()))
 		debtTotal = debtTotal.Add(debtBaseUnits)
 	}
 
@@ -409,39 +466,38 @@ func (k Keeper) CalculateCollateralToDebtRatio(ctx sdk.Context, collateral sdk.C
 		return types.MaxSortableDec.Sub(sdk.SmallestDec())
 	}
 
-	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, collateral[0])
+	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, sdk.NewCoin(collateralType, collateral.AmountOf(collateralType)))
 	return collateralBaseUnits.Quo(debtTotal)
 }
 
 // LoadAugmentedCDP creates a new augmented CDP from an existing CDP
 func (k Keeper) LoadAugmentedCDP(ctx sdk.Context, cdp types.CDP) (types.AugmentedCDP, sdk.Error) {
-	// calculate additional fees
-	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
-	totalFees := cdp.AccumulatedFees.Add(fees)
+	debt := k.CalculateDebt(ctx, cdp)
 
 	// calculate collateralization ratio
-	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Principal, totalFees)
+	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, debt, sdk.NewCoins())
 	if err != nil {
 		return types.AugmentedCDP{}, err
 	}
 
-	// total debt is the sum of all oustanding principal and fees
-	var totalDebt int64
-	for _, principalCoin := range cdp.Principal {
-		totalDebt += principalCoin.Amount.Int64()
+	// convert collateral value to each of the cdp's debt assets
+	collateralValue, err := k.CalculateCollateralValue(ctx, cdp.Collateral)
+	if err != nil {
+		return types.AugmentedCDP{}, err
 	}
-	for _, feeCoin := range cdp.AccumulatedFees.Add(fees) {
-		totalDebt += feeCoin.Amount.Int64()
+	collateralValueInDebt := sdk.NewCoins()
+	for _, dc := range debt {
+		debtPrice, err := k.getDebtPrice(ctx, dc.Denom)
+		if err != nil {
+			return types.AugmentedCDP{}, err
+		}
+		dp, _ := k.GetDebtParam(ctx, dc.Denom)
+		collateralValueInDebtDenom := collateralValue.Quo(debtPrice).MulInt(sdk.NewIntWithDecimal(1, int(dp.ConversionFactor.Int64())))
+		collateralValueInDebt = collateralValueInDebt.Add(sdk.NewCoins(sdk.NewCoin(dc.Denom, collateralValueInDebtDenom.TruncateInt())))
 	}
 
-	// convert collateral value to debt coin
-	debtBaseAdjusted := sdk.NewDec(totalDebt).QuoInt64(BaseDigitFactor)
-	collateralValueInDebtDenom := collateralizationRatio.Mul(debtBaseAdjusted)
-	collateralValueInDebt := sdk.NewInt64Coin(cdp.Principal[0].Denom, collateralValueInDebtDenom.Int64())
-
 	// create new augmuented cdp
-	augmentedCDP := types.NewAugmentedCDP(cdp, collateralValueInDebt, collateralizationRatio)
+	augmentedCDP := types.NewAugmentedCDP(cdp, debt, collateralValueInDebt, collateralizationRatio)
 	return augmentedCDP, nil
 }
 
@@ -450,27 +506,173 @@ func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.
 	if collateral.IsZero() {
 		return sdk.ZeroDec(), nil
 	}
-	marketID := k.getMarketID(ctx, collateral[0].Denom)
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	collateralValue, err := k.CalculateCollateralValue(ctx, collateral)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	debtValue, err := k.calculateDebtValue(ctx, principal, fees)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, collateral[0])
-	collateralValue := collateralBaseUnits.Mul(price.Price)
+	collateralRatio := collateralValue.Quo(debtValue)
+	return collateralRatio, nil
+}
 
-	principalTotal := sdk.ZeroDec()
-	for _, pc := range principal {
-		prinicpalBaseUnits := k.convertDebtToBaseUnits(ctx, pc)
-		principalTotal = principalTotal.Add(prinicpalBaseUnits)
+// CalculateLiquidationCollateralizationRatio returns the collateralization ratio of the input collateral to the input debt plus fees,
+// with each collateral asset valued at the price its collateral type is checked for liquidation at
+func (k Keeper) CalculateLiquidationCollateralizationRatio(ctx sdk.Context, collateral sdk.Coins, principal sdk.Coins, fees sdk.Coins) (sdk.Dec, sdk.Error) {
+	if collateral.IsZero() {
+		return sdk.ZeroDec(), nil
+	}
+	collateralValue, err := k.calculateLiquidationCollateralValue(ctx, collateral)
+	if err != nil {
+		return sdk.Dec{}, err
 	}
-	for _, fc := range fees {
-		feeBaseUnits := k.convertDebtToBaseUnits(ctx, fc)
-		principalTotal = principalTotal.Add(feeBaseUnits)
+	debtValue, err := k.calculateDebtValue(ctx, principal, fees)
+	if err != nil {
+		return sdk.Dec{}, err
 	}
-	collateralRatio := collateralValue.Quo(principalTotal)
+	collateralRatio := collateralValue.Quo(debtValue)
 	return collateralRatio, nil
 }
 
+// calculateLiquidationCollateralValue returns the total market value of the input collateral, with each collateral asset
+// valued at the price cdps are checked for liquidation at
+func (k Keeper) calculateLiquidationCollateralValue(ctx sdk.Context, collateral sdk.Coins) (sdk.Dec, sdk.Error) {
+	collateralValue := sdk.ZeroDec()
+	for _, cc := range collateral {
+		cp, _ := k.GetCollateral(ctx, cc.Denom)
+		price, err := k.getLiquidationPrice(ctx, cp.MarketID, cp.LiquidationTWAPWindow)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, cc)
+		collateralValue = collateralValue.Add(collateralBaseUnits.Mul(price))
+	}
+	return collateralValue, nil
+}
+
+// calculateDebtValue returns the total market value of the input principal and fees, with each debt asset priced by its reference asset
+func (k Keeper) calculateDebtValue(ctx sdk.Context, principal sdk.Coins, fees sdk.Coins) (sdk.Dec, sdk.Error) {
+	debtValue := sdk.ZeroDec()
+	for _, dc := range principal.Add(fees) {
+		price, err := k.getDebtPrice(ctx, dc.Denom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		debtBaseUnits := k.convertDebtToBaseUnits(ctx, dc)
+		debtValue = debtValue.Add(debtBaseUnits.Mul(price))
+	}
+	return debtValue, nil
+}
+
+// getDebtPrice returns the price of the reference asset of the input debt denom in the quote asset of the collateral markets.
+// Debt assets without a market are referenced to that quote asset, so they are priced at one.
+func (k Keeper) getDebtPrice(ctx sdk.Context, denom string) (sdk.Dec, sdk.Error) {
+	dp, found := k.GetDebtParam(ctx, denom)
+	if !found {
+		return sdk.Dec{}, types.ErrDebtNotSupported(k.codespace, denom)
+	}
+	if dp.MarketID == "" {
+		return sdk.OneDec(), nil
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, dp.MarketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return price.Price, nil
+}
+
+// getMaxDebtPrice returns the highest price of all debt assets, or one if no debt asset is priced above one
+func (k Keeper) getMaxDebtPrice(ctx sdk.Context) (sdk.Dec, sdk.Error) {
+	maxPrice := sdk.OneDec()
+	for _, dp := range k.GetParams(ctx).DebtParams {
+		price, err := k.getDebtPrice(ctx, dp.Denom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		maxPrice = sdk.MaxDec(maxPrice, price)
+	}
+	return maxPrice, nil
+}
+
+// holdsPricedDebt returns true if the input cdp owes any debt asset that is priced by a market
+func (k Keeper) holdsPricedDebt(ctx sdk.Context, cdp types.CDP) bool {
+	for _, np := range cdp.NormalizedPrincipal {
+		dp, found := k.GetDebtParam(ctx, np.Denom)
+		if found && dp.MarketID != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// getLiquidationPrice returns the price cdps are checked for liquidation at, which is the time-weighted average price
+// of the market over the input window, or the market's current price if the window is zero.
+// The current price is also used if the average is unavailable, for example because the pricefeed's max twap window
+// has been lowered below the input window, so that cdps are still liquidated.
+func (k Keeper) getLiquidationPrice(ctx sdk.Context, marketID string, twapWindow time.Duration) (sdk.Dec, sdk.Error) {
+	if twapWindow > 0 {
+		price, err := k.pricefeedKeeper.GetTWAP(ctx, marketID, twapWindow)
+		if err == nil {
+			return price.Price, nil
+		}
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return price.Price, nil
+}
+
+// CalculateCollateralValue returns the total market value of the input collateral, with each collateral asset priced by its own market
+func (k Keeper) CalculateCollateralValue(ctx sdk.Context, collateral sdk.Coins) (sdk.Dec, sdk.Error) {
+	collateralValue := sdk.ZeroDec()
+	for _, cc := range collateral {
+		marketID := k.getMarketID(ctx, cc.Denom)
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, cc)
+		collateralValue = collateralValue.Add(collateralBaseUnits.Mul(price.Price))
+	}
+	return collateralValue, nil
+}
+
+// CalculateLiquidationRatio returns the liquidation ratio of a cdp of the input collateral type that holds the input collateral.
+// A cdp holding a basket of collateral assets can back as much debt as each asset's value divided by its own liquidation ratio,
+// so its liquidation ratio is the value weighted harmonic mean of the liquidation ratios of its assets.
+func (k Keeper) CalculateLiquidationRatio(ctx sdk.Context, collateralType string, collateral sdk.Coins) (sdk.Dec, sdk.Error) {
+	if len(collateral) == 0 || (len(collateral) == 1 && collateral[0].Denom == collateralType) {
+		return k.getLiquidationRatio(ctx, collateralType), nil
+	}
+	totalValue := sdk.ZeroDec()
+	maxDebt := sdk.ZeroDec()
+	for _, cc := range collateral {
+		value, err := k.CalculateCollateralValue(ctx, sdk.NewCoins(cc))
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		totalValue = totalValue.Add(value)
+		maxDebt = maxDebt.Add(value.Quo(k.getLiquidationRatio(ctx, cc.Denom)))
+	}
+	if maxDebt.IsZero() {
+		return k.getLiquidationRatio(ctx, collateralType), nil
+	}
+	return totalValue.Quo(maxDebt), nil
+}
+
+// getMaxLiquidationRatio returns the highest liquidation ratio of all collateral types, which is the highest basket liquidation ratio a cdp can have
+func (k Keeper) getMaxLiquidationRatio(ctx sdk.Context) sdk.Dec {
+	maxRatio := sdk.ZeroDec()
+	for _, cp := range k.GetParams(ctx).CollateralParams {
+		maxRatio = sdk.MaxDec(maxRatio, cp.LiquidationRatio)
+	}
+	return maxRatio
+}
+
 // CalculateCollateralizationRatioFromAbsoluteRatio takes a coin's denom and an absolute ratio and returns the respective collateralization ratio
 func (k Keeper) CalculateCollateralizationRatioFromAbsoluteRatio(ctx sdk.Context, collateralDenom string, absoluteRatio sdk.Dec) (sdk.Dec, sdk.Error) {
 	// get price collateral
@@ -27,8 +27,8 @@ func (suite *CdpTestSuite) SetupTest() {
 	tApp := app.NewTestApp()
 	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
 	tApp.InitializeFromGenesisStates(
-		NewPricefeedGenStateMulti(),
-		NewCDPGenStateMulti(),
+		NewPricefeedGenStateMultiDebt(),
+		NewCDPGenStateMultiDebt(),
 	)
 	keeper := tApp.GetCDPKeeper()
 	suite.app = tApp
@@ -64,7 +64,7 @@ func (suite *CdpTestSuite) TestAddCdp() {
 	suite.Equal(i(10000000), tp)
 	sk := suite.app.GetSupplyKeeper()
 	macc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("debt", 10000000), c("xrp", 100000000)), macc.GetCoins())
+	suite.Equal(cs(c("debtusdx", 10000000), c("xrp", 100000000)), macc.GetCoins())
 	acc = ak.GetAccount(suite.ctx, addrs[0])
 	suite.Equal(cs(c("usdx", 10000000), c("xrp", 100000000), c("btc", 500000000)), acc.GetCoins())
 
@@ -78,7 +78,7 @@ func (suite *CdpTestSuite) TestAddCdp() {
 	tp = suite.keeper.GetTotalPrincipal(suite.ctx, "btc", "usdx")
 	suite.Equal(i(100000000), tp)
 	macc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("debt", 110000000), c("xrp", 100000000), c("btc", 500000000)), macc.GetCoins())
+	suite.Equal(cs(c("debtusdx", 110000000), c("xrp", 100000000), c("btc", 500000000)), macc.GetCoins())
 	acc = ak.GetAccount(suite.ctx, addrs[0])
 	suite.Equal(cs(c("usdx", 110000000), c("xrp", 100000000)), acc.GetCoins())
 
@@ -112,7 +112,7 @@ func (suite *CdpTestSuite) TestGetNextCdpID() {
 
 func (suite *CdpTestSuite) TestGetSetCdp() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(1)
-	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 1)), cs(c("usdx", 1)), tmtime.Canonical(time.Now()))
+	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 1)), sdk.NewDecCoins(cs(c("usdx", 1))))
 	suite.keeper.SetCDP(suite.ctx, cdp)
 	t, found := suite.keeper.GetCDP(suite.ctx, "xrp", types.DefaultCdpStartingID)
 	suite.True(found)
@@ -126,7 +126,7 @@ func (suite *CdpTestSuite) TestGetSetCdp() {
 
 func (suite *CdpTestSuite) TestGetSetCdpId() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(2)
-	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 1)), cs(c("usdx", 1)), tmtime.Canonical(time.Now()))
+	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 1)), sdk.NewDecCoins(cs(c("usdx", 1))))
 	suite.keeper.SetCDP(suite.ctx, cdp)
 	suite.keeper.IndexCdpByOwner(suite.ctx, cdp)
 	id, found := suite.keeper.GetCdpID(suite.ctx, addrs[0], "xrp")
@@ -140,7 +140,7 @@ func (suite *CdpTestSuite) TestGetSetCdpId() {
 
 func (suite *CdpTestSuite) TestGetSetCdpByOwnerAndDenom() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(2)
-	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 1)), cs(c("usdx", 1)), tmtime.Canonical(time.Now()))
+	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 1)), sdk.NewDecCoins(cs(c("usdx", 1))))
 	suite.keeper.SetCDP(suite.ctx, cdp)
 	suite.keeper.IndexCdpByOwner(suite.ctx, cdp)
 	t, found := suite.keeper.GetCdpByOwnerAndDenom(suite.ctx, addrs[0], "xrp")
@@ -155,21 +155,21 @@ func (suite *CdpTestSuite) TestGetSetCdpByOwnerAndDenom() {
 
 func (suite *CdpTestSuite) TestCalculateCollateralToDebtRatio() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(1)
-	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 3)), cs(c("usdx", 1)), tmtime.Canonical(time.Now()))
-	cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, cdp.Principal)
+	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 3)), sdk.NewDecCoins(cs(c("usdx", 1))))
+	cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 	suite.Equal(sdk.MustNewDecFromStr("3.0"), cr)
-	cdp = types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 1)), cs(c("usdx", 2)), tmtime.Canonical(time.Now()))
-	cr = suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, cdp.Principal)
+	cdp = types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 1)), sdk.NewDecCoins(cs(c("usdx", 2))))
+	cr = suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 	suite.Equal(sdk.MustNewDecFromStr("0.5"), cr)
-	cdp = types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 3)), cs(c("usdx", 1), c("susd", 2)), tmtime.Canonical(time.Now()))
-	cr = suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, cdp.Principal)
+	cdp = types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 3)), sdk.NewDecCoins(cs(c("usdx", 1), c("susd", 2))))
+	cr = suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 	suite.Equal(sdk.MustNewDecFromStr("1"), cr)
 }
 
 func (suite *CdpTestSuite) TestSetCdpByCollateralRatio() {
 	_, addrs := app.GeneratePrivKeyAddressPairs(1)
-	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 3)), cs(c("usdx", 1)), tmtime.Canonical(time.Now()))
-	cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, cdp.Principal)
+	cdp := types.NewCDP(types.DefaultCdpStartingID, addrs[0], cs(c("xrp", 3)), sdk.NewDecCoins(cs(c("usdx", 1))))
+	cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 	suite.NotPanics(func() { suite.keeper.IndexCdpByCollateralRatio(suite.ctx, cdp.Collateral[0].Denom, cdp.ID, cr) })
 }
 
@@ -178,7 +178,7 @@ func (suite *CdpTestSuite) TestIterateCdps() {
 	for _, c := range cdps {
 		suite.keeper.SetCDP(suite.ctx, c)
 		suite.keeper.IndexCdpByOwner(suite.ctx, c)
-		cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, c.Collateral, c.Principal)
+		cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, c.Type, c.Collateral, c.NormalizedPrincipal)
 		suite.keeper.IndexCdpByCollateralRatio(suite.ctx, c.Collateral[0].Denom, c.ID, cr)
 	}
 	t := suite.keeper.GetAllCdps(suite.ctx)
@@ -190,7 +190,7 @@ func (suite *CdpTestSuite) TestIterateCdpsByDenom() {
 	for _, c := range cdps {
 		suite.keeper.SetCDP(suite.ctx, c)
 		suite.keeper.IndexCdpByOwner(suite.ctx, c)
-		cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, c.Collateral, c.Principal)
+		cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, c.Type, c.Collateral, c.NormalizedPrincipal)
 		suite.keeper.IndexCdpByCollateralRatio(suite.ctx, c.Collateral[0].Denom, c.ID, cr)
 	}
 	xrpCdps := suite.keeper.GetAllCdpsByDenom(suite.ctx, "xrp")
@@ -214,7 +214,7 @@ func (suite *CdpTestSuite) TestIterateCdpsByCollateralRatio() {
 	for _, c := range cdps {
 		suite.keeper.SetCDP(suite.ctx, c)
 		suite.keeper.IndexCdpByOwner(suite.ctx, c)
-		cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, c.Collateral, c.Principal)
+		cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, c.Type, c.Collateral, c.NormalizedPrincipal)
 		suite.keeper.IndexCdpByCollateralRatio(suite.ctx, c.Collateral[0].Denom, c.ID, cr)
 	}
 	xrpCdps := suite.keeper.GetAllCdpsByDenomAndRatio(suite.ctx, "xrp", d("1.25"))
@@ -227,7 +227,7 @@ func (suite *CdpTestSuite) TestIterateCdpsByCollateralRatio() {
 	suite.Equal(3, len(xrpCdps))
 	suite.keeper.DeleteCDP(suite.ctx, cdps[0])
 	suite.keeper.RemoveCdpOwnerIndex(suite.ctx, cdps[0])
-	cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdps[0].Collateral, cdps[0].Principal)
+	cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdps[0].Type, cdps[0].Collateral, cdps[0].NormalizedPrincipal)
 	suite.keeper.RemoveCdpCollateralRatioIndex(suite.ctx, cdps[0].Collateral[0].Denom, cdps[0].ID, cr)
 	xrpCdps = suite.keeper.GetAllCdpsByDenomAndRatio(suite.ctx, "xrp", d("2.0").Add(sdk.SmallestDec()))
 	suite.Equal(1, len(xrpCdps))
@@ -240,8 +240,14 @@ func (suite *CdpTestSuite) TestValidateCollateral() {
 	c = sdk.NewCoins(sdk.NewCoin("lol", sdk.NewInt(1)))
 	err = suite.keeper.ValidateCollateral(suite.ctx, c)
 	suite.Equal(types.CodeCollateralNotSupported, err.Result().Code)
+	c = sdk.NewCoins(sdk.NewCoin("btc", sdk.NewInt(1)), sdk.NewCoin("xrp", sdk.NewInt(1)))
+	err = suite.keeper.ValidateCollateral(suite.ctx, c)
+	suite.NoError(err)
 	c = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)), sdk.NewCoin("xrp", sdk.NewInt(1)))
 	err = suite.keeper.ValidateCollateral(suite.ctx, c)
+	suite.Equal(types.CodeCollateralNotSupported, err.Result().Code)
+	c = sdk.NewCoins()
+	err = suite.keeper.ValidateCollateral(suite.ctx, c)
 	suite.Equal(types.CodeCollateralLengthInvalid, err.Result().Code)
 }
 
@@ -261,36 +267,136 @@ func (suite *CdpTestSuite) TestValidatePrincipal() {
 	d = sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100000000)))
 	err = suite.keeper.ValidateDebtLimit(suite.ctx, "xrp", d)
 	suite.NoError(err)
+	d = sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(500000000001)))
+	err = suite.keeper.ValidateDebtLimit(suite.ctx, "xrp", d)
+	suite.Equal(types.CodeExceedsDebtLimit, err.Result().Code)
+}
+
+func (suite *CdpTestSuite) TestValidatePrincipalDraw() {
+	// each debt denom drawn must be at least its debt floor after the draw
+	err := suite.keeper.ValidatePrincipalDraw(suite.ctx, cs(c("eur", 5000000)), cs(c("usdx", 10000000)))
+	suite.Equal(types.CodeBelowDebtFloor, err.Result().Code)
+	err = suite.keeper.ValidatePrincipalDraw(suite.ctx, cs(c("eur", 5000000)), cs(c("usdx", 10000000), c("eur", 5000000)))
+	suite.NoError(err)
+	err = suite.keeper.ValidatePrincipalDraw(suite.ctx, cs(c("usdx", 1)), cs(c("usdx", 10000000)))
+	suite.NoError(err)
+	err = suite.keeper.ValidatePrincipalDraw(suite.ctx, cs(c("xusd", 10000000)), cs(c("usdx", 10000000)))
+	suite.Equal(types.CodeDebtNotSupported, err.Result().Code)
+}
+
+func (suite *CdpTestSuite) TestValidateDebtLimitGlobal() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.GlobalDebtLimit = cs(c("usdx", 600000000000), c("susd", 1000000000000), c("eur", 1000000000000))
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetTotalPrincipal(suite.ctx, "btc", "usdx", i(500000000000))
+
+	// the global debt limit covers the principal drawn against every collateral type
+	err := suite.keeper.ValidateDebtLimit(suite.ctx, "xrp", cs(c("usdx", 100000000001)))
+	suite.Equal(types.CodeExceedsDebtLimit, err.Result().Code)
+	err = suite.keeper.ValidateDebtLimit(suite.ctx, "xrp", cs(c("usdx", 100000000000)))
+	suite.NoError(err)
+	err = suite.keeper.ValidateDebtLimit(suite.ctx, "xrp", cs(c("eur", 500000000000)))
+	suite.NoError(err)
 }
 
 func (suite *CdpTestSuite) TestCalculateCollateralizationRatio() {
 	c := cdps()[1]
 	suite.keeper.SetCDP(suite.ctx, c)
 	suite.keeper.IndexCdpByOwner(suite.ctx, c)
-	cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, c.Collateral, c.Principal)
+	cr := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, c.Type, c.Collateral, c.NormalizedPrincipal)
 	suite.keeper.IndexCdpByCollateralRatio(suite.ctx, c.Collateral[0].Denom, c.ID, cr)
-	cr, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, c.Collateral, c.Principal, c.AccumulatedFees)
+	debt := suite.keeper.CalculateDebt(suite.ctx, c)
+	cr, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, c.Collateral, debt, sdk.NewCoins())
 	suite.NoError(err)
 	suite.Equal(d("2.5"), cr)
-	c.AccumulatedFees = sdk.NewCoins(sdk.NewCoin("usdx", i(10000000)))
-	cr, err = suite.keeper.CalculateCollateralizationRatio(suite.ctx, c.Collateral, c.Principal, c.AccumulatedFees)
+	fees := sdk.NewCoins(sdk.NewCoin("usdx", i(10000000)))
+	cr, err = suite.keeper.CalculateCollateralizationRatio(suite.ctx, c.Collateral, debt, fees)
 	suite.NoError(err)
 	suite.Equal(d("1.25"), cr)
 }
 
+func (suite *CdpTestSuite) TestCalculateCollateralizationRatioMultiCollateral() {
+	// $100 of xrp and $300 of btc
+	collateral := cs(c("btc", 3750000), c("xrp", 400000000))
+	cr, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, collateral, cs(c("usdx", 100000000)), cs())
+	suite.NoError(err)
+	suite.Equal(d("4.0"), cr)
+
+	lr, err := suite.keeper.CalculateLiquidationRatio(suite.ctx, "xrp", cs(c("xrp", 400000000)))
+	suite.NoError(err)
+	suite.Equal(d("2.0"), lr)
+	lr, err = suite.keeper.CalculateLiquidationRatio(suite.ctx, "xrp", cs())
+	suite.NoError(err)
+	suite.Equal(d("2.0"), lr)
+	lr, err = suite.keeper.CalculateLiquidationRatio(suite.ctx, "xrp", cs(c("btc", 3750000)))
+	suite.NoError(err)
+	suite.Equal(d("1.5"), lr)
+	// the xrp can back $50 of debt and the btc $200
+	lr, err = suite.keeper.CalculateLiquidationRatio(suite.ctx, "xrp", collateral)
+	suite.NoError(err)
+	suite.Equal(d("1.6"), lr)
+
+	suite.NoError(suite.keeper.ValidateCollateralizationRatio(suite.ctx, "xrp", collateral, cs(c("usdx", 250000000)), cs()))
+	err = suite.keeper.ValidateCollateralizationRatio(suite.ctx, "xrp", collateral, cs(c("usdx", 250000001)), cs())
+	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
+}
+
+func (suite *CdpTestSuite) TestCalculateCollateralizationRatioPricedDebt() {
+	// $100 of xrp backing 40 eur, worth $50
+	collateral := cs(c("xrp", 400000000))
+	cr, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, collateral, cs(c("eur", 40000000)), cs())
+	suite.NoError(err)
+	suite.Equal(d("2.0"), cr)
+	cr, err = suite.keeper.CalculateCollateralizationRatio(suite.ctx, collateral, cs(c("eur", 20000000)), cs(c("eur", 20000000)))
+	suite.NoError(err)
+	suite.Equal(d("2.0"), cr)
+	// debt assets are valued together
+	cr, err = suite.keeper.CalculateCollateralizationRatio(suite.ctx, collateral, cs(c("eur", 40000000), c("usdx", 50000000)), cs())
+	suite.NoError(err)
+	suite.Equal(d("1.0"), cr)
+
+	// the debt is revalued when the price of its reference asset changes
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "eur:usd", d("1.0"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.NoError(err)
+	suite.NoError(pk.SetCurrentPrices(suite.ctx, "eur:usd"))
+	cr, err = suite.keeper.CalculateCollateralizationRatio(suite.ctx, collateral, cs(c("eur", 40000000)), cs())
+	suite.NoError(err)
+	suite.Equal(d("2.5"), cr)
+}
+
+func (suite *CdpTestSuite) TestLoadAugmentedCDPPricedDebt() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.NewAccountWithAddress(suite.ctx, addrs[0])
+	acc.SetCoins(cs(c("xrp", 400000000)))
+	ak.SetAccount(suite.ctx, acc)
+	// $100 of xrp backing 20 usdx and 16 eur, worth $20
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], cs(c("xrp", 400000000)), cs(c("usdx", 20000000), c("eur", 16000000)))
+	suite.NoError(err)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	augmentedCDP, err := suite.keeper.LoadAugmentedCDP(suite.ctx, cdp)
+	suite.NoError(err)
+	suite.Equal(cs(c("usdx", 20000000), c("eur", 16000000)), augmentedCDP.Debt)
+	// the collateral is valued in each debt asset at its own price
+	suite.Equal(cs(c("usdx", 100000000), c("eur", 80000000)), augmentedCDP.CollateralValue)
+	suite.Equal(d("2.5"), augmentedCDP.CollateralizationRatio)
+}
+
 func (suite *CdpTestSuite) TestMintBurnDebtCoins() {
 	cd := cdps()[1]
-	err := suite.keeper.MintDebtCoins(suite.ctx, types.ModuleName, suite.keeper.GetDebtDenom(suite.ctx), cd.Principal)
+	principal := suite.keeper.CalculateDebt(suite.ctx, cd)
+	err := suite.keeper.MintDebtCoins(suite.ctx, types.ModuleName, suite.keeper.GetDebtDenom(suite.ctx), principal)
 	suite.NoError(err)
-	err = suite.keeper.MintDebtCoins(suite.ctx, "notamodule", suite.keeper.GetDebtDenom(suite.ctx), cd.Principal)
+	err = suite.keeper.MintDebtCoins(suite.ctx, "notamodule", suite.keeper.GetDebtDenom(suite.ctx), principal)
 	suite.Error(err)
 	sk := suite.app.GetSupplyKeeper()
 	acc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("debt", 10000000)), acc.GetCoins())
+	suite.Equal(cs(c("debtusdx", 10000000)), acc.GetCoins())
 
-	err = suite.keeper.BurnDebtCoins(suite.ctx, types.ModuleName, suite.keeper.GetDebtDenom(suite.ctx), cd.Principal)
+	err = suite.keeper.BurnDebtCoins(suite.ctx, types.ModuleName, suite.keeper.GetDebtDenom(suite.ctx), principal)
 	suite.NoError(err)
-	err = suite.keeper.BurnDebtCoins(suite.ctx, "notamodule", suite.keeper.GetDebtDenom(suite.ctx), cd.Principal)
+	err = suite.keeper.BurnDebtCoins(suite.ctx, "notamodule", suite.keeper.GetDebtDenom(suite.ctx), principal)
 	suite.Error(err)
 	sk = suite.app.GetSupplyKeeper()
 	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
@@ -8,15 +8,15 @@ import (
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
-// DepositCollateral adds collateral to a cdp
-func (k Keeper) DepositCollateral(ctx sdk.Context, owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coins) sdk.Error {
+// DepositCollateral adds collateral to a cdp. Any supported collateral assets can be deposited to a cdp of any collateral type.
+func (k Keeper) DepositCollateral(ctx sdk.Context, owner sdk.AccAddress, depositor sdk.AccAddress, denom string, collateral sdk.Coins) sdk.Error {
 	err := k.ValidateCollateral(ctx, collateral)
 	if err != nil {
 		return err
 	}
-	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, collateral[0].Denom)
+	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, denom)
 	if !found {
-		return types.ErrCdpNotFound(k.codespace, owner, collateral[0].Denom)
+		return types.ErrCdpNotFound(k.codespace, owner, denom)
 	}
 
 	deposit, found := k.GetDeposit(ctx, cdp.ID, depositor)
@@ -39,46 +39,44 @@ func (k Keeper) DepositCollateral(ctx sdk.Context, owner sdk.AccAddress, deposit
 
 	k.SetDeposit(ctx, deposit)
 
-	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
-	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
-	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Collateral[0].Denom, cdp.ID, oldCollateralToDebtRatio)
+	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
+	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
 
-	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(fees)
-	cdp.FeesUpdated = ctx.BlockTime()
 	cdp.Collateral = cdp.Collateral.Add(collateral)
-	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 	k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
 	return nil
 }
 
 // WithdrawCollateral removes collateral from a cdp if it does not put the cdp below the liquidation ratio
-func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coins) sdk.Error {
+func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner sdk.AccAddress, depositor sdk.AccAddress, denom string, collateral sdk.Coins) sdk.Error {
+	if k.GetCircuitBreaker(ctx) {
+		return types.ErrCircuitBreakerTripped(k.codespace, "withdraw collateral")
+	}
 	err := k.ValidateCollateral(ctx, collateral)
 	if err != nil {
 		return err
 	}
-	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, collateral[0].Denom)
+	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, denom)
 	if !found {
-		return types.ErrCdpNotFound(k.codespace, owner, collateral[0].Denom)
+		return types.ErrCdpNotFound(k.codespace, owner, denom)
 	}
 	deposit, found := k.GetDeposit(ctx, cdp.ID, depositor)
 	if !found {
 		return types.ErrDepositNotFound(k.codespace, depositor, cdp.ID)
 	}
-	if collateral.IsAnyGT(deposit.Amount) {
+	if !deposit.Amount.IsAllGTE(collateral) {
 		return types.ErrInvalidWithdrawAmount(k.codespace, collateral, deposit.Amount)
 	}
-
-	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
-	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral.Sub(collateral), cdp.Principal, cdp.AccumulatedFees.Add(fees))
+	debt := k.CalculateDebt(ctx, cdp)
+	err = k.ValidatePricesNotStale(ctx, cdp.Collateral, debt)
 	if err != nil {
 		return err
 	}
-	liquidationRatio := k.getLiquidationRatio(ctx, collateral[0].Denom)
-	if collateralizationRatio.LT(liquidationRatio) {
-		return types.ErrInvalidCollateralRatio(k.codespace, collateral[0].Denom, collateralizationRatio, liquidationRatio)
+
+	err = k.ValidateCollateralizationRatio(ctx, cdp.Type, cdp.Collateral.Sub(collateral), debt, sdk.NewCoins())
+	if err != nil {
+		return err
 	}
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -92,13 +90,11 @@ func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner sdk.AccAddress, deposi
 	if err != nil {
 		panic(err)
 	}
-	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
-	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Collateral[0].Denom, cdp.ID, oldCollateralToDebtRatio)
+	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
+	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
 
-	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(fees)
-	cdp.FeesUpdated = ctx.BlockTime()
 	cdp.Collateral = cdp.Collateral.Sub(collateral)
-	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 	k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
 
 	deposit.Amount = deposit.Amount.Sub(collateral)
@@ -152,6 +148,22 @@ func (k Keeper) IterateDeposits(ctx sdk.Context, cdpID uint64, cb func(deposit t
 	}
 }
 
+// IterateAllDeposits iterates over all the deposits in the store and performs a callback function
+func (k Keeper) IterateAllDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deposit)
+
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
 // GetDeposits returns all the deposits to a cdp
 func (k Keeper) GetDeposits(ctx sdk.Context, cdpID uint64) (deposits types.Deposits) {
 	k.IterateDeposits(ctx, cdpID, func(deposit types.Deposit) bool {
@@ -60,7 +60,7 @@ func (suite *DepositTestSuite) TestGetSetDeposit() {
 }
 
 func (suite *DepositTestSuite) TestDepositCollateral() {
-	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("xrp", 10000000)))
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 10000000)))
 	suite.NoError(err)
 	d, found := suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[0])
 	suite.True(found)
@@ -75,13 +75,13 @@ func (suite *DepositTestSuite) TestDepositCollateral() {
 	acc := ak.GetAccount(suite.ctx, suite.addrs[0])
 	suite.Equal(i(90000000), acc.GetCoins().AmountOf("xrp"))
 
-	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("btc", 1)))
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "btc", cs(c("btc", 1)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
 
-	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], cs(c("xrp", 1)))
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp", cs(c("xrp", 1)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
 
-	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], cs(c("xrp", 10000000)))
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("xrp", 10000000)))
 	suite.NoError(err)
 	d, found = suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[1])
 	suite.True(found)
@@ -93,20 +93,21 @@ func (suite *DepositTestSuite) TestDepositCollateral() {
 }
 
 func (suite *DepositTestSuite) TestWithdrawCollateral() {
-	err := suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("xrp", 400000000)))
+	err := suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 400000000)))
 	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("xrp", 321000000)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 321000000)))
 	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], cs(c("xrp", 10000000)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp", cs(c("xrp", 10000000)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
 
 	cd, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
-	cd.AccumulatedFees = cs(c("usdx", 1))
+	// one unit of fees at an interest factor of one
+	cd.NormalizedPrincipal = cd.NormalizedPrincipal.Add(sdk.DecCoins{sdk.NewInt64DecCoin("usdx", 1)})
 	suite.keeper.SetCDP(suite.ctx, cd)
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("xrp", 320000000)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 320000000)))
 	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
 
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("xrp", 10000000)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 10000000)))
 	suite.NoError(err)
 	dep, _ := suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[0])
 	td := types.NewDeposit(uint64(1), suite.addrs[0], cs(c("xrp", 390000000)))
@@ -115,10 +116,47 @@ func (suite *DepositTestSuite) TestWithdrawCollateral() {
 	acc := ak.GetAccount(suite.ctx, suite.addrs[0])
 	suite.Equal(i(110000000), acc.GetCoins().AmountOf("xrp"))
 
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], cs(c("xrp", 10000000)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("xrp", 10000000)))
 	suite.Equal(types.CodeDepositNotFound, err.Result().Code)
 }
 
+func (suite *DepositTestSuite) TestDepositWithdrawOtherCollateral() {
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("btc", 100000000)))
+	suite.NoError(err)
+	cd, found := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.True(found)
+	suite.Equal("xrp", cd.Type)
+	suite.Equal(cs(c("btc", 100000000), c("xrp", 400000000)), cd.Collateral)
+	dep, _ := suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[0])
+	td := types.NewDeposit(uint64(1), suite.addrs[0], cs(c("btc", 100000000), c("xrp", 400000000)))
+	suite.True(dep.Equals(td))
+
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("lol", 1)))
+	suite.Equal(types.CodeCollateralNotSupported, err.Result().Code)
+
+	// collateral the depositor never deposited can't be withdrawn
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("xrp", 10000000)))
+	suite.NoError(err)
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("btc", 1)))
+	suite.Equal(types.CodeInvalidWithdrawAmount, err.Result().Code)
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("btc", 1), c("xrp", 10000000)))
+	suite.Equal(types.CodeInvalidWithdrawAmount, err.Result().Code)
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("xrp", 10000000)))
+	suite.NoError(err)
+
+	// the btc alone keeps the cdp above the liquidation ratio
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 400000000)))
+	suite.NoError(err)
+	cd, _ = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.Equal(cs(c("btc", 100000000)), cd.Collateral)
+
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("btc", 100000000)))
+	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.GetAccount(suite.ctx, suite.addrs[0])
+	suite.Equal(cs(c("btc", 400000000), c("usdx", 10000000), c("xrp", 500000000)), acc.GetCoins())
+}
+
 func TestDepositTestSuite(t *testing.T) {
 	suite.Run(t, new(DepositTestSuite))
 }
@@ -10,25 +10,30 @@ import (
 // AddPrincipal adds debt to a cdp if the additional debt does not put the cdp below the liquidation ratio
 func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom string, principal sdk.Coins) sdk.Error {
 	// validation
+	if k.GetCircuitBreaker(ctx) {
+		return types.ErrCircuitBreakerTripped(k.codespace, "draw debt")
+	}
 	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, denom)
 	if !found {
 		return types.ErrCdpNotFound(k.codespace, owner, denom)
 	}
-	err := k.ValidatePrincipalDraw(ctx, principal)
+	debt := k.CalculateDebt(ctx, cdp)
+	err := k.ValidatePrincipalDraw(ctx, principal, debt)
 	if err != nil {
 		return err
 	}
 
-	err = k.ValidateDebtLimit(ctx, cdp.Collateral[0].Denom, principal)
+	err = k.ValidateDebtLimit(ctx, cdp.Type, principal)
 	if err != nil {
 		return err
 	}
 
-	// fee calculation
-	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
+	err = k.ValidatePricesNotStale(ctx, cdp.Collateral, principal)
+	if err != nil {
+		return err
+	}
 
-	err = k.ValidateCollateralizationRatio(ctx, cdp.Collateral, cdp.Principal.Add(principal), cdp.AccumulatedFees.Add(fees))
+	err = k.ValidateCollateralizationRatio(ctx, cdp.Type, cdp.Collateral, debt.Add(principal), sdk.NewCoins())
 	if err != nil {
 		return err
 	}
@@ -59,19 +64,16 @@ func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom string
 	)
 
 	// remove old collateral:debt index
-	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
+	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 	k.RemoveCdpCollateralRatioIndex(ctx, denom, cdp.ID, oldCollateralToDebtRatio)
 
-	// update cdp state
-	cdp.Principal = cdp.Principal.Add(principal)
-	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(fees)
-	cdp.FeesUpdated = ctx.BlockTime()
-
-	// increment total principal for the input collateral type
-	k.IncrementTotalPrincipal(ctx, cdp.Collateral[0].Denom, principal)
+	// update cdp state and the total principal of its collateral type
+	k.DecrementNormalizedPrincipal(ctx, cdp)
+	cdp = k.addDebt(ctx, cdp, principal)
+	k.IncrementNormalizedPrincipal(ctx, cdp)
 
 	// set cdp state and indexes in the store
-	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 	k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
 
 	return nil
@@ -86,38 +88,32 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom stri
 		return types.ErrCdpNotFound(k.codespace, owner, denom)
 	}
 
-	// calculate fees
-	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
-	err := k.ValidatePaymentCoins(ctx, cdp, payment, cdp.Principal.Add(cdp.AccumulatedFees).Add(fees))
+	debt := k.CalculateDebt(ctx, cdp)
+	err := k.ValidatePaymentCoins(ctx, cdp, payment, debt)
 	if err != nil {
 		return err
 	}
 
-	// calculate fee and principal payment
-	feePayment, principalPayment := k.calculatePayment(ctx, cdp.Principal.Add(cdp.AccumulatedFees).Add(fees), cdp.AccumulatedFees.Add(fees), payment)
+	// payment of each denom is capped at the debt owed
+	payment = k.calculatePayment(ctx, debt, payment)
 
 	// send the payment from the sender to the cpd module
-	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, feePayment.Add(principalPayment))
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, payment)
 	if err != nil {
 		return err
 	}
 
 	// burn the payment coins
-	err = k.supplyKeeper.BurnCoins(ctx, types.ModuleName, feePayment.Add(principalPayment))
+	err = k.supplyKeeper.BurnCoins(ctx, types.ModuleName, payment)
 	if err != nil {
 		panic(err)
 	}
 
-	// burn the corresponding amount of debt coins
-	cdpDebt := k.getModAccountDebt(ctx, types.ModuleName)
-	paymentAmount := sdk.ZeroInt()
-	for _, c := range feePayment.Add(principalPayment) {
-		paymentAmount = paymentAmount.Add(c.Amount)
-	}
-	coinsToBurn := sdk.NewCoins(sdk.NewCoin(k.GetDebtDenom(ctx), paymentAmount))
-	if paymentAmount.GT(cdpDebt) {
-		coinsToBurn = sdk.NewCoins(sdk.NewCoin(k.GetDebtDenom(ctx), cdpDebt))
+	// burn the corresponding amount of debt coins of each payment denom
+	coinsToBurn := sdk.NewCoins()
+	for _, pc := range payment {
+		cdpDebt := k.getModAccountDebt(ctx, types.ModuleName, pc.Denom)
+		coinsToBurn = coinsToBurn.Add(sdk.NewCoins(sdk.NewCoin(pc.Denom, sdk.MinInt(pc.Amount, cdpDebt))))
 	}
 	err = k.BurnDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), coinsToBurn)
 	if err != nil {
@@ -128,28 +124,22 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom stri
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeCdpRepay,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, feePayment.Add(principalPayment).String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, payment.String()),
 			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
 		),
 	)
 
 	// remove the old collateral:debt ratio index
-	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
+	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 	k.RemoveCdpCollateralRatioIndex(ctx, denom, cdp.ID, oldCollateralToDebtRatio)
 
-	// update cdp state
-	if !principalPayment.IsZero() {
-		cdp.Principal = cdp.Principal.Sub(principalPayment)
-	}
-	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(fees).Sub(feePayment)
-	cdp.FeesUpdated = ctx.BlockTime()
-
-	// decrement the total principal for the input collateral type
-	k.DecrementTotalPrincipal(ctx, denom, feePayment.Add(principalPayment))
+	// update cdp state and the total principal of its collateral type
+	k.DecrementNormalizedPrincipal(ctx, cdp)
+	cdp = k.removeDebt(ctx, cdp, payment)
 
 	// if the debt is fully paid, return collateral to depositors,
 	// and remove the cdp and indexes from the store
-	if cdp.Principal.IsZero() && cdp.AccumulatedFees.IsZero() {
+	if cdp.NormalizedPrincipal.IsZero() {
 		k.ReturnCollateral(ctx, cdp)
 		k.DeleteCDP(ctx, cdp)
 		k.RemoveCdpOwnerIndex(ctx, cdp)
@@ -165,19 +155,20 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom stri
 	}
 
 	// set cdp state and update indexes
-	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
+	k.IncrementNormalizedPrincipal(ctx, cdp)
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 	k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
 	return nil
 }
 
-// ValidatePaymentCoins validates that the input coins are valid for repaying debt
+// ValidatePaymentCoins validates that the input coins are valid for repaying the input debt of a cdp
 func (k Keeper) ValidatePaymentCoins(ctx sdk.Context, cdp types.CDP, payment sdk.Coins, debt sdk.Coins) sdk.Error {
-	subset := payment.DenomsSubsetOf(cdp.Principal)
+	subset := payment.DenomsSubsetOf(debt)
 	if !subset {
 		var paymentDenoms []string
 		var principalDenoms []string
-		for _, pc := range cdp.Principal {
-			principalDenoms = append(principalDenoms, pc.Denom)
+		for _, dc := range debt {
+			principalDenoms = append(principalDenoms, dc.Denom)
 		}
 		for _, pc := range payment {
 			paymentDenoms = append(paymentDenoms, pc.Denom)
@@ -186,7 +177,7 @@ func (k Keeper) ValidatePaymentCoins(ctx sdk.Context, cdp types.CDP, payment sdk
 	}
 	for _, dc := range payment {
 		dp, _ := k.GetDebtParam(ctx, dc.Denom)
-		proposedBalance := cdp.Principal.AmountOf(dc.Denom).Sub(dc.Amount)
+		proposedBalance := debt.AmountOf(dc.Denom).Sub(dc.Amount)
 		if proposedBalance.GT(sdk.ZeroInt()) && proposedBalance.LT(dp.DebtFloor) {
 			return types.ErrBelowDebtFloor(k.codespace, sdk.NewCoins(sdk.NewCoin(dc.Denom, proposedBalance)), dp.DebtFloor)
 		}
@@ -206,31 +197,12 @@ func (k Keeper) ReturnCollateral(ctx sdk.Context, cdp types.CDP) {
 	}
 }
 
-func (k Keeper) calculatePayment(ctx sdk.Context, owed sdk.Coins, fees sdk.Coins, payment sdk.Coins) (sdk.Coins, sdk.Coins) {
-	// divides repayment into principal and fee components, with fee payment applied first.
-
-	feePayment := sdk.NewCoins()
-	principalPayment := sdk.NewCoins()
-	overpayment := sdk.NewCoins()
-	// TODO must compare denoms directly if there are multiple principal denoms
-	if payment.IsAllGT(owed) {
-		overpayment = payment.Sub(owed)
-		payment = payment.Sub(overpayment)
-	}
-	if fees.IsZero() {
-		return sdk.NewCoins(), payment
-	}
-	for _, fc := range fees {
-		if payment.AmountOf(fc.Denom).IsPositive() {
-			if payment.AmountOf(fc.Denom).GT(fc.Amount) {
-				feePayment = feePayment.Add(sdk.NewCoins(fc))
-				pc := sdk.NewCoin(fc.Denom, payment.AmountOf(fc.Denom).Sub(fc.Amount))
-				principalPayment = principalPayment.Add(sdk.NewCoins(pc))
-			} else {
-				fc := sdk.NewCoin(fc.Denom, payment.AmountOf(fc.Denom))
-				feePayment = feePayment.Add(sdk.NewCoins(fc))
-			}
-		}
+// calculatePayment returns the input payment with the payment of each denom capped at the amount owed of that denom
+func (k Keeper) calculatePayment(ctx sdk.Context, owed sdk.Coins, payment sdk.Coins) sdk.Coins {
+	capped := sdk.NewCoins()
+	for _, pc := range payment {
+		amount := sdk.MinInt(pc.Amount, owed.AmountOf(pc.Denom))
+		capped = capped.Add(sdk.NewCoins(sdk.NewCoin(pc.Denom, amount)))
 	}
-	return feePayment, principalPayment
+	return capped
 }
@@ -34,8 +34,8 @@ func (suite *DrawTestSuite) SetupTest() {
 			cs(c("xrp", 10000000000000), c("usdx", 100000000000))})
 	tApp.InitializeFromGenesisStates(
 		authGS,
-		NewPricefeedGenStateMulti(),
-		NewCDPGenStateMulti(),
+		NewPricefeedGenStateMultiDebt(),
+		NewCDPGenStateMultiDebt(),
 	)
 	keeper := tApp.GetCDPKeeper()
 	suite.app = tApp
@@ -52,8 +52,8 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	suite.NoError(err)
 
 	t, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
-	suite.Equal(cs(c("usdx", 20000000)), t.Principal)
-	ctd := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, t.Collateral, t.Principal.Add(t.AccumulatedFees))
+	suite.Equal(cs(c("usdx", 20000000)), suite.keeper.CalculateDebt(suite.ctx, t))
+	ctd := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, t.Type, t.Collateral, t.NormalizedPrincipal)
 	suite.Equal(d("20.0"), ctd)
 	ts := suite.keeper.GetAllCdpsByDenomAndRatio(suite.ctx, "xrp", d("20.0"))
 	suite.Equal(0, len(ts))
@@ -63,13 +63,13 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	suite.Equal(i(20000000), tp)
 	sk := suite.app.GetSupplyKeeper()
 	acc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("xrp", 400000000), c("debt", 20000000)), acc.GetCoins())
+	suite.Equal(cs(c("xrp", 400000000), c("debtusdx", 20000000)), acc.GetCoins())
 
 	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("susd", 10000000)))
 	suite.NoError(err)
 	t, _ = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
-	suite.Equal(cs(c("usdx", 20000000), c("susd", 10000000)), t.Principal)
-	ctd = suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, t.Collateral, t.Principal.Add(t.AccumulatedFees))
+	suite.Equal(cs(c("usdx", 20000000), c("susd", 10000000)), suite.keeper.CalculateDebt(suite.ctx, t))
+	ctd = suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, t.Type, t.Collateral, t.NormalizedPrincipal)
 	suite.Equal(d("400000000").Quo(d("30000000")), ctd)
 	ts = suite.keeper.GetAllCdpsByDenomAndRatio(suite.ctx, "xrp", d("400").Quo(d("30")))
 	suite.Equal(0, len(ts))
@@ -79,7 +79,7 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	suite.Equal(i(10000000), tp)
 	sk = suite.app.GetSupplyKeeper()
 	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("xrp", 400000000), c("debt", 30000000)), acc.GetCoins())
+	suite.Equal(cs(c("xrp", 400000000), c("debtusdx", 20000000), c("debtsusd", 10000000)), acc.GetCoins())
 
 	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[1], "xrp", cs(c("usdx", 10000000)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
@@ -92,8 +92,8 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	suite.NoError(err)
 
 	t, _ = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
-	suite.Equal(cs(c("usdx", 10000000), c("susd", 10000000)), t.Principal)
-	ctd = suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, t.Collateral, t.Principal.Add(t.AccumulatedFees))
+	suite.Equal(cs(c("usdx", 10000000), c("susd", 10000000)), suite.keeper.CalculateDebt(suite.ctx, t))
+	ctd = suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, t.Type, t.Collateral, t.NormalizedPrincipal)
 	suite.Equal(d("20.0"), ctd)
 	ts = suite.keeper.GetAllCdpsByDenomAndRatio(suite.ctx, "xrp", d("20.0"))
 	suite.Equal(0, len(ts))
@@ -103,14 +103,14 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	suite.Equal(i(10000000), tp)
 	sk = suite.app.GetSupplyKeeper()
 	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("xrp", 400000000), c("debt", 20000000)), acc.GetCoins())
+	suite.Equal(cs(c("xrp", 400000000), c("debtusdx", 10000000), c("debtsusd", 10000000)), acc.GetCoins())
 
 	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("susd", 10000000)))
 	suite.NoError(err)
 
 	t, _ = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
-	suite.Equal(cs(c("usdx", 10000000)), t.Principal)
-	ctd = suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, t.Collateral, t.Principal.Add(t.AccumulatedFees))
+	suite.Equal(cs(c("usdx", 10000000)), suite.keeper.CalculateDebt(suite.ctx, t))
+	ctd = suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, t.Type, t.Collateral, t.NormalizedPrincipal)
 	suite.Equal(d("40.0"), ctd)
 	ts = suite.keeper.GetAllCdpsByDenomAndRatio(suite.ctx, "xrp", d("40.0"))
 	suite.Equal(0, len(ts))
@@ -120,7 +120,7 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	suite.Equal(i(0), tp)
 	sk = suite.app.GetSupplyKeeper()
 	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("xrp", 400000000), c("debt", 10000000)), acc.GetCoins())
+	suite.Equal(cs(c("xrp", 400000000), c("debtusdx", 10000000)), acc.GetCoins())
 
 	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("xusd", 10000000)))
 	suite.Equal(types.CodeInvalidPaymentDenom, err.Result().Code)
@@ -144,6 +144,32 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 
 }
 
+func (suite *DrawTestSuite) TestAddRepayPrincipalMultiDebt() {
+	// the cdp holds $100 of xrp and can back $50 of debt, 10 usdx is already drawn
+	err := suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("eur", 5000000)))
+	suite.Equal(types.CodeBelowDebtFloor, err.Result().Code)
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("eur", 20000000)))
+	suite.NoError(err)
+	t, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.Equal(cs(c("usdx", 10000000), c("eur", 20000000)), suite.keeper.CalculateDebt(suite.ctx, t))
+	suite.Equal(i(20000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "eur"))
+
+	// 33 eur are worth $41.25, which would put the cdp at $51.25 of debt
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("eur", 13000000)))
+	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
+
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("eur", 20000000)))
+	suite.NoError(err)
+	t, _ = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.Equal(cs(c("usdx", 10000000)), suite.keeper.CalculateDebt(suite.ctx, t))
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "eur"))
+
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("usdx", 10000000)))
+	suite.NoError(err)
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.False(found)
+}
+
 func (suite *DrawTestSuite) TestRepayPrincipalOverpay() {
 	err := suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("usdx", 20000000)))
 	suite.NoError(err)
@@ -158,16 +184,16 @@ func (suite *DrawTestSuite) TestAddRepayPrincipalFees() {
 	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[2], cs(c("xrp", 1000000000000)), cs(c("usdx", 100000000000)))
 	suite.NoError(err)
 	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute * 10))
+	suite.keeper.HandleNewDebt(suite.ctx, "xrp", "usdx", i(600))
 	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[2], "xrp", cs(c("usdx", 10000000)))
 	suite.NoError(err)
 	t, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
-	suite.Equal(cs(c("usdx", 92827)), t.AccumulatedFees)
-	_ = suite.keeper.MintDebtCoins(suite.ctx, types.ModuleName, "debt", cs(c("usdx", 92827)))
+	suite.Equal(cs(c("usdx", 100010092828)), suite.keeper.CalculateDebt(suite.ctx, t))
 	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[2], "xrp", cs(c("usdx", 100)))
 	suite.NoError(err)
 	t, _ = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
-	suite.Equal(cs(c("usdx", 92727)), t.AccumulatedFees)
-	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[2], "xrp", cs(c("usdx", 100010092727)))
+	suite.Equal(cs(c("usdx", 100010092728)), suite.keeper.CalculateDebt(suite.ctx, t))
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[2], "xrp", cs(c("usdx", 100010092728)))
 	suite.NoError(err)
 	_, f := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
 	suite.False(f)
@@ -176,10 +202,11 @@ func (suite *DrawTestSuite) TestAddRepayPrincipalFees() {
 	suite.NoError(err)
 
 	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 31536000))
+	suite.keeper.HandleNewDebt(suite.ctx, "xrp", "usdx", i(31536000))
 	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[2], "xrp", cs(c("usdx", 100000000)))
 	suite.NoError(err)
 	t, _ = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(3))
-	suite.Equal(cs(c("usdx", 5000000)), t.AccumulatedFees)
+	suite.Equal(cs(c("usdx", 205000000)), suite.keeper.CalculateDebt(suite.ctx, t))
 }
 
 func (suite *DrawTestSuite) TestPricefeedFailure() {
@@ -192,6 +219,47 @@ func (suite *DrawTestSuite) TestPricefeedFailure() {
 	suite.NoError(err)
 }
 
+func (suite *DrawTestSuite) TestStalePrice() {
+	pfk := suite.app.GetPriceFeedKeeper()
+	params := pfk.GetParams(suite.ctx)
+	for i := range params.Markets {
+		params.Markets[i].Oracles = suite.addrs[:1]
+		params.Markets[i].MinOracles = 1
+	}
+	pfk.SetParams(suite.ctx, params)
+
+	// all prices have expired, so the last xrp price is kept as stale
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 2))
+	err := pfk.SetCurrentPrices(ctx, "xrp:usd")
+	suite.NoError(err)
+	price, err := pfk.GetCurrentPrice(ctx, "xrp:usd")
+	suite.NoError(err)
+	suite.True(price.IsStale())
+	suite.Equal(ctx.BlockTime(), price.StaleSince)
+
+	err = suite.keeper.AddPrincipal(ctx, suite.addrs[0], "xrp", cs(c("usdx", 10000000)))
+	suite.Equal(types.CodePriceStale, err.Result().Code)
+	err = suite.keeper.AddCdp(ctx, suite.addrs[1], cs(c("xrp", 100000000)), cs(c("usdx", 10000000)))
+	suite.Equal(types.CodePriceStale, err.Result().Code)
+	err = suite.keeper.DepositCollateral(ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 10000000)))
+	suite.NoError(err)
+	err = suite.keeper.WithdrawCollateral(ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 10000000)))
+	suite.Equal(types.CodePriceStale, err.Result().Code)
+
+	// a new price from the oracle meets the quorum again
+	_, err = pfk.SetPrice(ctx, suite.addrs[0], "xrp:usd", d("0.75"), ctx.BlockTime().Add(time.Hour))
+	suite.NoError(err)
+	err = pfk.SetCurrentPrices(ctx, "xrp:usd")
+	suite.NoError(err)
+	price, err = pfk.GetCurrentPrice(ctx, "xrp:usd")
+	suite.NoError(err)
+	suite.False(price.IsStale())
+	err = suite.keeper.WithdrawCollateral(ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 10000000)))
+	suite.NoError(err)
+	err = suite.keeper.AddPrincipal(ctx, suite.addrs[0], "xrp", cs(c("usdx", 10000000)))
+	suite.NoError(err)
+}
+
 func (suite *DrawTestSuite) TestModuleAccountFailure() {
 	suite.Panics(func() {
 		ctx := suite.ctx.WithBlockHeader(suite.ctx.BlockHeader())
@@ -8,61 +8,172 @@ import (
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
-// CalculateFees returns the fees accumulated since fees were last calculated based on
-// the input amount of outstanding debt (principal) and the number of periods (seconds) that have passed
+// CalculateFees returns the fees accumulated by the input amount of outstanding debt (principal)
+// over the input number of periods (seconds) at the current fee rate
 func (k Keeper) CalculateFees(ctx sdk.Context, principal sdk.Coins, periods sdk.Int, denom string) sdk.Coins {
 	newFees := sdk.NewCoins()
 	for _, pc := range principal {
-		// how fees are calculated:
-		// feesAccumulated = (outstandingDebt * (feeRate^periods)) - outstandingDebt
-		// Note that since we can't do x^y using sdk.Decimal, we are converting to int and using RelativePow
-		feePerSecond := k.getFeeRate(ctx, denom)
-		scalar := sdk.NewInt(1000000000000000000)
-		feeRateInt := feePerSecond.Mul(sdk.NewDecFromInt(scalar)).TruncateInt()
-		accumulator := sdk.NewDecFromInt(types.RelativePow(feeRateInt, periods, scalar)).Mul(sdk.SmallestDec())
+		accumulator := k.calculateInterestGrowth(ctx, denom, pc.Denom, periods)
 		feesAccumulated := (sdk.NewDecFromInt(pc.Amount).Mul(accumulator)).Sub(sdk.NewDecFromInt(pc.Amount))
-		// TODO this will always round down, causing precision loss between the sum of all fees in CDPs and surplus coins in liquidator account
 		newFees = newFees.Add(sdk.NewCoins(sdk.NewCoin(pc.Denom, feesAccumulated.TruncateInt())))
 	}
 	return newFees
 }
 
-// IncrementTotalPrincipal increments the total amount of debt that has been drawn with that collateral type
-func (k Keeper) IncrementTotalPrincipal(ctx sdk.Context, collateralDenom string, principal sdk.Coins) {
-	for _, pc := range principal {
-		total := k.GetTotalPrincipal(ctx, collateralDenom, pc.Denom)
-		total = total.Add(pc.Amount)
-		k.SetTotalPrincipal(ctx, collateralDenom, pc.Denom, total)
+// calculateInterestGrowth returns the factor that debt of the input principal denom drawn against the input collateral denom
+// grows by over the input number of periods (seconds) at the current fee rate
+func (k Keeper) calculateInterestGrowth(ctx sdk.Context, collateralDenom string, principalDenom string, periods sdk.Int) sdk.Dec {
+	// how fees are calculated:
+	// growth = feeRate^periods
+	// Note that since we can't do x^y using sdk.Decimal, we are converting to int and using RelativePow
+	feePerSecond := k.GetFeeRate(ctx, collateralDenom, principalDenom)
+	scalar := sdk.NewInt(1000000000000000000)
+	feeRateInt := feePerSecond.Mul(sdk.NewDecFromInt(scalar)).TruncateInt()
+	return sdk.NewDecFromInt(types.RelativePow(feeRateInt, periods, scalar)).Mul(sdk.SmallestDec())
+}
+
+// CalculateDebt returns the current debt, principal plus fees, of each debt denom of the input cdp,
+// which is its normalized principal multiplied by the current interest factor, rounded to the nearest integer
+func (k Keeper) CalculateDebt(ctx sdk.Context, cdp types.CDP) sdk.Coins {
+	debt := sdk.NewCoins()
+	for _, np := range cdp.NormalizedPrincipal {
+		amount := np.Amount.Mul(k.GetInterestFactor(ctx, cdp.Type, np.Denom)).RoundInt()
+		debt = debt.Add(sdk.NewCoins(sdk.NewCoin(np.Denom, amount)))
 	}
+	return debt
 }
 
-// DecrementTotalPrincipal decrements the total amount of debt that has been drawn for a particular collateral type
-func (k Keeper) DecrementTotalPrincipal(ctx sdk.Context, collateralDenom string, principal sdk.Coins) {
-	for _, pc := range principal {
-		total := k.GetTotalPrincipal(ctx, collateralDenom, pc.Denom)
-		total = total.Sub(pc.Amount)
+// addDebt returns the input cdp with the input debt, drawn at the current interest factors, added to its normalized principal.
+// The cdp is not stored and the total normalized principal of its collateral type is not updated.
+func (k Keeper) addDebt(ctx sdk.Context, cdp types.CDP, debt sdk.Coins) types.CDP {
+	cdp.NormalizedPrincipal = cdp.NormalizedPrincipal.Add(k.normalize(ctx, cdp.Type, debt))
+	return cdp
+}
+
+// removeDebt returns the input cdp with the input debt, valued at the current interest factors, removed from its normalized principal.
+// A debt denom is removed entirely once the input debt covers the cdp's current debt of that denom, so repaid cdps hold no dust.
+// The cdp is not stored and the total normalized principal of its collateral type is not updated.
+func (k Keeper) removeDebt(ctx sdk.Context, cdp types.CDP, debt sdk.Coins) types.CDP {
+	current := k.CalculateDebt(ctx, cdp)
+	normalizedDebt := k.normalize(ctx, cdp.Type, debt)
+	remaining := sdk.DecCoins{}
+	for _, np := range cdp.NormalizedPrincipal {
+		if debt.AmountOf(np.Denom).GTE(current.AmountOf(np.Denom)) {
+			continue
+		}
+		remaining = remaining.Add(sdk.DecCoins{sdk.NewDecCoinFromDec(np.Denom, np.Amount.Sub(normalizedDebt.AmountOf(np.Denom)))})
+	}
+	cdp.NormalizedPrincipal = remaining
+	return cdp
+}
+
+// normalize returns the input debt drawn against the input collateral type divided by the current interest factor of each debt denom
+func (k Keeper) normalize(ctx sdk.Context, collateralDenom string, debt sdk.Coins) sdk.DecCoins {
+	normalized := sdk.DecCoins{}
+	for _, dc := range debt {
+		factor := k.GetInterestFactor(ctx, collateralDenom, dc.Denom)
+		normalized = normalized.Add(sdk.DecCoins{sdk.NewDecCoinFromDec(dc.Denom, sdk.NewDecFromInt(dc.Amount).Quo(factor))})
+	}
+	return normalized
+}
+
+// getMaxInterestFactor returns the highest interest factor of any debt denom drawn against the input collateral denom
+func (k Keeper) getMaxInterestFactor(ctx sdk.Context, collateralDenom string) sdk.Dec {
+	maxFactor := sdk.OneDec()
+	for _, dp := range k.GetParams(ctx).DebtParams {
+		maxFactor = sdk.MaxDec(maxFactor, k.GetInterestFactor(ctx, collateralDenom, dp.Denom))
+	}
+	return maxFactor
+}
+
+// GetInterestFactor returns the cumulative interest factor for debt of the input principal denom drawn against the input collateral denom
+func (k Keeper) GetInterestFactor(ctx sdk.Context, collateralDenom string, principalDenom string) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorKeyPrefix)
+	bz := store.Get([]byte(collateralDenom + principalDenom))
+	if bz == nil {
+		return sdk.OneDec()
+	}
+	var factor types.InterestFactor
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &factor)
+	return factor.Factor
+}
+
+// SetInterestFactor sets the cumulative interest factor for debt of the input principal denom drawn against the input collateral denom
+func (k Keeper) SetInterestFactor(ctx sdk.Context, collateralDenom string, principalDenom string, factor sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(types.NewInterestFactor(collateralDenom, principalDenom, factor))
+	store.Set([]byte(collateralDenom+principalDenom), bz)
+}
+
+// IterateInterestFactors iterates over all interest factors in the store and performs a callback function
+func (k Keeper) IterateInterestFactors(ctx sdk.Context, cb func(factor types.InterestFactor) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var factor types.InterestFactor
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &factor)
+		if cb(factor) {
+			break
+		}
+	}
+}
+
+// GetAllInterestFactors returns all interest factors in the store
+func (k Keeper) GetAllInterestFactors(ctx sdk.Context) (factors types.InterestFactors) {
+	k.IterateInterestFactors(ctx, func(factor types.InterestFactor) bool {
+		factors = append(factors, factor)
+		return false
+	})
+	return
+}
+
+// IncrementNormalizedPrincipal adds the normalized principal of the input cdp to the total normalized principal of its collateral type
+func (k Keeper) IncrementNormalizedPrincipal(ctx sdk.Context, cdp types.CDP) {
+	for _, np := range cdp.NormalizedPrincipal {
+		total := k.getNormalizedPrincipal(ctx, cdp.Type, np.Denom)
+		k.setNormalizedPrincipal(ctx, cdp.Type, np.Denom, total.Add(np.Amount))
+	}
+}
+
+// DecrementNormalizedPrincipal removes the normalized principal of the input cdp from the total normalized principal of its collateral type
+func (k Keeper) DecrementNormalizedPrincipal(ctx sdk.Context, cdp types.CDP) {
+	for _, np := range cdp.NormalizedPrincipal {
+		total := k.getNormalizedPrincipal(ctx, cdp.Type, np.Denom)
+		total = total.Sub(np.Amount)
 		if total.IsNegative() {
-			// can happen in tests due to rounding errors in fee calculation
-			total = sdk.ZeroInt()
+			// can happen in tests that set the total principal directly
+			total = sdk.ZeroDec()
 		}
-		k.SetTotalPrincipal(ctx, collateralDenom, pc.Denom, total)
+		k.setNormalizedPrincipal(ctx, cdp.Type, np.Denom, total)
 	}
 }
 
-// GetTotalPrincipal returns the total amount of principal that has been drawn for a particular collateral
+// GetTotalPrincipal returns the total debt (principal plus fees) that has been drawn for a particular collateral and principal denom,
+// which is the total normalized principal multiplied by the current interest factor, rounded to the nearest integer
 func (k Keeper) GetTotalPrincipal(ctx sdk.Context, collateralDenom string, principalDenom string) (total sdk.Int) {
+	normalized := k.getNormalizedPrincipal(ctx, collateralDenom, principalDenom)
+	return normalized.Mul(k.GetInterestFactor(ctx, collateralDenom, principalDenom)).RoundInt()
+}
+
+// SetTotalPrincipal sets the total debt that has been drawn for the input collateral and principal denom
+// by setting the total normalized principal to the input total divided by the current interest factor
+func (k Keeper) SetTotalPrincipal(ctx sdk.Context, collateralDenom string, principalDenom string, total sdk.Int) {
+	normalized := sdk.NewDecFromInt(total).Quo(k.GetInterestFactor(ctx, collateralDenom, principalDenom))
+	k.setNormalizedPrincipal(ctx, collateralDenom, principalDenom, normalized)
+}
+
+func (k Keeper) getNormalizedPrincipal(ctx sdk.Context, collateralDenom string, principalDenom string) (total sdk.Dec) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PrincipalKeyPrefix)
 	bz := store.Get([]byte(collateralDenom + principalDenom))
 	if bz == nil {
-		k.SetTotalPrincipal(ctx, collateralDenom, principalDenom, sdk.ZeroInt())
-		return sdk.ZeroInt()
+		return sdk.ZeroDec()
 	}
 	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &total)
 	return total
 }
 
-// SetTotalPrincipal sets the total amount of principal that has been drawn for the input collateral
-func (k Keeper) SetTotalPrincipal(ctx sdk.Context, collateralDenom string, principalDenom string, total sdk.Int) {
+func (k Keeper) setNormalizedPrincipal(ctx sdk.Context, collateralDenom string, principalDenom string, total sdk.Dec) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PrincipalKeyPrefix)
 	store.Set([]byte(collateralDenom+principalDenom), k.cdc.MustMarshalBinaryLengthPrefixed(total))
 }
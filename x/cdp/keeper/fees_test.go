@@ -3,12 +3,14 @@ package keeper_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -76,6 +78,66 @@ func (suite *FeeTestSuite) TestCalculateFeesPrecisionLoss() {
 
 }
 
+func (suite *FeeTestSuite) TestGetFeeRate() {
+	params := suite.keeper.GetParams(suite.ctx)
+	stabilityFee := params.CollateralParams[0].StabilityFee
+	suite.Equal(stabilityFee, suite.keeper.GetFeeRate(suite.ctx, "xrp", "usdx"))
+
+	// xrp has a debt limit of 500000000000usdx, so drawing 250000000000usdx is half utilization
+	params.CollateralParams[0].InterestRateModel = types.NewInterestRateModel(types.RateModelUtilization, d("1.000000003547125958"), sdk.Dec{}, sdk.Dec{})
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", i(250000000000))
+	suite.Equal(d("1.000000002547125958"), suite.keeper.GetFeeRate(suite.ctx, "xrp", "usdx"))
+	// utilization is tracked separately for each debt denom
+	suite.Equal(stabilityFee, suite.keeper.GetFeeRate(suite.ctx, "xrp", "susd"))
+
+	// fees rise with utilization
+	lowFees := suite.keeper.CalculateFees(suite.ctx, cs(c("usdx", 100000000000)), i(3600), "xrp")
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", i(500000000000))
+	suite.Equal(d("1.000000003547125958"), suite.keeper.GetFeeRate(suite.ctx, "xrp", "usdx"))
+	highFees := suite.keeper.CalculateFees(suite.ctx, cs(c("usdx", 100000000000)), i(3600), "xrp")
+	suite.True(highFees.IsAllGT(lowFees))
+}
+
+func (suite *FeeTestSuite) TestCalculateDebt() {
+	suite.Equal(sdk.OneDec(), suite.keeper.GetInterestFactor(suite.ctx, "xrp", "usdx"))
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	amounts := []int64{100000000, 333333333, 777777777}
+	for j, addr := range addrs {
+		cdp := types.NewCDP(uint64(j+1), addr, cs(c("xrp", 1000000000000)), sdk.NewDecCoins(cs(c("usdx", amounts[j]))))
+		suite.keeper.SetCDP(suite.ctx, cdp)
+		suite.keeper.IncrementNormalizedPrincipal(suite.ctx, cdp)
+	}
+	suite.Equal(i(1211111110), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx"))
+
+	// compound fees block by block
+	for j := 0; j < 100; j++ {
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 7))
+		suite.keeper.HandleNewDebt(suite.ctx, "xrp", "usdx", i(7))
+
+		// the total is the sum of cdp debt, up to rounding of each cdp
+		sum := sdk.ZeroInt()
+		for _, cdp := range suite.keeper.GetAllCdpsByDenom(suite.ctx, "xrp") {
+			sum = sum.Add(suite.keeper.CalculateDebt(suite.ctx, cdp).AmountOf("usdx"))
+		}
+		total := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx")
+		suite.True(total.Sub(sum).LTE(i(2)) && sum.Sub(total).LTE(i(2)), "total %s, sum of cdp debt %s", total, sum)
+	}
+	suite.True(suite.keeper.GetInterestFactor(suite.ctx, "xrp", "usdx").GT(sdk.OneDec()))
+
+	// cdp debt grows with the interest factor while the stored normalized principal is unchanged
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.Equal(sdk.NewDecCoins(cs(c("usdx", amounts[0]))), cdp.NormalizedPrincipal)
+	suite.True(suite.keeper.CalculateDebt(suite.ctx, cdp).IsAllGT(cs(c("usdx", amounts[0]))))
+
+	// removing every cdp leaves no principal outstanding
+	for _, cdp := range suite.keeper.GetAllCdpsByDenom(suite.ctx, "xrp") {
+		suite.keeper.DecrementNormalizedPrincipal(suite.ctx, cdp)
+		suite.keeper.DeleteCDP(suite.ctx, cdp)
+	}
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx"))
+}
+
 func (suite *FeeTestSuite) TestGetSetPreviousBlockTime() {
 	now := tmtime.Now()
 
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+	pftypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// Hooks wrapper struct for the cdp keeper's pricefeed hooks
+type Hooks struct {
+	k Keeper
+}
+
+var _ pftypes.PricefeedHooks = Hooks{}
+
+// Hooks returns the pricefeed hooks implemented by the cdp keeper
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// BeforeMarketRemoved rejects removing a market that a collateral or debt param is priced with
+func (h Hooks) BeforeMarketRemoved(ctx sdk.Context, marketID string) sdk.Error {
+	params := h.k.GetParams(ctx)
+	for _, cp := range params.CollateralParams {
+		if cp.MarketID == marketID {
+			return types.ErrMarketInUse(h.k.codespace, marketID, cp.Denom)
+		}
+	}
+	for _, dp := range params.DebtParams {
+		if dp.MarketID == marketID {
+			return types.ErrMarketInUse(h.k.codespace, marketID, dp.Denom)
+		}
+	}
+	return nil
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp"
 	"github.com/kava-labs/kava/x/pricefeed"
-	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 // Avoid cluttering test cases with long function names
@@ -20,6 +19,7 @@ func cs(coins ...sdk.Coin) sdk.Coins        { return sdk.NewCoins(coins...) }
 func NewPricefeedGenState(asset string, price sdk.Dec) app.GenesisState {
 	pfGenesis := pricefeed.GenesisState{
 		Params: pricefeed.Params{
+			MaxTWAPWindow: pricefeed.DefaultMaxTWAPWindow,
 			Markets: []pricefeed.Market{
 				pricefeed.Market{MarketID: asset + ":usd", BaseAsset: asset, QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
 			},
@@ -39,9 +39,10 @@ func NewPricefeedGenState(asset string, price sdk.Dec) app.GenesisState {
 func NewCDPGenState(asset string, liquidationRatio sdk.Dec) app.GenesisState {
 	cdpGenesis := cdp.GenesisState{
 		Params: cdp.Params{
-			GlobalDebtLimit:         sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000)),
-			SurplusAuctionThreshold: cdp.DefaultSurplusThreshold,
-			DebtAuctionThreshold:    cdp.DefaultDebtThreshold,
+			GlobalDebtLimit:          sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000)),
+			SurplusAuctionThreshold:  cdp.DefaultSurplusThreshold,
+			DebtAuctionThreshold:     cdp.DefaultDebtThreshold,
+			LiquidationBlockInterval: cdp.DefaultLiquidationInterval,
 			CollateralParams: cdp.CollateralParams{
 				{
 					Denom:              asset,
@@ -76,6 +77,7 @@ func NewCDPGenState(asset string, liquidationRatio sdk.Dec) app.GenesisState {
 func NewPricefeedGenStateMulti() app.GenesisState {
 	pfGenesis := pricefeed.GenesisState{
 		Params: pricefeed.Params{
+			MaxTWAPWindow: pricefeed.DefaultMaxTWAPWindow,
 			Markets: []pricefeed.Market{
 				pricefeed.Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
 				pricefeed.Market{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
@@ -101,9 +103,10 @@ func NewPricefeedGenStateMulti() app.GenesisState {
 func NewCDPGenStateMulti() app.GenesisState {
 	cdpGenesis := cdp.GenesisState{
 		Params: cdp.Params{
-			GlobalDebtLimit:         sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000), sdk.NewInt64Coin("susd", 1000000000000)),
-			SurplusAuctionThreshold: cdp.DefaultSurplusThreshold,
-			DebtAuctionThreshold:    cdp.DefaultDebtThreshold,
+			GlobalDebtLimit:          sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000), sdk.NewInt64Coin("susd", 1000000000000)),
+			SurplusAuctionThreshold:  cdp.DefaultSurplusThreshold,
+			DebtAuctionThreshold:     cdp.DefaultDebtThreshold,
+			LiquidationBlockInterval: cdp.DefaultLiquidationInterval,
 			CollateralParams: cdp.CollateralParams{
 				{
 					Denom:              "xrp",
@@ -152,12 +155,45 @@ func NewCDPGenStateMulti() app.GenesisState {
 	return app.GenesisState{cdp.ModuleName: cdp.ModuleCdc.MustMarshalJSON(cdpGenesis)}
 }
 
+// NewPricefeedGenStateMultiDebt returns the multi collateral pricefeed genesis state with a eur:usd market for pricing a euro debt asset
+func NewPricefeedGenStateMultiDebt() app.GenesisState {
+	var pfGenesis pricefeed.GenesisState
+	pricefeed.ModuleCdc.MustUnmarshalJSON(NewPricefeedGenStateMulti()[pricefeed.ModuleName], &pfGenesis)
+	pfGenesis.Params.Markets = append(pfGenesis.Params.Markets,
+		pricefeed.Market{MarketID: "eur:usd", BaseAsset: "eur", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true})
+	pfGenesis.PostedPrices = append(pfGenesis.PostedPrices, pricefeed.PostedPrice{
+		MarketID:      "eur:usd",
+		OracleAddress: sdk.AccAddress{},
+		Price:         sdk.MustNewDecFromStr("1.25"),
+		Expiry:        time.Now().Add(1 * time.Hour),
+	})
+	return app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pfGenesis)}
+}
+
+// NewCDPGenStateMultiDebt returns the multi collateral cdp genesis state with a euro debt asset, priced by the eur:usd market
+func NewCDPGenStateMultiDebt() app.GenesisState {
+	var cdpGenesis cdp.GenesisState
+	cdp.ModuleCdc.MustUnmarshalJSON(NewCDPGenStateMulti()[cdp.ModuleName], &cdpGenesis)
+	cdpGenesis.Params.GlobalDebtLimit = cdpGenesis.Params.GlobalDebtLimit.Add(sdk.NewCoins(sdk.NewInt64Coin("eur", 1000000000000)))
+	for j := range cdpGenesis.Params.CollateralParams {
+		cdpGenesis.Params.CollateralParams[j].DebtLimit = cdpGenesis.Params.CollateralParams[j].DebtLimit.Add(sdk.NewCoins(sdk.NewInt64Coin("eur", 500000000000)))
+	}
+	cdpGenesis.Params.DebtParams = append(cdpGenesis.Params.DebtParams, cdp.DebtParam{
+		Denom:            "eur",
+		ReferenceAsset:   "eur",
+		ConversionFactor: i(6),
+		DebtFloor:        i(10000000),
+		MarketID:         "eur:usd",
+	})
+	return app.GenesisState{cdp.ModuleName: cdp.ModuleCdc.MustMarshalJSON(cdpGenesis)}
+}
+
 func cdps() (cdps cdp.CDPs) {
 	_, addrs := app.GeneratePrivKeyAddressPairs(3)
-	c1 := cdp.NewCDP(uint64(1), addrs[0], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(10000000))), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(8000000))), tmtime.Canonical(time.Now()))
-	c2 := cdp.NewCDP(uint64(2), addrs[1], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(100000000))), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10000000))), tmtime.Canonical(time.Now()))
-	c3 := cdp.NewCDP(uint64(3), addrs[1], sdk.NewCoins(sdk.NewCoin("btc", sdk.NewInt(1000000000))), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10000000))), tmtime.Canonical(time.Now()))
-	c4 := cdp.NewCDP(uint64(4), addrs[2], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(1000000000))), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(500000000))), tmtime.Canonical(time.Now()))
+	c1 := cdp.NewCDP(uint64(1), addrs[0], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(10000000))), sdk.NewDecCoins(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(8000000)))))
+	c2 := cdp.NewCDP(uint64(2), addrs[1], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(100000000))), sdk.NewDecCoins(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10000000)))))
+	c3 := cdp.NewCDP(uint64(3), addrs[1], sdk.NewCoins(sdk.NewCoin("btc", sdk.NewInt(1000000000))), sdk.NewDecCoins(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10000000)))))
+	c4 := cdp.NewCDP(uint64(4), addrs[2], sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(1000000000))), sdk.NewDecCoins(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(500000000)))))
 	cdps = append(cdps, c1, c2, c3, c4)
 	return
 }
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// RegisterInvariants registers all cdp invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-principal", TotalPrincipalInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "deposits", DepositsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "collateral-ratio-index", CollateralRatioIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "savings", SavingsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the cdp module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, broken := TotalPrincipalInvariant(k)(ctx)
+		if broken {
+			return res, broken
+		}
+		res, broken = DepositsInvariant(k)(ctx)
+		if broken {
+			return res, broken
+		}
+		res, broken = CollateralRatioIndexInvariant(k)(ctx)
+		if broken {
+			return res, broken
+		}
+		return SavingsInvariant(k)(ctx)
+	}
+}
+
+// TotalPrincipalInvariant checks that the total normalized principal stored for each collateral and debt denom
+// equals the sum of the normalized principal of all cdps of that collateral type. Both are multiplied by the same
+// interest factor, so the total principal and the sum of the debt of the cdps only differ by the rounding of each cdp's debt.
+func TotalPrincipalInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		params := k.GetParams(ctx)
+		for _, cp := range params.CollateralParams {
+			cdpPrincipal := sdk.DecCoins{}
+			k.IterateCdpsByDenom(ctx, cp.Denom, func(cdp types.CDP) bool {
+				cdpPrincipal = cdpPrincipal.Add(cdp.NormalizedPrincipal)
+				return false
+			})
+			for _, dp := range params.DebtParams {
+				total := k.getNormalizedPrincipal(ctx, cp.Denom, dp.Denom)
+				sum := cdpPrincipal.AmountOf(dp.Denom)
+				if !total.Equal(sum) {
+					broken = true
+					msg += fmt.Sprintf("\ttotal normalized principal for collateral %s: %s%s, sum of cdp normalized principal: %s%s\n",
+						cp.Denom, total, dp.Denom, sum, dp.Denom)
+				}
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "total principal", msg), broken
+	}
+}
+
+// DepositsInvariant checks that the collateral held by the cdp module account equals the sum of all deposits
+func DepositsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		deposited := sdk.NewCoins()
+		k.IterateAllDeposits(ctx, func(deposit types.Deposit) bool {
+			deposited = deposited.Add(deposit.Amount)
+			return false
+		})
+		moduleCoins := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+		for _, cp := range k.GetParams(ctx).CollateralParams {
+			if !moduleCoins.AmountOf(cp.Denom).Equal(deposited.AmountOf(cp.Denom)) {
+				broken = true
+				msg += fmt.Sprintf("\tcdp module account balance: %s%s, sum of deposits: %s%s\n",
+					moduleCoins.AmountOf(cp.Denom), cp.Denom, deposited.AmountOf(cp.Denom), cp.Denom)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "deposits", msg), broken
+	}
+}
+
+// CollateralRatioIndexInvariant checks that every entry in the collateral ratio index refers to an existing cdp
+// and that the indexed ratio matches the current collateral to debt ratio of that cdp
+func CollateralRatioIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		denoms := make(map[byte]string)
+		for _, cp := range k.GetParams(ctx).CollateralParams {
+			denoms[cp.Prefix] = cp.Denom
+		}
+		store := prefix.NewStore(ctx.KVStore(k.key), types.CollateralRatioIndexPrefix)
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			db, id, ratio := types.SplitCollateralRatioKey(iterator.Key())
+			denom, found := denoms[db]
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("\tcdp %d is indexed under unknown collateral prefix %b\n", id, db)
+				continue
+			}
+			cdp, found := k.GetCDP(ctx, denom, id)
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("\tcdp %d is indexed but does not exist\n", id)
+				continue
+			}
+			expected := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
+			if !bytes.Equal(types.CollateralRatioBytes(ratio), types.CollateralRatioBytes(expected)) {
+				broken = true
+				msg += fmt.Sprintf("\tcdp %d is indexed with ratio %s, expected %s\n", id, ratio, expected)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "collateral ratio index", msg), broken
+	}
+}
+
+// SavingsInvariant checks that the total savings shares equal the sum of the shares of all savings deposits,
+// and that the savings module account holds a balance of every debt asset shares have been issued for
+func SavingsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		deposited := sdk.NewCoins()
+		k.IterateSavingsDeposits(ctx, func(deposit types.SavingsDeposit) bool {
+			deposited = deposited.Add(deposit.Shares)
+			return false
+		})
+		totalShares := k.GetTotalSavingsShares(ctx)
+		if !totalShares.IsAllGTE(deposited) || !deposited.IsAllGTE(totalShares) {
+			broken = true
+			msg += fmt.Sprintf("\ttotal savings shares: %s, sum of savings deposit shares: %s\n", totalShares, deposited)
+		}
+		balance := k.getSavingsMaccBalance(ctx)
+		for _, shares := range totalShares {
+			if !balance.AmountOf(shares.Denom).IsPositive() {
+				broken = true
+				msg += fmt.Sprintf("\tsavings module account holds no %s for %s savings shares\n", shares.Denom, shares.Amount)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "savings", msg), broken
+	}
+}
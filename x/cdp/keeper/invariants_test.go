@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+type InvariantTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *InvariantTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	authGS := app.NewAuthGenState(
+		addrs,
+		[]sdk.Coins{
+			cs(c("xrp", 500000000), c("btc", 500000000), c("usdx", 100000000)),
+			cs(c("xrp", 200000000))})
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(),
+		NewCDPGenStateMulti(),
+	)
+	suite.app = tApp
+	suite.keeper = tApp.GetCDPKeeper()
+	suite.ctx = ctx
+	suite.addrs = addrs
+	suite.NoError(suite.keeper.AddCdp(suite.ctx, addrs[0], cs(c("xrp", 400000000)), cs(c("usdx", 10000000))))
+	suite.NoError(suite.keeper.AddCdp(suite.ctx, addrs[0], cs(c("btc", 100000000)), cs(c("usdx", 20000000))))
+	suite.NoError(suite.keeper.DepositCollateral(suite.ctx, addrs[0], addrs[1], "xrp", cs(c("xrp", 100000000))))
+}
+
+func (suite *InvariantTestSuite) TestInvariantsHold() {
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// accumulate fees over several blocks and modify cdps
+	for i := 0; i < 10; i++ {
+		previousBlockTime := suite.ctx.BlockTime()
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+		for _, cp := range suite.keeper.GetParams(suite.ctx).CollateralParams {
+			suite.keeper.HandleNewDebt(suite.ctx, cp.Denom, "usdx", sdk.NewInt(suite.ctx.BlockTime().Unix()-previousBlockTime.Unix()))
+		}
+		_, broken = keeper.AllInvariants(suite.keeper)(suite.ctx)
+		suite.False(broken)
+	}
+	suite.NoError(suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("usdx", 10000000))))
+	suite.NoError(suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("xrp", 50000000))))
+	suite.NoError(suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "btc", cs(c("usdx", 5000000))))
+	_, broken = keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp", 1)
+	suite.True(found)
+	suite.NoError(suite.keeper.SeizeCollateral(suite.ctx, cdp))
+	_, broken = keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+}
+
+func (suite *InvariantTestSuite) TestTotalPrincipalInvariant() {
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", i(20000000))
+	_, broken := keeper.TotalPrincipalInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+
+	// the total can't be less than the sum of cdp debt
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", i(9999999))
+	_, broken = keeper.TotalPrincipalInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", i(10000000))
+	_, broken = keeper.TotalPrincipalInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+}
+
+func (suite *InvariantTestSuite) TestDepositsInvariant() {
+	suite.keeper.DeleteDeposit(suite.ctx, 1, suite.addrs[1])
+	_, broken := keeper.DepositsInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *InvariantTestSuite) TestCollateralRatioIndexInvariant() {
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "btc", 2)
+	suite.True(found)
+	suite.keeper.IndexCdpByCollateralRatio(suite.ctx, "btc", cdp.ID, d("1.5"))
+	_, broken := keeper.CollateralRatioIndexInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+
+	suite.keeper.RemoveCdpCollateralRatioIndex(suite.ctx, "btc", cdp.ID, d("1.5"))
+	_, broken = keeper.CollateralRatioIndexInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	suite.keeper.DeleteCDP(suite.ctx, cdp)
+	_, broken = keeper.CollateralRatioIndexInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
+
+func TestInvariantTestSuite(t *testing.T) {
+	suite.Run(t, new(InvariantTestSuite))
+}
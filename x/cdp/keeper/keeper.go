@@ -34,6 +34,11 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
 		panic(fmt.Sprintf("%s module account has not been set", types.LiquidatorMacc))
 	}
 
+	// ensure savings module account is set
+	if addr := sk.GetModuleAddress(types.SavingsMacc); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.SavingsMacc))
+	}
+
 	return Keeper{
 		key:             key,
 		cdc:             cdc,
@@ -19,6 +19,13 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
+// GetCircuitBreaker returns true if the circuit breaker has been tripped by governance
+func (k Keeper) GetCircuitBreaker(ctx sdk.Context) bool {
+	var breaker bool
+	k.paramSubspace.Get(ctx, types.KeyCircuitBreaker, &breaker)
+	return breaker
+}
+
 // GetCollateral returns the collateral param with corresponding denom
 func (k Keeper) GetCollateral(ctx sdk.Context, denom string) (types.CollateralParam, bool) {
 	params := k.GetParams(ctx)
@@ -95,11 +102,26 @@ func (k Keeper) getAuctionSize(ctx sdk.Context, denom string) sdk.Int {
 	return cp.AuctionSize
 }
 
-// GetFeeRate returns the per second fee rate for the input denom
-func (k Keeper) getFeeRate(ctx sdk.Context, denom string) (fee sdk.Dec) {
-	collalateralParam, found := k.GetCollateral(ctx, denom)
+// GetFeeRate returns the per second fee rate for debt of the input principal denom drawn against the input collateral denom.
+// The collateral's interest rate model sets the rate from the utilization of its debt limit for the principal denom.
+func (k Keeper) GetFeeRate(ctx sdk.Context, collateralDenom string, principalDenom string) (fee sdk.Dec) {
+	collalateralParam, found := k.GetCollateral(ctx, collateralDenom)
 	if !found {
-		panic(fmt.Sprintf("could not get fee rate for %s, collateral not found", denom))
+		panic(fmt.Sprintf("could not get fee rate for %s, collateral not found", collateralDenom))
+	}
+	utilization := sdk.ZeroDec()
+	debtLimit := collalateralParam.DebtLimit.AmountOf(principalDenom)
+	if debtLimit.IsPositive() {
+		utilization = sdk.NewDecFromInt(k.GetTotalPrincipal(ctx, collateralDenom, principalDenom)).QuoInt(debtLimit)
+	}
+	return collalateralParam.InterestRateModel.FeeRate(collalateralParam.StabilityFee, utilization)
+}
+
+// GetSavingsRate returns the fraction of stability fees paid to savings depositors
+func (k Keeper) GetSavingsRate(ctx sdk.Context) sdk.Dec {
+	rate := k.GetParams(ctx).SavingsRate
+	if rate.IsNil() {
+		return sdk.ZeroDec()
 	}
-	return collalateralParam.StabilityFee
+	return rate
 }
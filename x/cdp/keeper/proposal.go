@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// HandleAddCollateralProposal adds the collateral type of the proposal to the cdp params
+func HandleAddCollateralProposal(ctx sdk.Context, k Keeper, p types.AddCollateralProposal) sdk.Error {
+	params := k.GetParams(ctx)
+	if _, found := findCollateral(params.CollateralParams, p.CollateralParam.Denom); found {
+		return types.ErrCollateralExists(k.codespace, p.CollateralParam.Denom)
+	}
+	if err := k.validateCollateralMarket(ctx, p.CollateralParam); err != nil {
+		return err
+	}
+	params.CollateralParams = append(params.CollateralParams, p.CollateralParam)
+	if err := k.setProposalParams(ctx, params); err != nil {
+		return err
+	}
+	for _, dp := range params.DebtParams {
+		k.SetTotalPrincipal(ctx, p.CollateralParam.Denom, dp.Denom, sdk.ZeroInt())
+	}
+	return nil
+}
+
+// HandleUpdateCollateralProposal replaces the params of an existing collateral type.
+// The prefix and conversion factor must be unchanged, as stored cdps are indexed and valued using them.
+func HandleUpdateCollateralProposal(ctx sdk.Context, k Keeper, p types.UpdateCollateralProposal) sdk.Error {
+	params := k.GetParams(ctx)
+	i, found := findCollateral(params.CollateralParams, p.CollateralParam.Denom)
+	if !found {
+		return types.ErrCollateralNotSupported(k.codespace, p.CollateralParam.Denom)
+	}
+	existing := params.CollateralParams[i]
+	if p.CollateralParam.Prefix != existing.Prefix {
+		return types.ErrInvalidProposal(k.codespace, "collateral prefix can't be changed")
+	}
+	if !p.CollateralParam.ConversionFactor.Equal(existing.ConversionFactor) {
+		return types.ErrInvalidProposal(k.codespace, "collateral conversion factor can't be changed")
+	}
+	if err := k.validateCollateralMarket(ctx, p.CollateralParam); err != nil {
+		return err
+	}
+	params.CollateralParams[i] = p.CollateralParam
+	return k.setProposalParams(ctx, params)
+}
+
+// validateCollateralMarket checks that the market of a collateral type exists in the pricefeed
+func (k Keeper) validateCollateralMarket(ctx sdk.Context, cp types.CollateralParam) sdk.Error {
+	for _, m := range k.pricefeedKeeper.GetParams(ctx).Markets {
+		if m.MarketID == cp.MarketID {
+			return nil
+		}
+	}
+	return types.ErrMarketNotFound(k.codespace, cp.Denom, cp.MarketID)
+}
+
+// setProposalParams sets the params changed by a proposal, if they are valid
+func (k Keeper) setProposalParams(ctx sdk.Context, params types.Params) sdk.Error {
+	if err := params.Validate(); err != nil {
+		return types.ErrInvalidProposal(k.codespace, err.Error())
+	}
+	k.SetParams(ctx, params)
+	return nil
+}
+
+func findCollateral(cps types.CollateralParams, denom string) (int, bool) {
+	for i, cp := range cps {
+		if cp.Denom == denom {
+			return i, true
+		}
+	}
+	return 0, false
+}
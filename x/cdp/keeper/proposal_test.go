@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+type ProposalTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *ProposalTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(),
+		NewCDPGenStateMulti(),
+	)
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	pk := tApp.GetPriceFeedKeeper()
+	pfParams := pk.GetParams(ctx)
+	pfParams.Markets = append(pfParams.Markets, pricefeed.Market{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{addrs[0]}, Active: true})
+	pk.SetParams(ctx, pfParams)
+
+	// leave room under the global debt limit for new collateral types
+	k := tApp.GetCDPKeeper()
+	params := k.GetParams(ctx)
+	params.GlobalDebtLimit = cs(c("usdx", 2000000000000), c("susd", 2000000000000))
+	k.SetParams(ctx, params)
+
+	suite.app = tApp
+	suite.keeper = k
+	suite.ctx = ctx
+	suite.addrs = addrs
+}
+
+func bnbCollateralParam() types.CollateralParam {
+	return types.CollateralParam{
+		Denom:                  "bnb",
+		LiquidationRatio:       d("1.5"),
+		DebtLimit:              cs(c("usdx", 100000000000)),
+		StabilityFee:           d("1.000000001547125958"),
+		LiquidationPenalty:     d("0.05"),
+		AuctionSize:            i(100000000),
+		Prefix:                 0x22,
+		MarketID:               "bnb:usd",
+		ConversionFactor:       i(8),
+		LiquidationBuffer:      sdk.ZeroDec(),
+		KeeperRewardPercentage: sdk.ZeroDec(),
+	}
+}
+
+func (suite *ProposalTestSuite) TestAddCollateralProposal() {
+	duplicateDenom := bnbCollateralParam()
+	duplicateDenom.Denom = "xrp"
+	duplicatePrefix := bnbCollateralParam()
+	duplicatePrefix.Prefix = 0x20
+	missingMarket := bnbCollateralParam()
+	missingMarket.MarketID = "bnb:eur"
+	overDebtLimit := bnbCollateralParam()
+	overDebtLimit.DebtLimit = cs(c("usdx", 1000000000001))
+	invalidDebtDenom := bnbCollateralParam()
+	invalidDebtDenom.DebtLimit = cs(c("xusd", 100000000))
+
+	tests := []struct {
+		name      string
+		param     types.CollateralParam
+		errorCode sdk.CodeType
+	}{
+		{"duplicate denom", duplicateDenom, types.CodeCollateralExists},
+		{"duplicate prefix", duplicatePrefix, types.CodeInvalidProposal},
+		{"missing market", missingMarket, types.CodeMarketNotFound},
+		{"total debt limits exceed global debt limit", overDebtLimit, types.CodeInvalidProposal},
+		{"invalid debt denom", invalidDebtDenom, types.CodeInvalidProposal},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			p := types.NewAddCollateralProposal("title", "description", tc.param)
+			err := keeper.HandleAddCollateralProposal(ctx, suite.keeper, p)
+			suite.Require().Error(err)
+			suite.Equal(tc.errorCode, err.Code())
+			suite.Equal(2, len(suite.keeper.GetParams(ctx).CollateralParams))
+		})
+	}
+
+	p := types.NewAddCollateralProposal("title", "description", bnbCollateralParam())
+	suite.NoError(keeper.HandleAddCollateralProposal(suite.ctx, suite.keeper, p))
+	cp, found := suite.keeper.GetCollateral(suite.ctx, "bnb")
+	suite.True(found)
+	suite.Equal(bnbCollateralParam().String(), cp.String())
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "bnb", "usdx"))
+
+	// cdps can be opened with the new collateral type once it has a price
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err := pk.SetPrice(suite.ctx, suite.addrs[0], "bnb:usd", d("20.0"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.NoError(err)
+	suite.NoError(pk.SetCurrentPrices(suite.ctx, "bnb:usd"))
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.NewAccountWithAddress(suite.ctx, suite.addrs[1])
+	suite.NoError(acc.SetCoins(cs(c("bnb", 1000000000))))
+	ak.SetAccount(suite.ctx, acc)
+	suite.NoError(suite.keeper.AddCdp(suite.ctx, suite.addrs[1], cs(c("bnb", 1000000000)), cs(c("usdx", 10000000))))
+}
+
+func (suite *ProposalTestSuite) TestUpdateCollateralProposal() {
+	xrp, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+
+	notFound := bnbCollateralParam()
+	changedPrefix := xrp
+	changedPrefix.Prefix = 0x22
+	changedConversionFactor := xrp
+	changedConversionFactor.ConversionFactor = i(8)
+	missingMarket := xrp
+	missingMarket.MarketID = "xrp:eur"
+	overDebtLimit := xrp
+	overDebtLimit.DebtLimit = cs(c("usdx", 1500000000001), c("susd", 500000000000))
+
+	tests := []struct {
+		name      string
+		param     types.CollateralParam
+		errorCode sdk.CodeType
+	}{
+		{"collateral not found", notFound, types.CodeCollateralNotSupported},
+		{"changed prefix", changedPrefix, types.CodeInvalidProposal},
+		{"changed conversion factor", changedConversionFactor, types.CodeInvalidProposal},
+		{"missing market", missingMarket, types.CodeMarketNotFound},
+		{"total debt limits exceed global debt limit", overDebtLimit, types.CodeInvalidProposal},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			p := types.NewUpdateCollateralProposal("title", "description", tc.param)
+			err := keeper.HandleUpdateCollateralProposal(ctx, suite.keeper, p)
+			suite.Require().Error(err)
+			suite.Equal(tc.errorCode, err.Code())
+			cp, _ := suite.keeper.GetCollateral(ctx, "xrp")
+			suite.Equal(xrp.String(), cp.String())
+		})
+	}
+
+	updated := xrp
+	updated.LiquidationRatio = d("2.5")
+	updated.MarketID = "bnb:usd"
+	p := types.NewUpdateCollateralProposal("title", "description", updated)
+	suite.NoError(keeper.HandleUpdateCollateralProposal(suite.ctx, suite.keeper, p))
+	cp, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	suite.Equal(updated.String(), cp.String())
+	suite.Equal(2, len(suite.keeper.GetParams(suite.ctx).CollateralParams))
+}
+
+func (suite *ProposalTestSuite) TestRemoveMarketProposal() {
+	pk := suite.app.GetPriceFeedKeeper()
+
+	// markets pricing collateral types can't be removed
+	err := pricefeed.HandleRemoveMarketProposal(suite.ctx, pk, pricefeed.NewRemoveMarketProposal("title", "description", "xrp:usd"))
+	suite.Require().Error(err)
+	suite.Equal(types.CodeMarketInUse, err.Code())
+	_, found := pk.GetMarket(suite.ctx, "xrp:usd")
+	suite.True(found)
+
+	// nor can markets pricing debt types
+	ctx, _ := suite.ctx.CacheContext()
+	params := suite.keeper.GetParams(ctx)
+	params.DebtParams[0].MarketID = "bnb:usd"
+	suite.keeper.SetParams(ctx, params)
+	err = pricefeed.HandleRemoveMarketProposal(ctx, pk, pricefeed.NewRemoveMarketProposal("title", "description", "bnb:usd"))
+	suite.Require().Error(err)
+	suite.Equal(types.CodeMarketInUse, err.Code())
+
+	// unused markets are removed
+	suite.NoError(pricefeed.HandleRemoveMarketProposal(suite.ctx, pk, pricefeed.NewRemoveMarketProposal("title", "description", "bnb:usd")))
+	_, found = pk.GetMarket(suite.ctx, "bnb:usd")
+	suite.False(found)
+}
+
+func TestProposalTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalTestSuite))
+}
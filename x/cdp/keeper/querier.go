@@ -22,6 +22,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryGetParams(ctx, req, keeper)
 		case types.QueryGetCdpDeposits:
 			return queryGetDeposits(ctx, req, keeper)
+		case types.QueryGetSavingsDeposit:
+			return queryGetSavingsDeposit(ctx, req, keeper)
+		case types.QueryGetSavingsRate:
+			return queryGetSavingsRate(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown cdp query endpoint")
 		}
@@ -160,3 +164,35 @@ func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]by
 	}
 	return bz, nil
 }
+
+// query the savings deposit of a depositor along with its current balance
+func queryGetSavingsDeposit(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var requestParams types.QuerySavingsDepositParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	deposit, found := keeper.GetSavingsDeposit(ctx, requestParams.Depositor)
+	if !found {
+		return nil, types.ErrSavingsDepositNotFound(keeper.codespace, requestParams.Depositor)
+	}
+	position := types.NewSavingsPosition(deposit, keeper.GetSavingsBalance(ctx, deposit))
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, position)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// query the current savings rate
+func queryGetSavingsRate(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	rate := keeper.GetSavingsRate(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, rate)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
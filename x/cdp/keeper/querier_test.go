@@ -178,7 +178,7 @@ func (suite *QuerierTestSuite) TestQueryCdpsByRatio() {
 	expectedXrpIds := []int{}
 	expectedBtcIds := []int{}
 	for _, cdp := range suite.cdps {
-		absoluteRatio := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Collateral, cdp.Principal)
+		absoluteRatio := suite.keeper.CalculateCollateralToDebtRatio(suite.ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 		collateralizationRatio, err := suite.keeper.CalculateCollateralizationRatioFromAbsoluteRatio(suite.ctx, cdp.Collateral[0].Denom, absoluteRatio)
 		suite.Nil(err)
 		if cdp.Collateral[0].Denom == "xrp" {
@@ -275,6 +275,37 @@ func (suite *QuerierTestSuite) TestQueryDeposits() {
 
 }
 
+func (suite *QuerierTestSuite) TestQuerySavings() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	owner := suite.cdps[0].Owner
+	debt := suite.keeper.CalculateDebt(ctx, suite.cdps[0])
+	suite.Nil(suite.keeper.DepositSavings(ctx, owner, debt))
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetSavingsDeposit}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySavingsDepositParams(owner)),
+	}
+
+	bz, err := suite.querier(ctx, []string{types.QueryGetSavingsDeposit}, query)
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var position types.SavingsPosition
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &position))
+	suite.Equal(owner, position.Depositor)
+	suite.Equal(debt, position.Shares)
+	suite.Equal(debt, position.Balance)
+
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQuerySavingsDepositParams(suite.addrs[1]))
+	_, err = suite.querier(ctx, []string{types.QueryGetSavingsDeposit}, query)
+	suite.Error(err)
+
+	bz, err = suite.querier(ctx, []string{types.QueryGetSavingsRate}, abci.RequestQuery{})
+	suite.Nil(err)
+	var rate sdk.Dec
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &rate))
+	suite.Equal(sdk.ZeroDec(), rate)
+}
+
 func TestQuerierTestSuite(t *testing.T) {
 	suite.Run(t, new(QuerierTestSuite))
 }
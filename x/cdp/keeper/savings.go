@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// DepositSavings locks debt assets in the savings module account in exchange for savings shares.
+// Shares are issued in proportion to the assets already held, so depositors only earn the
+// savings rate distributed after their deposit.
+func (k Keeper) DepositSavings(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	totalShares := k.GetTotalSavingsShares(ctx)
+	balance := k.getSavingsMaccBalance(ctx)
+	shares := sdk.NewCoins()
+	for _, coin := range amount {
+		_, found := k.GetDebtParam(ctx, coin.Denom)
+		if !found {
+			return types.ErrInvalidSavingsAmount(k.codespace, amount, "only debt assets can be deposited in savings")
+		}
+		newShares := coin.Amount
+		if totalShares.AmountOf(coin.Denom).IsPositive() && balance.AmountOf(coin.Denom).IsPositive() {
+			newShares = coin.Amount.Mul(totalShares.AmountOf(coin.Denom)).Quo(balance.AmountOf(coin.Denom))
+		}
+		if !newShares.IsPositive() {
+			return types.ErrInvalidSavingsAmount(k.codespace, amount, "amount is worth less than one share")
+		}
+		shares = shares.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, newShares)))
+	}
+
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.SavingsMacc, amount)
+	if err != nil {
+		return err
+	}
+
+	deposit, found := k.GetSavingsDeposit(ctx, depositor)
+	if found {
+		deposit.Shares = deposit.Shares.Add(shares)
+	} else {
+		deposit = types.NewSavingsDeposit(depositor, shares)
+	}
+	k.SetSavingsDeposit(ctx, deposit)
+	k.SetTotalSavingsShares(ctx, totalShares.Add(shares))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+	return nil
+}
+
+// WithdrawSavings returns debt assets from the savings module account to a depositor, burning the
+// shares they were worth. The shares burned are rounded up so withdrawals never take more than their share.
+func (k Keeper) WithdrawSavings(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	deposit, found := k.GetSavingsDeposit(ctx, depositor)
+	if !found {
+		return types.ErrSavingsDepositNotFound(k.codespace, depositor)
+	}
+	totalShares := k.GetTotalSavingsShares(ctx)
+	balance := k.getSavingsMaccBalance(ctx)
+	shares := sdk.NewCoins()
+	for _, coin := range amount {
+		denomShares := totalShares.AmountOf(coin.Denom)
+		denomBalance := balance.AmountOf(coin.Denom)
+		if !denomBalance.IsPositive() {
+			return types.ErrInsufficientSavings(k.codespace, depositor, k.GetSavingsBalance(ctx, deposit), amount)
+		}
+		burned := sdk.NewDecFromInt(coin.Amount.Mul(denomShares)).QuoInt(denomBalance).Ceil().TruncateInt()
+		if burned.GT(deposit.Shares.AmountOf(coin.Denom)) {
+			return types.ErrInsufficientSavings(k.codespace, depositor, k.GetSavingsBalance(ctx, deposit), amount)
+		}
+		shares = shares.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, burned)))
+	}
+
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.SavingsMacc, depositor, amount)
+	if err != nil {
+		return err
+	}
+
+	deposit.Shares = deposit.Shares.Sub(shares)
+	if deposit.Shares.IsZero() {
+		k.DeleteSavingsDeposit(ctx, depositor)
+	} else {
+		k.SetSavingsDeposit(ctx, deposit)
+	}
+	k.SetTotalSavingsShares(ctx, totalShares.Sub(shares))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+	return nil
+}
+
+// DistributeSavingsRate moves the savings rate's share of newly collected stability fees from the liquidator
+// module account to the savings module account, raising the value of every savings share of that debt asset.
+// Only surplus above the debt the liquidator still has to cover is distributed, so savings never take assets
+// needed to net bad debt. Fees in debt assets that nobody has deposited in savings are left with the liquidator.
+func (k Keeper) DistributeSavingsRate(ctx sdk.Context, fees sdk.Coins) sdk.Error {
+	rate := k.GetSavingsRate(ctx)
+	if !rate.IsPositive() {
+		return nil
+	}
+	totalShares := k.GetTotalSavingsShares(ctx)
+	distribution := sdk.NewCoins()
+	for _, fee := range fees {
+		if !totalShares.AmountOf(fee.Denom).IsPositive() {
+			continue
+		}
+		amount := sdk.NewDecFromInt(fee.Amount).Mul(rate).TruncateInt()
+		amount = sdk.MinInt(amount, k.getSurplusAboveDebt(ctx, fee.Denom))
+		if !amount.IsPositive() {
+			continue
+		}
+		distribution = distribution.Add(sdk.NewCoins(sdk.NewCoin(fee.Denom, amount)))
+	}
+	if distribution.IsZero() {
+		return nil
+	}
+
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.LiquidatorMacc, types.SavingsMacc, distribution)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsRate,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, distribution.String()),
+		),
+	)
+	return nil
+}
+
+// GetSavingsBalance returns the amount of debt assets the shares of a savings deposit can currently be withdrawn for
+func (k Keeper) GetSavingsBalance(ctx sdk.Context, deposit types.SavingsDeposit) sdk.Coins {
+	totalShares := k.GetTotalSavingsShares(ctx)
+	balance := k.getSavingsMaccBalance(ctx)
+	value := sdk.NewCoins()
+	for _, share := range deposit.Shares {
+		if !totalShares.AmountOf(share.Denom).IsPositive() {
+			continue
+		}
+		amount := share.Amount.Mul(balance.AmountOf(share.Denom)).Quo(totalShares.AmountOf(share.Denom))
+		value = value.Add(sdk.NewCoins(sdk.NewCoin(share.Denom, amount)))
+	}
+	return value
+}
+
+// GetSavingsDeposit returns the savings deposit of a depositor from the store
+func (k Keeper) GetSavingsDeposit(ctx sdk.Context, depositor sdk.AccAddress) (deposit types.SavingsDeposit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	bz := store.Get(depositor)
+	if bz == nil {
+		return deposit, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &deposit)
+	return deposit, true
+}
+
+// SetSavingsDeposit sets the savings deposit in the store
+func (k Keeper) SetSavingsDeposit(ctx sdk.Context, deposit types.SavingsDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(deposit)
+	store.Set(deposit.Depositor, bz)
+}
+
+// DeleteSavingsDeposit deletes a savings deposit from the store
+func (k Keeper) DeleteSavingsDeposit(ctx sdk.Context, depositor sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	store.Delete(depositor)
+}
+
+// IterateSavingsDeposits iterates over all savings deposits in the store and performs a callback function
+func (k Keeper) IterateSavingsDeposits(ctx sdk.Context, cb func(deposit types.SavingsDeposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.SavingsDeposit
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deposit)
+
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// GetAllSavingsDeposits returns all savings deposits from the store
+func (k Keeper) GetAllSavingsDeposits(ctx sdk.Context) (deposits types.SavingsDeposits) {
+	k.IterateSavingsDeposits(ctx, func(deposit types.SavingsDeposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+	return
+}
+
+// GetTotalSavingsShares returns the total savings shares issued for each debt asset
+func (k Keeper) GetTotalSavingsShares(ctx sdk.Context) (total sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalSavingsSharesKey)
+	bz := store.Get([]byte{})
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &total)
+	return total
+}
+
+// SetTotalSavingsShares sets the total savings shares issued for each debt asset
+func (k Keeper) SetTotalSavingsShares(ctx sdk.Context, total sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalSavingsSharesKey)
+	store.Set([]byte{}, k.cdc.MustMarshalBinaryLengthPrefixed(total))
+}
+
+func (k Keeper) getSavingsMaccBalance(ctx sdk.Context) sdk.Coins {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.SavingsMacc).GetCoins()
+}
+
+// getSurplusAboveDebt returns the liquidator's balance of a debt asset in excess of the debt of that asset it has to cover
+func (k Keeper) getSurplusAboveDebt(ctx sdk.Context, denom string) sdk.Int {
+	surplus := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins().AmountOf(denom)
+	debt := k.getModAccountDebt(ctx, types.LiquidatorMacc, denom)
+	if debt.GTE(surplus) {
+		return sdk.ZeroInt()
+	}
+	return surplus.Sub(debt)
+}
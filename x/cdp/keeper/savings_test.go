@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+type SavingsTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *SavingsTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	authGS := app.NewAuthGenState(
+		addrs,
+		[]sdk.Coins{cs(c("usdx", 100000000), c("xrp", 100000000)), cs(c("usdx", 100000000))},
+	)
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(),
+		NewCDPGenStateMulti(),
+	)
+	keeper := tApp.GetCDPKeeper()
+	params := keeper.GetParams(ctx)
+	params.SavingsRate = d("0.5")
+	keeper.SetParams(ctx, params)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = keeper
+	suite.addrs = addrs
+}
+
+func (suite *SavingsTestSuite) TestDepositWithdrawSavings() {
+	err := suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], cs(c("usdx", 10000000)))
+	suite.NoError(err)
+	deposit, found := suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0])
+	suite.True(found)
+	suite.Equal(cs(c("usdx", 10000000)), deposit.Shares)
+	suite.Equal(cs(c("usdx", 10000000)), suite.keeper.GetTotalSavingsShares(suite.ctx))
+	suite.Equal(cs(c("usdx", 10000000)), suite.getModuleBalance(types.SavingsMacc))
+	suite.Equal(cs(c("usdx", 90000000), c("xrp", 100000000)), suite.getAccountCoins(suite.addrs[0]))
+
+	err = suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], cs(c("xrp", 10000000)))
+	suite.Equal(types.CodeInvalidSavingsAmount, err.Result().Code)
+
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[1], cs(c("usdx", 10000000)))
+	suite.Equal(types.CodeSavingsDepositNotFound, err.Result().Code)
+
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], cs(c("usdx", 10000001)))
+	suite.Equal(types.CodeInsufficientSavings, err.Result().Code)
+
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], cs(c("usdx", 4000000)))
+	suite.NoError(err)
+	deposit, _ = suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0])
+	suite.Equal(cs(c("usdx", 6000000)), deposit.Shares)
+
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], cs(c("usdx", 6000000)))
+	suite.NoError(err)
+	_, found = suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0])
+	suite.False(found)
+	suite.True(suite.keeper.GetTotalSavingsShares(suite.ctx).IsZero())
+	suite.Equal(cs(c("usdx", 100000000), c("xrp", 100000000)), suite.getAccountCoins(suite.addrs[0]))
+}
+
+func (suite *SavingsTestSuite) TestDistributeSavingsRate() {
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", i(100000000000))
+
+	// without savings deposits the liquidator keeps all fees
+	suite.keeper.HandleNewDebt(suite.ctx, "xrp", "usdx", i(3600))
+	fees := suite.getModuleBalance(types.LiquidatorMacc).AmountOf("usdx")
+	suite.True(fees.IsPositive())
+	suite.True(suite.getModuleBalance(types.SavingsMacc).IsZero())
+
+	err := suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], cs(c("usdx", 100000000)))
+	suite.NoError(err)
+
+	suite.keeper.HandleNewDebt(suite.ctx, "xrp", "usdx", i(3600))
+	liquidatorBalance := suite.getModuleBalance(types.LiquidatorMacc).AmountOf("usdx")
+	savingsRateFees := suite.getModuleBalance(types.SavingsMacc).AmountOf("usdx").Sub(i(100000000))
+	suite.True(savingsRateFees.IsPositive())
+	newFees := liquidatorBalance.Add(savingsRateFees).Sub(fees)
+	suite.Equal(sdk.NewDecFromInt(newFees).Mul(d("0.5")).TruncateInt(), savingsRateFees)
+
+	deposit, _ := suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0])
+	suite.Equal(cs(c("usdx", i(100000000).Add(savingsRateFees).Int64())), suite.keeper.GetSavingsBalance(suite.ctx, deposit))
+
+	// later depositors receive fewer shares and don't earn fees distributed before their deposit
+	err = suite.keeper.DepositSavings(suite.ctx, suite.addrs[1], cs(c("usdx", 100000000)))
+	suite.NoError(err)
+	deposit, _ = suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[1])
+	suite.True(deposit.Shares.AmountOf("usdx").LT(i(100000000)))
+	balance := suite.keeper.GetSavingsBalance(suite.ctx, deposit)
+	suite.True(i(100000000).Sub(balance.AmountOf("usdx")).LTE(i(1)))
+
+	deposit, _ = suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0])
+	balance = suite.keeper.GetSavingsBalance(suite.ctx, deposit)
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], balance)
+	suite.NoError(err)
+	suite.Equal(i(100000000).Add(savingsRateFees), suite.getAccountCoins(suite.addrs[0]).AmountOf("usdx"))
+
+	_, broken := keeper.SavingsInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+}
+
+func (suite *SavingsTestSuite) TestDistributeSavingsRateZero() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SavingsRate = sdk.ZeroDec()
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", i(100000000000))
+	err := suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], cs(c("usdx", 100000000)))
+	suite.NoError(err)
+
+	suite.keeper.HandleNewDebt(suite.ctx, "xrp", "usdx", i(3600))
+	suite.True(suite.getModuleBalance(types.LiquidatorMacc).AmountOf("usdx").IsPositive())
+	suite.Equal(cs(c("usdx", 100000000)), suite.getModuleBalance(types.SavingsMacc))
+}
+
+func (suite *SavingsTestSuite) TestDistributeSavingsRateBadDebt() {
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", i(100000000000))
+	err := suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], cs(c("usdx", 100000000)))
+	suite.NoError(err)
+
+	// fees that only cover the liquidator's outstanding debt aren't distributed
+	sk := suite.app.GetSupplyKeeper()
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", 1000000000)))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.HandleNewDebt(suite.ctx, "xrp", "usdx", i(3600)))
+	fees := suite.getModuleBalance(types.LiquidatorMacc).AmountOf("usdx")
+	suite.True(fees.IsPositive())
+	suite.Equal(cs(c("usdx", 100000000)), suite.getModuleBalance(types.SavingsMacc))
+
+	// once the debt is covered, only the surplus above it is distributed
+	debt := fees.Add(i(500000))
+	err = sk.BurnCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtusdx", i(1000000000).Sub(debt).Int64())))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.HandleNewDebt(suite.ctx, "xrp", "usdx", i(3600)))
+	newFees := suite.getModuleBalance(types.LiquidatorMacc).AmountOf("usdx").Sub(fees)
+	savingsRateFees := suite.getModuleBalance(types.SavingsMacc).AmountOf("usdx").Sub(i(100000000))
+	newFees = newFees.Add(savingsRateFees)
+	suite.True(savingsRateFees.IsPositive())
+	suite.True(savingsRateFees.LT(sdk.NewDecFromInt(newFees).Mul(d("0.5")).TruncateInt()))
+	suite.Equal(newFees.Sub(i(500000)), savingsRateFees)
+}
+
+func (suite *SavingsTestSuite) TestDistributeSavingsRateOtherDebt() {
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", i(100000000000))
+	err := suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], cs(c("usdx", 100000000)))
+	suite.NoError(err)
+
+	// the liquidator's debt of another asset doesn't hold back the savings rate
+	sk := suite.app.GetSupplyKeeper()
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debtsusd", 1000000000)))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.HandleNewDebt(suite.ctx, "xrp", "usdx", i(3600)))
+	suite.True(suite.getModuleBalance(types.SavingsMacc).AmountOf("usdx").GT(i(100000000)))
+}
+
+func (suite *SavingsTestSuite) getAccountCoins(addr sdk.AccAddress) sdk.Coins {
+	return suite.app.GetAccountKeeper().GetAccount(suite.ctx, addr).GetCoins()
+}
+
+func (suite *SavingsTestSuite) getModuleBalance(name string) sdk.Coins {
+	return suite.app.GetSupplyKeeper().GetModuleAccount(suite.ctx, name).GetCoins()
+}
+
+func TestSavingsTestSuite(t *testing.T) {
+	suite.Run(t, new(SavingsTestSuite))
+}
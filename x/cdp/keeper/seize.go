@@ -9,36 +9,25 @@ import (
 
 // SeizeCollateral liquidates the collateral in the input cdp.
 // the following operations are performed:
-// 1. updates the fees for the input cdp,
+// 1. calculates the current debt, principal plus fees, of the input cdp,
 // 2. sends collateral for all deposits from the cdp module to the liquidator module account
 // 3. Applies the liquidation penalty and mints the corresponding amount of debt coins in the cdp module
 // 3. moves debt coins from the cdp module to the liquidator module account,
-// 4. decrements the total amount of principal outstanding for that collateral type
+// 4. removes the normalized principal of the cdp from the total for that collateral type
 // (this is the equivalent of saying that fees are no longer accumulated by a cdp once it gets liquidated)
 func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) sdk.Error {
+	return k.seizeCollateral(ctx, cdp, nil)
+}
+
+// seizeCollateral liquidates the collateral in the input cdp, paying the keeper reward out of the seized collateral
+// to the input keeper if it is not empty.
+func (k Keeper) seizeCollateral(ctx sdk.Context, cdp types.CDP, keeper sdk.AccAddress) sdk.Error {
 	// Calculate the previous collateral ratio
-	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
-	// Update fees
-	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
-	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(fees)
-	cdp.FeesUpdated = ctx.BlockTime()
+	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
 
 	// Move debt coins from cdp to liquidator account
 	deposits := k.GetDeposits(ctx, cdp.ID)
-	debt := sdk.ZeroInt()
-	for _, pc := range cdp.Principal {
-		debt = debt.Add(pc.Amount)
-	}
-	for _, dc := range cdp.AccumulatedFees {
-		debt = debt.Add(dc.Amount)
-	}
-	modAccountDebt := k.getModAccountDebt(ctx, types.ModuleName)
-	if modAccountDebt.LT(debt) {
-		debt = modAccountDebt
-	}
-	debtCoin := sdk.NewCoin(k.GetDebtDenom(ctx), debt)
-	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
+	debt, err := k.moveDebtToLiquidator(ctx, k.CalculateDebt(ctx, cdp))
 	if err != nil {
 		return err
 	}
@@ -59,51 +48,215 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) sdk.Error {
 		}
 		k.DeleteDeposit(ctx, dep.CdpID, dep.Depositor)
 	}
-	err = k.AuctionCollateral(ctx, deposits, debt, cdp.Principal[0].Denom)
+	deposits, keeperReward, err := k.payKeeperReward(ctx, keeper, cdp, deposits)
 	if err != nil {
 		return err
 	}
-
-	// Decrement total principal for this collateral type
-	for _, dc := range cdp.Principal {
-		feeAmount := cdp.AccumulatedFees.AmountOf(dc.Denom)
-		coinsToDecrement := sdk.NewCoins(dc)
-		if feeAmount.IsPositive() {
-			feeCoins := sdk.NewCoins(sdk.NewCoin(dc.Denom, feeAmount))
-			coinsToDecrement = coinsToDecrement.Add(feeCoins)
-		}
-		k.DecrementTotalPrincipal(ctx, cdp.Collateral[0].Denom, coinsToDecrement)
+	err = k.AuctionCollateral(ctx, deposits, debt, keeperReward)
+	if err != nil {
+		return err
 	}
+
+	// Removing the cdp's principal from the total principal for this collateral type stops fees accruing on the seized debt
+	k.DecrementNormalizedPrincipal(ctx, cdp)
 	k.RemoveCdpOwnerIndex(ctx, cdp)
-	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Collateral[0].Denom, cdp.ID, oldCollateralToDebtRatio)
+	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
 	k.DeleteCDP(ctx, cdp)
 	return nil
 }
 
+// PartialSeizeCollateral liquidates only as much of the input cdp as is needed to bring it back above
+// the liquidation ratio plus the liquidation buffer of its collateral type.
+// The seized collateral is taken pro rata from every deposit, so the liquidation penalty, which is applied
+// when the seized collateral is auctioned, only falls on the seized debt.
+// The same fraction of the debt of every debt denom is seized.
+// The whole cdp is seized instead if it can't be brought back above the target ratio,
+// or if the debt of any debt denom left in the cdp would be below its debt floor.
+// Collateral is valued at its liquidation price, and an error is returned if the cdp is already above the target ratio.
+func (k Keeper) PartialSeizeCollateral(ctx sdk.Context, cdp types.CDP) sdk.Error {
+	return k.partialSeizeCollateral(ctx, cdp, nil)
+}
+
+// partialSeizeCollateral partially liquidates the input cdp, paying the keeper reward out of the seized collateral
+// to the input keeper if it is not empty.
+func (k Keeper) partialSeizeCollateral(ctx sdk.Context, cdp types.CDP, keeper sdk.AccAddress) sdk.Error {
+	cp, _ := k.GetCollateral(ctx, cdp.Type)
+
+	// Calculate the previous collateral ratio before the cdp is modified
+	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
+	totalDebt := k.CalculateDebt(ctx, cdp)
+
+	liquidationRatio, err := k.CalculateLiquidationRatio(ctx, cdp.Type, cdp.Collateral)
+	if err != nil {
+		return err
+	}
+	targetRatio := liquidationRatio.Add(cp.LiquidationBuffer)
+	penaltyRatio := sdk.OneDec().Add(cp.LiquidationPenalty)
+	// seizing collateral worth more than the debt it repays can't raise the collateralization ratio
+	if targetRatio.LTE(penaltyRatio) {
+		return k.seizeCollateral(ctx, cdp, keeper)
+	}
+	// the collateral is valued at the same prices the cdp was found to be below its liquidation ratio at
+	collateralValue, err := k.calculateLiquidationCollateralValue(ctx, cdp.Collateral)
+	if err != nil {
+		return err
+	}
+	debtValue, err := k.calculateDebtValue(ctx, totalDebt, sdk.NewCoins())
+	if err != nil {
+		return err
+	}
+
+	// solve (collateralValue - seizedDebtValue * penaltyRatio) / (debtValue - seizedDebtValue) = targetRatio
+	seizedDebtValue := targetRatio.Mul(debtValue).Sub(collateralValue).Quo(targetRatio.Sub(penaltyRatio))
+	if !seizedDebtValue.IsPositive() {
+		return types.ErrCdpNotLiquidatable(k.codespace, cdp.ID, collateralValue.Quo(debtValue), liquidationRatio)
+	}
+	seizedDebtFraction := seizedDebtValue.Quo(debtValue)
+	seizedDebt := sdk.NewCoins()
+	for _, dc := range totalDebt {
+		amount := seizedDebtFraction.MulInt(dc.Amount).Ceil().TruncateInt()
+		if amount.GTE(dc.Amount) {
+			return k.seizeCollateral(ctx, cdp, keeper)
+		}
+		seizedDebt = seizedDebt.Add(sdk.NewCoins(sdk.NewCoin(dc.Denom, amount)))
+	}
+	for _, dc := range totalDebt {
+		dp, _ := k.GetDebtParam(ctx, dc.Denom)
+		if dc.Amount.Sub(seizedDebt.AmountOf(dc.Denom)).LT(dp.DebtFloor) {
+			return k.seizeCollateral(ctx, cdp, keeper)
+		}
+	}
+	seizedFraction := seizedDebtValue.Mul(penaltyRatio).Quo(collateralValue)
+	if seizedFraction.GTE(sdk.OneDec()) {
+		return k.seizeCollateral(ctx, cdp, keeper)
+	}
+
+	// Move the seized debt coins from cdp to liquidator account
+	debt, err := k.moveDebtToLiquidator(ctx, seizedDebt)
+	if err != nil {
+		return err
+	}
+
+	// seize the same fraction of every deposit and send it from cdp to liquidator
+	seizedDeposits := types.Deposits{}
+	seizedCollateral := sdk.NewCoins()
+	for _, dep := range k.GetDeposits(ctx, cdp.ID) {
+		seizedAmount := sdk.NewCoins()
+		for _, dc := range dep.Amount {
+			amount := sdk.MinInt(seizedFraction.MulInt(dc.Amount).Ceil().TruncateInt(), dc.Amount)
+			seizedAmount = seizedAmount.Add(sdk.NewCoins(sdk.NewCoin(dc.Denom, amount)))
+		}
+		if seizedAmount.IsZero() {
+			continue
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpLiquidation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+				sdk.NewAttribute(types.AttributeKeyDepositor, fmt.Sprintf("%s", dep.Depositor)),
+			),
+		)
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, seizedAmount)
+		if err != nil {
+			return err
+		}
+		seizedDeposits = append(seizedDeposits, types.NewDeposit(cdp.ID, dep.Depositor, seizedAmount))
+		seizedCollateral = seizedCollateral.Add(seizedAmount)
+		dep.Amount = dep.Amount.Sub(seizedAmount)
+		if dep.Amount.IsZero() {
+			k.DeleteDeposit(ctx, dep.CdpID, dep.Depositor)
+		} else {
+			k.SetDeposit(ctx, dep)
+		}
+	}
+	seizedDeposits, keeperReward, err := k.payKeeperReward(ctx, keeper, cdp, seizedDeposits)
+	if err != nil {
+		return err
+	}
+	err = k.AuctionCollateral(ctx, seizedDeposits, debt, keeperReward)
+	if err != nil {
+		return err
+	}
+
+	// Update the remaining cdp and remove the seized debt from the total principal for this collateral type
+	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
+	k.DecrementNormalizedPrincipal(ctx, cdp)
+	cdp = k.removeDebt(ctx, cdp, seizedDebt)
+	cdp.Collateral = cdp.Collateral.Sub(seizedCollateral)
+	k.IncrementNormalizedPrincipal(ctx, cdp)
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Type, cdp.Collateral, cdp.NormalizedPrincipal)
+	k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+	return nil
+}
+
 // HandleNewDebt compounds the accumulated fees for the input collateral and principal coins.
 // the following operations are performed:
-// 1. mints the fee coins in the liquidator module account,
-// 2. mints the same amount of debt coins in the cdp module account
-// 3. updates the total amount of principal for the input collateral type in the store,
-func (k Keeper) HandleNewDebt(ctx sdk.Context, collateralDenom string, principalDenom string, periods sdk.Int) {
+// 1. multiplies the interest factor for the input collateral and principal denoms by the fee rate compounded over the input periods,
+// which increases the total amount of principal for the input collateral type in the store by the new fees,
+// 2. mints the fee coins in the liquidator module account,
+// 3. mints the same amount of debt coins in the cdp module account
+// 4. pays the savings rate's share of the fees to savings depositors
+// Individual cdps are charged their share of the fees when they are next synchronized. The fees are collected
+// even if paying the savings rate fails, in which case the error is returned.
+func (k Keeper) HandleNewDebt(ctx sdk.Context, collateralDenom string, principalDenom string, periods sdk.Int) sdk.Error {
 	previousDebt := k.GetTotalPrincipal(ctx, collateralDenom, principalDenom)
-	feeCoins := sdk.NewCoins(sdk.NewCoin(principalDenom, previousDebt))
-	newFees := k.CalculateFees(ctx, feeCoins, periods, collateralDenom)
+	growth := k.calculateInterestGrowth(ctx, collateralDenom, principalDenom, periods)
+	factor := k.GetInterestFactor(ctx, collateralDenom, principalDenom)
+	k.SetInterestFactor(ctx, collateralDenom, principalDenom, factor.Mul(growth))
+	newDebt := k.GetTotalPrincipal(ctx, collateralDenom, principalDenom)
+	if !newDebt.GT(previousDebt) {
+		return nil
+	}
+	newFees := sdk.NewCoins(sdk.NewCoin(principalDenom, newDebt.Sub(previousDebt)))
 	k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), newFees)
 	k.supplyKeeper.MintCoins(ctx, types.LiquidatorMacc, newFees)
-	k.SetTotalPrincipal(ctx, collateralDenom, principalDenom, feeCoins.Add(newFees).AmountOf(principalDenom))
+	return k.DistributeSavingsRate(ctx, newFees)
 }
 
 // LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio
 func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, denom string, liquidationRatio sdk.Dec) sdk.Error {
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	cp, found := k.GetCollateral(ctx, denom)
+	if !found {
+		return types.ErrCollateralNotSupported(k.codespace, denom)
+	}
+	price, err := k.getLiquidationPrice(ctx, marketID, cp.LiquidationTWAPWindow)
 	if err != nil {
 		return err
 	}
-	normalizedRatio := sdk.OneDec().Quo(price.Price.Quo(liquidationRatio))
+	// the index values all debt assets at one and is by normalized principal, which is the debt divided by the interest factor,
+	// so the scan is widened to cover cdps owing the most expensive debt asset with the highest interest factor
+	maxDebtPrice, err := k.getMaxDebtPrice(ctx)
+	if err != nil {
+		return err
+	}
+	scanScale := maxDebtPrice.Mul(k.getMaxInterestFactor(ctx, denom))
+	// cdps holding other collateral assets have a basket liquidation ratio, which may be up to the highest liquidation ratio
+	// of any collateral type, so the scan is also widened to cover cdps below the highest liquidation ratio
+	scanRatio := sdk.MaxDec(liquidationRatio, k.getMaxLiquidationRatio(ctx))
+	normalizedRatio := sdk.OneDec().Quo(price.Quo(scanRatio)).Mul(scanScale)
 	cdpsToLiquidate := k.GetAllCdpsByDenomAndRatio(ctx, denom, normalizedRatio)
 	for _, c := range cdpsToLiquidate {
-		err := k.SeizeCollateral(ctx, c)
+		// the index only counts collateral of the cdp's own type and doesn't price debt, so cdps holding other collateral
+		// assets, owing priced debt assets or found by the widened scan may still be above their liquidation ratio
+		if c.HoldsOtherCollateral() || k.holdsPricedDebt(ctx, c) || scanScale.GT(sdk.OneDec()) || scanRatio.GT(liquidationRatio) {
+			collateralizationRatio, err := k.CalculateLiquidationCollateralizationRatio(ctx, c.Collateral, k.CalculateDebt(ctx, c), sdk.NewCoins())
+			if err != nil {
+				return err
+			}
+			basketLiquidationRatio, err := k.CalculateLiquidationRatio(ctx, c.Type, c.Collateral)
+			if err != nil {
+				return err
+			}
+			if collateralizationRatio.GTE(basketLiquidationRatio) {
+				continue
+			}
+		}
+		if cp.PartialLiquidation {
+			err = k.PartialSeizeCollateral(ctx, c)
+		} else {
+			err = k.SeizeCollateral(ctx, c)
+		}
 		if err != nil {
 			return err
 		}
@@ -111,14 +264,117 @@ func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, denom string, li
 	return nil
 }
 
+// AttemptKeeperLiquidation liquidates the cdp with the input collateral type and id if it is below its liquidation ratio.
+// The cdp is liquidated in the same way as in the begin blocker, except that the keeper that submitted the liquidation
+// is paid the keeper reward percentage of the seized collateral, and the auctions of the rest of the seized collateral
+// raise the liquidation penalty less the keeper reward.
+func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress, denom string, id uint64) sdk.Error {
+	if k.GetCircuitBreaker(ctx) {
+		return types.ErrCircuitBreakerTripped(k.codespace, "liquidate cdp")
+	}
+	cdp, found := k.GetCDP(ctx, denom, id)
+	if !found {
+		return types.ErrCdpIDNotFound(k.codespace, denom, id)
+	}
+	collateralizationRatio, err := k.CalculateLiquidationCollateralizationRatio(ctx, cdp.Collateral, k.CalculateDebt(ctx, cdp), sdk.NewCoins())
+	if err != nil {
+		return err
+	}
+	liquidationRatio, err := k.CalculateLiquidationRatio(ctx, cdp.Type, cdp.Collateral)
+	if err != nil {
+		return err
+	}
+	if collateralizationRatio.GTE(liquidationRatio) {
+		return types.ErrCdpNotLiquidatable(k.codespace, cdp.ID, collateralizationRatio, liquidationRatio)
+	}
+
+	cp, _ := k.GetCollateral(ctx, cdp.Type)
+	if cp.PartialLiquidation {
+		return k.partialSeizeCollateral(ctx, cdp, keeper)
+	}
+	return k.seizeCollateral(ctx, cdp, keeper)
+}
+
+// payKeeperReward sends the keeper reward percentage of every input deposit, which has been seized from the input cdp,
+// from the liquidator module account to the input keeper.
+// It returns the deposits left to auction and the keeper reward percentage, which is zero if no keeper is given.
+func (k Keeper) payKeeperReward(ctx sdk.Context, keeper sdk.AccAddress, cdp types.CDP, deposits types.Deposits) (types.Deposits, sdk.Dec, sdk.Error) {
+	cp, _ := k.GetCollateral(ctx, cdp.Type)
+	if keeper.Empty() || cp.KeeperRewardPercentage.IsNil() || !cp.KeeperRewardPercentage.IsPositive() {
+		return deposits, sdk.ZeroDec(), nil
+	}
+	reward := sdk.NewCoins()
+	remainingDeposits := types.Deposits{}
+	for _, dep := range deposits {
+		depositReward := sdk.NewCoins()
+		for _, dc := range dep.Amount {
+			depositReward = depositReward.Add(sdk.NewCoins(sdk.NewCoin(dc.Denom, cp.KeeperRewardPercentage.MulInt(dc.Amount).TruncateInt())))
+		}
+		dep.Amount = dep.Amount.Sub(depositReward)
+		if !dep.Amount.IsZero() {
+			remainingDeposits = append(remainingDeposits, dep)
+		}
+		reward = reward.Add(depositReward)
+	}
+	if reward.IsZero() {
+		return deposits, cp.KeeperRewardPercentage, nil
+	}
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.LiquidatorMacc, keeper, reward)
+	if err != nil {
+		return nil, sdk.Dec{}, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpKeeperReward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, reward.String()),
+		),
+	)
+	return remainingDeposits, cp.KeeperRewardPercentage, nil
+}
+
 // ApplyLiquidationPenalty multiplies the input debt amount by the liquidation penalty and mints the debt coins in the cdp module account
 func (k Keeper) ApplyLiquidationPenalty(ctx sdk.Context, denom string, debt sdk.Int) sdk.Int {
-	penalty := k.getLiquidationPenalty(ctx, denom)
+	return k.applyLiquidationPenalty(ctx, denom, debt, sdk.ZeroDec())
+}
+
+// applyLiquidationPenalty multiplies the input debt amount by the liquidation penalty less the input keeper reward percentage,
+// which has already been paid out of the seized collateral
+func (k Keeper) applyLiquidationPenalty(ctx sdk.Context, denom string, debt sdk.Int, keeperReward sdk.Dec) sdk.Int {
+	penalty := k.getLiquidationPenalty(ctx, denom).Sub(keeperReward)
+	if penalty.IsNegative() {
+		return sdk.ZeroInt()
+	}
 	penaltyAmount := sdk.NewDecFromInt(debt).Mul(penalty).RoundInt()
 	return penaltyAmount
 }
 
-func (k Keeper) getModAccountDebt(ctx sdk.Context, accountName string) sdk.Int {
+// moveDebtToLiquidator moves the debt coins backing the input debt from the cdp module to the liquidator module account.
+// The debt of each denom is capped at the debt coins of that denom held by the cdp module, and the moved debt is returned.
+func (k Keeper) moveDebtToLiquidator(ctx sdk.Context, debt sdk.Coins) (sdk.Coins, sdk.Error) {
+	movedDebt := sdk.NewCoins()
+	debtCoins := sdk.NewCoins()
+	for _, dc := range debt {
+		amount := sdk.MinInt(dc.Amount, k.getModAccountDebt(ctx, types.ModuleName, dc.Denom))
+		movedDebt = movedDebt.Add(sdk.NewCoins(sdk.NewCoin(dc.Denom, amount)))
+		debtCoins = debtCoins.Add(sdk.NewCoins(sdk.NewCoin(k.getDebtCoinDenom(ctx, dc.Denom), amount)))
+	}
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, debtCoins)
+	if err != nil {
+		return nil, err
+	}
+	return movedDebt, nil
+}
+
+// getModAccountDebt returns the debt coins backing debt of the input debt denom held by the input module account
+func (k Keeper) getModAccountDebt(ctx sdk.Context, accountName string, denom string) sdk.Int {
 	macc := k.supplyKeeper.GetModuleAccount(ctx, accountName)
-	return macc.GetCoins().AmountOf(k.GetDebtDenom(ctx))
+	return macc.GetCoins().AmountOf(k.getDebtCoinDenom(ctx, denom))
+}
+
+// getDebtCoinDenom returns the denom of the debt coins backing debt of the input debt denom
+func (k Keeper) getDebtCoinDenom(ctx sdk.Context, denom string) string {
+	return types.DebtCoinDenom(k.GetDebtDenom(ctx), denom)
 }
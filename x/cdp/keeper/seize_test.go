@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/app"
@@ -49,8 +50,8 @@ func (suite *SeizeTestSuite) SetupTest() {
 		addrs, coins)
 	tApp.InitializeFromGenesisStates(
 		authGS,
-		NewPricefeedGenStateMulti(),
-		NewCDPGenStateMulti(),
+		NewPricefeedGenStateMultiDebt(),
+		NewCDPGenStateMultiDebt(),
 	)
 	suite.ctx = ctx
 	suite.app = tApp
@@ -129,7 +130,7 @@ func (suite *SeizeTestSuite) TestSeizeCollateral() {
 	suite.createCdps()
 	sk := suite.app.GetSupplyKeeper()
 	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
-	p := cdp.Principal[0].Amount
+	p := suite.keeper.CalculateDebt(suite.ctx, cdp).AmountOf("usdx")
 	cl := cdp.Collateral[0].Amount
 	tpb := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx")
 	err := suite.keeper.SeizeCollateral(suite.ctx, cdp)
@@ -140,11 +141,11 @@ func (suite *SeizeTestSuite) TestSeizeCollateral() {
 	_, found := auctionKeeper.GetAuction(suite.ctx, auction.DefaultNextAuctionID)
 	suite.True(found)
 	auctionMacc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
-	suite.Equal(cs(c("debt", p.Int64()), c("xrp", cl.Int64())), auctionMacc.GetCoins())
+	suite.Equal(cs(c("debtusdx", p.Int64()), c("xrp", cl.Int64())), auctionMacc.GetCoins())
 	ak := suite.app.GetAccountKeeper()
 	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
 	suite.Equal(p.Int64(), acc.GetCoins().AmountOf("usdx").Int64())
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], cs(c("xrp", 10)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], "xrp", cs(c("xrp", 10)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
 }
 
@@ -152,12 +153,12 @@ func (suite *SeizeTestSuite) TestSeizeCollateralMultiDeposit() {
 	suite.createCdps()
 	sk := suite.app.GetSupplyKeeper()
 	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
-	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], cs(c("xrp", 6999000000)))
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp", cs(c("xrp", 6999000000)))
 	suite.NoError(err)
 	cdp, _ = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
 	deposits := suite.keeper.GetDeposits(suite.ctx, cdp.ID)
 	suite.Equal(2, len(deposits))
-	p := cdp.Principal[0].Amount
+	p := suite.keeper.CalculateDebt(suite.ctx, cdp).AmountOf("usdx")
 	cl := cdp.Collateral[0].Amount
 	tpb := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx")
 	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
@@ -165,14 +166,158 @@ func (suite *SeizeTestSuite) TestSeizeCollateralMultiDeposit() {
 	tpa := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx")
 	suite.Equal(tpb.Sub(tpa), p)
 	auctionMacc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
-	suite.Equal(cs(c("debt", p.Int64()), c("xrp", cl.Int64())), auctionMacc.GetCoins())
+	suite.Equal(cs(c("debtusdx", p.Int64()), c("xrp", cl.Int64())), auctionMacc.GetCoins())
 	ak := suite.app.GetAccountKeeper()
 	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
 	suite.Equal(p.Int64(), acc.GetCoins().AmountOf("usdx").Int64())
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], cs(c("xrp", 10)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], "xrp", cs(c("xrp", 10)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
 }
 
+func (suite *SeizeTestSuite) TestSeizeCollateralMultiCollateral() {
+	suite.createCdps()
+	sk := suite.app.GetSupplyKeeper()
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], "xrp", cs(c("btc", 10000000)))
+	suite.NoError(err)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	p := suite.keeper.CalculateDebt(suite.ctx, cdp).AmountOf("usdx")
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.NoError(err)
+	auctionMacc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("btc", 10000000), c("debtusdx", p.Int64()), c("xrp", 10000000000)), auctionMacc.GetCoins())
+
+	// each collateral denom is sold in separate auctions
+	lotDenoms := make(map[string]int)
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		lotDenoms[a.GetLot().Denom]++
+		return false
+	})
+	suite.Equal(1, lotDenoms["btc"])
+	suite.Equal(2, lotDenoms["xrp"])
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralDutchAuction() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Denom == "xrp" {
+			params.CollateralParams[i].DutchAuction = true
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	p := suite.keeper.CalculateDebt(suite.ctx, cdp).AmountOf("usdx")
+	err := suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.NoError(err)
+	auctionMacc := suite.app.GetSupplyKeeper().GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("debtusdx", p.Int64()), c("xrp", 10000000000)), auctionMacc.GetCoins())
+
+	// xrp is sold in dutch auctions starting at the premium over its market price of 0.25 usdx
+	count := 0
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		da, ok := a.(auction.DutchCollateralAuction)
+		suite.True(ok)
+		suite.Equal(d("0.25").Mul(sdk.OneDec().Add(auction.DefaultDutchStartPremium)), da.StartPrice)
+		suite.Equal("usdx", da.MaxBid.Denom)
+		count++
+		return false
+	})
+	suite.Equal(2, count)
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralMultiDebt() {
+	sk := suite.app.GetSupplyKeeper()
+	// $2500 of xrp backing $500 of usdx and $500 of eur
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], cs(c("xrp", 10000000000)), cs(c("usdx", 500000000), c("eur", 400000000)))
+	suite.NoError(err)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.NoError(err)
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx"))
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "eur"))
+	auctionMacc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("debteur", 400000000), c("debtusdx", 500000000), c("xrp", 10000000000)), auctionMacc.GetCoins())
+
+	// the collateral is split between the debt denoms by value, and each part is auctioned for its own debt denom
+	lots := sdk.NewCoins()
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		lots = lots.Add(sdk.NewCoins(sdk.NewCoin(a.GetBid().Denom, a.GetLot().Amount)))
+		return false
+	})
+	suite.Equal(cs(c("eur", 5000000000), c("usdx", 5000000000)), lots)
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsPricedDebt() {
+	// $2500 of xrp backing 900 eur, worth $1125
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], cs(c("xrp", 10000000000)), cs(c("eur", 900000000)))
+	suite.NoError(err)
+	// $2500 of xrp backing $1200 of usdx
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[1], cs(c("xrp", 10000000000)), cs(c("usdx", 1200000000)))
+	suite.NoError(err)
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.True(found)
+
+	// the eur debt is now worth $1350, below the liquidation ratio, while the usdx cdp is unaffected
+	suite.setPrice(d("1.5"), "eur:usd")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.False(found)
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	suite.True(found)
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsPartialMultiDebt() {
+	suite.setPartialLiquidation("xrp", d("0.1"))
+	// $2500 of xrp backing $500 of usdx and $500 of eur
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], cs(c("xrp", 10000000000)), cs(c("usdx", 500000000), c("eur", 400000000)))
+	suite.NoError(err)
+	suite.setPrice(d("0.18"), "xrp:usd")
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+
+	// the same fraction of each debt denom is seized
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.True(found)
+	debt := suite.keeper.CalculateDebt(suite.ctx, cdp)
+	suite.True(debt.AmountOf("usdx").LT(i(500000000)))
+	suite.True(debt.AmountOf("eur").LT(i(400000000)))
+	usdxFraction := debt.AmountOf("usdx").ToDec().QuoInt64(500000000)
+	eurFraction := debt.AmountOf("eur").ToDec().QuoInt64(400000000)
+	suite.True(usdxFraction.Sub(eurFraction).Abs().LT(d("0.00000001")))
+	ratio, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, cdp.Collateral, debt, sdk.NewCoins())
+	suite.NoError(err)
+	suite.True(ratio.GTE(d("2.1")))
+
+	// each debt denom is raised by its own auctions, which carry the seized debt of that denom plus the liquidation penalty
+	seizedDebt := cs(c("usdx", 500000000), c("eur", 400000000)).Sub(debt)
+	suite.checkCollateralAuctions(cs(c("xrp", 10000000000)).Sub(cdp.Collateral), seizedDebt)
+	sk := suite.app.GetSupplyKeeper()
+	auctionMacc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(seizedDebt.AmountOf("usdx"), auctionMacc.GetCoins().AmountOf("debtusdx"))
+	suite.Equal(seizedDebt.AmountOf("eur"), auctionMacc.GetCoins().AmountOf("debteur"))
+}
+
+// checkCollateralAuctions checks that the collateral auctions sell the input seized collateral for the input seized debt,
+// with each auction raising its share of the debt plus the liquidation penalty on that share
+func (suite *SeizeTestSuite) checkCollateralAuctions(seizedCollateral sdk.Coins, seizedDebt sdk.Coins) {
+	lots := sdk.NewCoins()
+	debt := sdk.NewCoins()
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		ca, ok := a.(auction.CollateralAuction)
+		suite.True(ok)
+		suite.Equal(types.DebtCoinDenom(types.DefaultDebtDenom, ca.MaxBid.Denom), ca.CorrespondingDebt.Denom)
+		penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, ca.Lot.Denom, ca.CorrespondingDebt.Amount)
+		suite.Equal(ca.CorrespondingDebt.Amount.Add(penalty), ca.MaxBid.Amount)
+		lots = lots.Add(sdk.NewCoins(ca.Lot))
+		debt = debt.Add(sdk.NewCoins(sdk.NewCoin(ca.MaxBid.Denom, ca.CorrespondingDebt.Amount)))
+		return false
+	})
+	suite.Equal(seizedCollateral, lots)
+	suite.Equal(seizedDebt, debt)
+}
+
 func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.createCdps()
 	sk := suite.app.GetSupplyKeeper()
@@ -188,12 +333,269 @@ func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.Equal(len(suite.liquidations.xrp), xrpLiquidations)
 }
 
+func (suite *SeizeTestSuite) TestLiquidateCdpsMultiCollateral() {
+	suite.createCdps()
+	// btc deposited to an xrp cdp keeps it from being liquidated when the xrp price falls
+	id := suite.liquidations.xrp[0]
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[id-1], suite.addrs[id-1], "xrp", cs(c("btc", 10000000)))
+	suite.NoError(err)
+	sk := suite.app.GetSupplyKeeper()
+	acc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	originalXrpCollateral := acc.GetCoins().AmountOf("xrp")
+	suite.setPrice(d("0.2"), "xrp:usd")
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+	suite.True(found)
+	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	seizedXrpCollateral := originalXrpCollateral.Sub(acc.GetCoins().AmountOf("xrp"))
+	xrpLiquidations := int(seizedXrpCollateral.Quo(i(10000000000)).Int64())
+	suite.Equal(len(suite.liquidations.xrp)-1, xrpLiquidations)
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsBasketLiquidationRatio() {
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	pfParams := pfKeeper.GetParams(suite.ctx)
+	pfParams.MaxTWAPWindow = time.Hour * 24
+	pfKeeper.SetParams(suite.ctx, pfParams)
+	params := suite.keeper.GetParams(suite.ctx)
+	for i := range params.CollateralParams {
+		if params.CollateralParams[i].Denom == "xrp" {
+			params.CollateralParams[i].LiquidationTWAPWindow = time.Hour * 2
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	startTime := suite.ctx.BlockTime()
+	suite.setPrice(d("0.25"), "xrp:usd")
+
+	// a btc cdp, with a liquidation ratio of 1.5, that also holds xrp, with a liquidation ratio of 2.0
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], cs(c("btc", 100000000)), cs(c("usdx", 4200000000)))
+	suite.NoError(err)
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "btc", cs(c("xrp", 200000000)))
+	suite.NoError(err)
+
+	// the current xrp price weights the basket liquidation ratio towards xrp's, while the xrp twap keeps the cdp's ratio
+	// close to its btc ratio, which is above the btc liquidation ratio
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(time.Hour))
+	suite.setPrice(d("2500.00"), "xrp:usd")
+	cdp, found := suite.keeper.GetCdpByOwnerAndDenom(suite.ctx, suite.addrs[0], "btc")
+	suite.True(found)
+	collateralizationRatio, err := suite.keeper.CalculateLiquidationCollateralizationRatio(suite.ctx, cdp.Collateral, suite.keeper.CalculateDebt(suite.ctx, cdp), cs())
+	suite.NoError(err)
+	basketLiquidationRatio, err := suite.keeper.CalculateLiquidationRatio(suite.ctx, "btc", cdp.Collateral)
+	suite.NoError(err)
+	suite.True(collateralizationRatio.LT(basketLiquidationRatio))
+	suite.True(collateralizationRatio.GT(d("1.5")))
+
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "btc")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "btc:usd", "btc", p.LiquidationRatio))
+	_, found = suite.keeper.GetCdpByOwnerAndDenom(suite.ctx, suite.addrs[0], "btc")
+	suite.False(found)
+}
+
+func (suite *SeizeTestSuite) setPartialLiquidation(denom string, buffer sdk.Dec) {
+	params := suite.keeper.GetParams(suite.ctx)
+	for i, cp := range params.CollateralParams {
+		if cp.Denom == denom {
+			params.CollateralParams[i].PartialLiquidation = true
+			params.CollateralParams[i].LiquidationBuffer = buffer
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsPartial() {
+	suite.createCdps()
+	suite.setPartialLiquidation("xrp", d("0.1"))
+	suite.setPrice(d("0.2"), "xrp:usd")
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	tpb := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	seizedCollateral := sdk.NewCoins()
+	seizedDebt := sdk.NewCoins()
+	for _, id := range suite.liquidations.xrp {
+		cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+		suite.True(found)
+		debt := suite.keeper.CalculateDebt(suite.ctx, cdp)
+		originalDebt := suite.keeper.CalculateDebt(suite.ctx, suite.cdps[id-1])
+		suite.True(debt.AmountOf("usdx").LT(originalDebt.AmountOf("usdx")))
+		suite.True(cdp.Collateral[0].Amount.LT(suite.cdps[id-1].Collateral[0].Amount))
+		ratio, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, cdp.Collateral, debt, sdk.NewCoins())
+		suite.NoError(err)
+		suite.True(ratio.GTE(d("2.1")))
+		deposits := suite.keeper.GetDeposits(suite.ctx, id)
+		suite.Equal(1, len(deposits))
+		suite.Equal(cdp.Collateral, deposits[0].Amount)
+
+		// the seized collateral is worth the seized debt plus the liquidation penalty, up to rounding
+		cdpSeizedCollateral := suite.cdps[id-1].Collateral.Sub(cdp.Collateral)
+		cdpSeizedDebt := originalDebt.Sub(debt)
+		penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, "xrp", cdpSeizedDebt.AmountOf("usdx"))
+		seizedValue := d("0.2").MulInt(cdpSeizedCollateral.AmountOf("xrp"))
+		suite.True(seizedValue.Sub(sdk.NewDecFromInt(cdpSeizedDebt.AmountOf("usdx").Add(penalty))).Abs().LTE(d("2")),
+			"seized collateral worth %s, seized debt %s plus penalty %s", seizedValue, cdpSeizedDebt, penalty)
+		seizedCollateral = seizedCollateral.Add(cdpSeizedCollateral)
+		seizedDebt = seizedDebt.Add(cdpSeizedDebt)
+	}
+	suite.checkCollateralAuctions(seizedCollateral, seizedDebt)
+	tpa := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx")
+	suite.Equal(tpb.Sub(seizedDebt.AmountOf("usdx")), tpa)
+	// cdps are no longer returned by the index once they are back above the liquidation ratio
+	normalizedRatio := sdk.OneDec().Quo(d("0.2").Quo(p.LiquidationRatio))
+	suite.Equal(0, len(suite.keeper.GetAllCdpsByDenomAndRatio(suite.ctx, "xrp", normalizedRatio)))
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsPartialTWAP() {
+	suite.createCdps()
+	suite.setPartialLiquidation("xrp", d("0.1"))
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	pfParams := pfKeeper.GetParams(suite.ctx)
+	pfParams.MaxTWAPWindow = time.Hour * 24
+	pfKeeper.SetParams(suite.ctx, pfParams)
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].LiquidationTWAPWindow = time.Hour * 2
+	suite.keeper.SetParams(suite.ctx, params)
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	startTime := suite.ctx.BlockTime()
+	suite.setPrice(d("0.2"), "xrp:usd")
+
+	// the current price recovers, but the cdps are still below the liquidation ratio at the twap,
+	// so the collateral seized from them is calculated at the twap
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(time.Hour * 3))
+	suite.setPrice(d("0.25"), "xrp:usd")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	for _, id := range suite.liquidations.xrp {
+		cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+		suite.True(found)
+		suite.True(cdp.Collateral[0].Amount.LT(suite.cdps[id-1].Collateral[0].Amount))
+		ratio, err := suite.keeper.CalculateLiquidationCollateralizationRatio(suite.ctx, cdp.Collateral, suite.keeper.CalculateDebt(suite.ctx, cdp), sdk.NewCoins())
+		suite.NoError(err)
+		suite.True(ratio.GTE(d("2.1")))
+	}
+
+	// a cdp above its liquidation ratio plus the liquidation buffer can't be partially seized
+	checked := false
+	for _, cdp := range suite.cdps {
+		if cdp.Type == "xrp" && suite.keeper.CalculateDebt(suite.ctx, cdp).AmountOf("usdx").LT(i(900000000)) {
+			err := suite.keeper.PartialSeizeCollateral(suite.ctx, cdp)
+			suite.Equal(types.CodeCdpNotLiquidatable, err.Result().Code)
+			checked = true
+			break
+		}
+	}
+	suite.True(checked)
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsPartialBelowDebtFloor() {
+	suite.createCdps()
+	suite.setPartialLiquidation("xrp", d("0.1"))
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[0].DebtFloor = i(900000000)
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.setPrice(d("0.2"), "xrp:usd")
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	// the remaining principal would be below the debt floor, so the whole cdp is seized
+	for _, id := range suite.liquidations.xrp {
+		_, found := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+		suite.False(found)
+	}
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsTWAP() {
+	suite.createCdps()
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	pfParams := pfKeeper.GetParams(suite.ctx)
+	pfParams.MaxTWAPWindow = time.Hour * 24
+	pfKeeper.SetParams(suite.ctx, pfParams)
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].LiquidationTWAPWindow = time.Hour * 2
+	suite.keeper.SetParams(suite.ctx, params)
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	startTime := suite.ctx.BlockTime()
+	suite.setPrice(d("0.25"), "xrp:usd")
+
+	// a drop in the current price does not liquidate cdps until it moves the twap
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(time.Hour))
+	suite.setPrice(d("0.2"), "xrp:usd")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	for _, id := range suite.liquidations.xrp {
+		_, found := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+		suite.True(found)
+	}
+	err := suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[0], "xrp", suite.liquidations.xrp[0])
+	suite.Equal(types.CodeCdpNotLiquidatable, err.Result().Code)
+
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(time.Hour * 3))
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	for _, id := range suite.liquidations.xrp {
+		_, found := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+		suite.False(found)
+	}
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsTWAPUnavailable() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].LiquidationTWAPWindow = time.Hour * 2
+	suite.keeper.SetParams(suite.ctx, params)
+	// the max twap window is lowered below the liquidation twap window, so the current price is used
+	pfKeeper := suite.app.GetPriceFeedKeeper()
+	pfParams := pfKeeper.GetParams(suite.ctx)
+	pfParams.MaxTWAPWindow = time.Hour
+	pfKeeper.SetParams(suite.ctx, pfParams)
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+
+	suite.setPrice(d("0.2"), "xrp:usd")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	for _, id := range suite.liquidations.xrp {
+		_, found := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+		suite.False(found)
+	}
+}
+
+func (suite *SeizeTestSuite) TestAttemptKeeperLiquidation() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].KeeperRewardPercentage = d("0.01")
+	suite.keeper.SetParams(suite.ctx, params)
+	ak := suite.app.GetAccountKeeper()
+	keepers := []sdk.AccAddress{sdk.AccAddress(crypto.AddressHash([]byte("keeper")))}
+	id := suite.liquidations.xrp[0]
+
+	err := suite.keeper.AttemptKeeperLiquidation(suite.ctx, keepers[0], "xrp", id)
+	suite.Equal(types.CodeCdpNotLiquidatable, err.Result().Code)
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keepers[0], "xrp", 1000)
+	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
+
+	suite.setPrice(d("0.2"), "xrp:usd")
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+	debt := suite.keeper.CalculateDebt(suite.ctx, cdp).AmountOf("usdx")
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keepers[0], "xrp", id)
+	suite.NoError(err)
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+	suite.False(found)
+	acc := ak.GetAccount(suite.ctx, keepers[0])
+	suite.Equal(cs(c("xrp", 100000000)), acc.GetCoins())
+	auctionMacc := suite.app.GetSupplyKeeper().GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(i(9900000000), auctionMacc.GetCoins().AmountOf("xrp"))
+
+	// the keeper reward is taken out of the liquidation penalty raised by the auctions
+	maxBid := sdk.ZeroInt()
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		maxBid = maxBid.Add(a.(auction.CollateralAuction).MaxBid.Amount)
+		return false
+	})
+	penalty := params.CollateralParams[0].LiquidationPenalty.Sub(params.CollateralParams[0].KeeperRewardPercentage)
+	suite.Equal(debt.Add(sdk.NewDecFromInt(debt).Mul(penalty).RoundInt()), maxBid)
+}
+
 func (suite *SeizeTestSuite) TestHandleNewDebt() {
 	suite.createCdps()
 	tpb := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx")
 	suite.keeper.HandleNewDebt(suite.ctx, "xrp", "usdx", i(31536000))
 	tpa := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx")
-	suite.Equal(sdk.NewDec(tpb.Int64()).Mul(d("1.05")).TruncateInt().Int64(), tpa.Int64())
+	suite.Equal(sdk.NewDec(tpb.Int64()).Mul(d("1.05")).RoundInt64(), tpa.Int64())
 }
 
 func (suite *SeizeTestSuite) TestApplyLiquidationPenalty() {
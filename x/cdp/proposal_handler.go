@@ -0,0 +1,23 @@
+package cdp
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewProposalHandler handles all cdp governance proposals
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case AddCollateralProposal:
+			return HandleAddCollateralProposal(ctx, k, c)
+		case UpdateCollateralProposal:
+			return HandleUpdateCollateralProposal(ctx, k, c)
+		default:
+			errMsg := fmt.Sprintf("unrecognized cdp proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
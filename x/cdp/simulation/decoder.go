@@ -0,0 +1,73 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding cdp type
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.CdpIDKeyPrefix):
+		var cdpIDsA, cdpIDsB []uint64
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &cdpIDsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &cdpIDsB)
+		return fmt.Sprintf("%v\n%v", cdpIDsA, cdpIDsB)
+	case bytes.Equal(kvA.Key[:1], types.CdpKeyPrefix):
+		var cdpA, cdpB types.CDP
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &cdpA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &cdpB)
+		return fmt.Sprintf("%s\n%s", cdpA, cdpB)
+	case bytes.Equal(kvA.Key[:1], types.CollateralRatioIndexPrefix),
+		bytes.Equal(kvA.Key[:1], types.CdpIDKey):
+		idA := types.GetCdpIDFromBytes(kvA.Value)
+		idB := types.GetCdpIDFromBytes(kvB.Value)
+		return fmt.Sprintf("%d\n%d", idA, idB)
+	case bytes.Equal(kvA.Key[:1], types.DebtDenomKey),
+		bytes.Equal(kvA.Key[:1], types.GovDenomKey):
+		var denomA, denomB string
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &denomA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &denomB)
+		return fmt.Sprintf("%s\n%s", denomA, denomB)
+	case bytes.Equal(kvA.Key[:1], types.DepositKeyPrefix):
+		var depositA, depositB types.Deposit
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &depositA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &depositB)
+		return fmt.Sprintf("%s\n%s", depositA, depositB)
+	case bytes.Equal(kvA.Key[:1], types.PrincipalKeyPrefix):
+		var totalA, totalB sdk.Dec
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &totalA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &totalB)
+		return fmt.Sprintf("%s\n%s", totalA, totalB)
+	case bytes.Equal(kvA.Key[:1], types.PreviousBlockTimeKey):
+		var timeA, timeB time.Time
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &timeA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &timeB)
+		return fmt.Sprintf("%s\n%s", timeA, timeB)
+	case bytes.Equal(kvA.Key[:1], types.SavingsDepositKeyPrefix):
+		var depositA, depositB types.SavingsDeposit
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &depositA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &depositB)
+		return fmt.Sprintf("%s\n%s", depositA, depositB)
+	case bytes.Equal(kvA.Key[:1], types.TotalSavingsSharesKey):
+		var sharesA, sharesB sdk.Coins
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &sharesA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &sharesB)
+		return fmt.Sprintf("%s\n%s", sharesA, sharesB)
+	case bytes.Equal(kvA.Key[:1], types.InterestFactorKeyPrefix):
+		var factorA, factorB types.InterestFactor
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &factorA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &factorB)
+		return fmt.Sprintf("%s\n%s", factorA, factorB)
+	default:
+		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+	}
+}
@@ -0,0 +1,223 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// Simulation parameter constants
+const (
+	CollateralParams        = "collateral_params"
+	DebtParams              = "debt_params"
+	SurplusAuctionThreshold = "surplus_auction_threshold"
+	DebtAuctionThreshold    = "debt_auction_threshold"
+	LiquidationInterval     = "liquidation_block_interval"
+	SavingsRate             = "savings_rate"
+)
+
+// simulated collateral types, their pricefeed markets and the range of whole tokens given to each simulation account and sold in each auction
+var collaterals = []struct {
+	denom            string
+	marketID         string
+	prefix           byte
+	conversionFactor int64
+	minBalance       int64
+	maxBalance       int64
+	minAuctionSize   int64
+	maxAuctionSize   int64
+}{
+	{"btc", "btc:usd", 0x00, 8, 1, 100, 1, 10},
+	{"xrp", "xrp:usd", 0x01, 6, 1000, 1000000, 10000, 100000},
+}
+
+// simulated debt asset
+const (
+	debtDenom            = "usdx"
+	debtReferenceAsset   = "usd"
+	debtConversionFactor = 6
+)
+
+// GenCollateralParams randomized CollateralParams
+func GenCollateralParams(r *rand.Rand) types.CollateralParams {
+	var collateralParams types.CollateralParams
+	for _, c := range collaterals {
+		penalty := sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 21)), 2)
+		stabilityFee := sdk.OneDec().Add(sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 3000000001)), 18))
+		collateralParams = append(collateralParams, types.CollateralParam{
+			Denom:              c.denom,
+			LiquidationRatio:   sdk.OneDec().Add(sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 10, 101)), 2)),
+			DebtLimit:          sdk.NewCoins(sdk.NewCoin(debtDenom, wholeTokens(int64(simulation.RandIntBetween(r, 1000000, 10000001)), debtConversionFactor))),
+			StabilityFee:       stabilityFee,
+			AuctionSize:        wholeTokens(int64(simulation.RandIntBetween(r, int(c.minAuctionSize), int(c.maxAuctionSize)+1)), c.conversionFactor),
+			LiquidationPenalty: penalty,
+			Prefix:             c.prefix,
+			MarketID:           c.marketID,
+			ConversionFactor:   sdk.NewInt(c.conversionFactor),
+			PartialLiquidation: r.Intn(2) == 0,
+			LiquidationBuffer:  sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 51)), 2),
+			// keepers are rewarded with up to the whole liquidation penalty
+			KeeperRewardPercentage: penalty.MulInt64(int64(simulation.RandIntBetween(r, 0, 101))).QuoInt64(100),
+			LiquidationTWAPWindow:  GenLiquidationTWAPWindow(r),
+			InterestRateModel:      GenInterestRateModel(r, stabilityFee),
+			DutchAuction:           r.Intn(2) == 0,
+		})
+	}
+	return collateralParams
+}
+
+// GenLiquidationTWAPWindow randomized liquidation twap window, within the pricefeed's default max twap window
+func GenLiquidationTWAPWindow(r *rand.Rand) time.Duration {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return time.Hour * time.Duration(simulation.RandIntBetween(r, 1, 13))
+}
+
+// GenInterestRateModel randomized InterestRateModel, with fees rising from the stability fee by up to ~10% apr
+func GenInterestRateModel(r *rand.Rand, stabilityFee sdk.Dec) types.InterestRateModel {
+	switch r.Intn(3) {
+	case 0:
+		maxFee := stabilityFee.Add(sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 3000000001)), 18))
+		return types.NewInterestRateModel(types.RateModelUtilization, maxFee, sdk.ZeroDec(), sdk.ZeroDec())
+	case 1:
+		kink := sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 100)), 2)
+		kinkFee := stabilityFee.Add(sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 1000000001)), 18))
+		maxFee := kinkFee.Add(sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 3000000001)), 18))
+		return types.NewInterestRateModel(types.RateModelKink, maxFee, kink, kinkFee)
+	default:
+		return types.NewInterestRateModel(types.RateModelFlat, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	}
+}
+
+// GenDebtParams randomized DebtParams
+func GenDebtParams(r *rand.Rand) types.DebtParams {
+	return types.DebtParams{
+		{
+			Denom:            debtDenom,
+			ReferenceAsset:   debtReferenceAsset,
+			ConversionFactor: sdk.NewInt(debtConversionFactor),
+			DebtFloor:        wholeTokens(int64(simulation.RandIntBetween(r, 1, 11)), debtConversionFactor),
+		},
+	}
+}
+
+// GenSurplusAuctionThreshold randomized SurplusAuctionThreshold
+func GenSurplusAuctionThreshold(r *rand.Rand) sdk.Int {
+	return wholeTokens(int64(simulation.RandIntBetween(r, 1, 1001)), debtConversionFactor)
+}
+
+// GenDebtAuctionThreshold randomized DebtAuctionThreshold
+func GenDebtAuctionThreshold(r *rand.Rand) sdk.Int {
+	return wholeTokens(int64(simulation.RandIntBetween(r, 1, 1001)), debtConversionFactor)
+}
+
+// GenLiquidationInterval randomized LiquidationBlockInterval
+func GenLiquidationInterval(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 1, 6))
+}
+
+// GenSavingsRate randomized SavingsRate
+func GenSavingsRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 101)), 2)
+}
+
+// RandomizedGenState generates a random GenesisState for cdp. Every simulation account is given
+// a random balance of each collateral type, so the supply genesis state must already have been generated.
+func RandomizedGenState(simState *module.SimulationState) {
+	var collateralParams types.CollateralParams
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CollateralParams, &collateralParams, simState.Rand,
+		func(r *rand.Rand) { collateralParams = GenCollateralParams(r) },
+	)
+
+	var debtParams types.DebtParams
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DebtParams, &debtParams, simState.Rand,
+		func(r *rand.Rand) { debtParams = GenDebtParams(r) },
+	)
+
+	var surplusAuctionThreshold sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SurplusAuctionThreshold, &surplusAuctionThreshold, simState.Rand,
+		func(r *rand.Rand) { surplusAuctionThreshold = GenSurplusAuctionThreshold(r) },
+	)
+
+	var debtAuctionThreshold sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DebtAuctionThreshold, &debtAuctionThreshold, simState.Rand,
+		func(r *rand.Rand) { debtAuctionThreshold = GenDebtAuctionThreshold(r) },
+	)
+
+	var liquidationInterval int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, LiquidationInterval, &liquidationInterval, simState.Rand,
+		func(r *rand.Rand) { liquidationInterval = GenLiquidationInterval(r) },
+	)
+
+	var savingsRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SavingsRate, &savingsRate, simState.Rand,
+		func(r *rand.Rand) { savingsRate = GenSavingsRate(r) },
+	)
+
+	// the global debt limit covers the debt limits of all collateral types
+	globalDebtLimit := sdk.NewCoins()
+	for _, cp := range collateralParams {
+		globalDebtLimit = globalDebtLimit.Add(cp.DebtLimit)
+	}
+
+	cdpGenesis := types.DefaultGenesisState()
+	cdpGenesis.Params = types.NewParams(globalDebtLimit, collateralParams, debtParams, surplusAuctionThreshold, debtAuctionThreshold, false, liquidationInterval, savingsRate)
+	// surplus auctions are bid on in the bond denom, which all simulation accounts hold
+	cdpGenesis.GovDenom = sdk.DefaultBondDenom
+
+	fmt.Printf("Selected randomly generated cdp parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, cdpGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(cdpGenesis)
+
+	addCollateralToAccounts(simState)
+}
+
+// addCollateralToAccounts gives every genesis account a random balance of each collateral type
+// and increases the total supply accordingly
+func addCollateralToAccounts(simState *module.SimulationState) {
+	var authGenState authtypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[authtypes.ModuleName], &authGenState)
+
+	addedCoins := sdk.NewCoins()
+	var newGenesisAccs authexported.GenesisAccounts
+	for _, acc := range authGenState.Accounts {
+		var coins sdk.Coins
+		for _, c := range collaterals {
+			amount := int64(simulation.RandIntBetween(simState.Rand, int(c.minBalance), int(c.maxBalance)+1))
+			coins = coins.Add(sdk.NewCoins(sdk.NewCoin(c.denom, wholeTokens(amount, c.conversionFactor))))
+		}
+		if err := acc.SetCoins(acc.GetCoins().Add(coins)); err != nil {
+			panic(err)
+		}
+		addedCoins = addedCoins.Add(coins)
+		newGenesisAccs = append(newGenesisAccs, acc)
+	}
+	newAuthGenesis := authtypes.NewGenesisState(authGenState.Params, newGenesisAccs)
+	simState.GenState[authtypes.ModuleName] = simState.Cdc.MustMarshalJSON(newAuthGenesis)
+
+	var supplyGenState supply.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[supply.ModuleName], &supplyGenState)
+	supplyGenState.Supply = supplyGenState.Supply.Add(addedCoins)
+	simState.GenState[supply.ModuleName] = simState.Cdc.MustMarshalJSON(supplyGenState)
+}
+
+// wholeTokens converts an amount of whole tokens into the smallest units of a coin with the input conversion factor
+func wholeTokens(amount int64, conversionFactor int64) sdk.Int {
+	return sdk.NewInt(amount).Mul(sdk.NewIntWithDecimal(1, int(conversionFactor)))
+}
@@ -0,0 +1,358 @@
+package operations
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+// SimulateMsgCreateCDP generates a MsgCreateCDP from a random account for a random collateral type,
+// drawing a random amount of principal that keeps the new cdp above the liquidation ratio
+func SimulateMsgCreateCDP(ak auth.AccountKeeper, k cdp.Keeper, pfk pricefeed.Keeper) simulation.Operation {
+	handler := cdp.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		params := k.GetParams(ctx)
+		if len(params.CollateralParams) == 0 || len(params.DebtParams) == 0 {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		cp := params.CollateralParams[r.Intn(len(params.CollateralParams))]
+		dp := params.DebtParams[r.Intn(len(params.DebtParams))]
+
+		owner := simulation.RandomAcc(r, accs)
+		if _, found := k.GetCdpByOwnerAndDenom(ctx, owner.Address, cp.Denom); found {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		// new debt can't be drawn against stale prices
+		price, sdkErr := pfk.GetCurrentPrice(ctx, cp.MarketID)
+		if sdkErr != nil || price.IsStale() {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+
+		balance := ak.GetAccount(ctx, owner.Address).SpendableCoins(ctx.BlockTime()).AmountOf(cp.Denom)
+		collateralAmount, goErr := simulation.RandPositiveInt(r, balance)
+		if goErr != nil {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+
+		maxPrincipal := collateralValue(collateralAmount, cp, dp, price.Price).Quo(cp.LiquidationRatio).TruncateInt()
+		if maxPrincipal.LT(dp.DebtFloor) {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		principalAmount := dp.DebtFloor.Add(simulation.RandomAmount(r, maxPrincipal.Sub(dp.DebtFloor)))
+
+		msg := cdp.NewMsgCreateCDP(owner.Address, sdk.NewCoins(sdk.NewCoin(cp.Denom, collateralAmount)), sdk.NewCoins(sdk.NewCoin(dp.Denom, principalAmount)))
+		return deliverMsg(handler, ctx, msg)
+	}
+}
+
+// SimulateMsgDeposit generates a MsgDeposit of a random amount of a random collateral type from a random account to a random cdp
+func SimulateMsgDeposit(ak auth.AccountKeeper, k cdp.Keeper) simulation.Operation {
+	handler := cdp.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		c, found := randomCdp(r, ctx, k)
+		if !found {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		// cdps are mostly topped up with their own collateral type, but any supported collateral can be deposited
+		denom := c.Type
+		if collateralParams := k.GetParams(ctx).CollateralParams; r.Intn(2) == 0 && len(collateralParams) > 0 {
+			denom = collateralParams[r.Intn(len(collateralParams))].Denom
+		}
+
+		depositor := simulation.RandomAcc(r, accs)
+		balance := ak.GetAccount(ctx, depositor.Address).SpendableCoins(ctx.BlockTime()).AmountOf(denom)
+		amount, goErr := simulation.RandPositiveInt(r, balance)
+		if goErr != nil {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+
+		msg := cdp.NewMsgDeposit(c.Owner, depositor.Address, c.Type, sdk.NewCoins(sdk.NewCoin(denom, amount)))
+		return deliverMsg(handler, ctx, msg)
+	}
+}
+
+// SimulateMsgWithdraw generates a MsgWithdraw of a random part of a random collateral type of a random deposit,
+// leaving enough collateral in the cdp to keep it above the liquidation ratio
+func SimulateMsgWithdraw(k cdp.Keeper, pfk pricefeed.Keeper) simulation.Operation {
+	handler := cdp.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		c, found := randomCdp(r, ctx, k)
+		if !found {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		deposits := k.GetDeposits(ctx, c.ID)
+		if len(deposits) == 0 {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		deposit := deposits[r.Intn(len(deposits))]
+		if deposit.Amount.Empty() {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		denom := deposit.Amount[r.Intn(len(deposit.Amount))].Denom
+		// collateral can't be withdrawn against stale prices
+		cdpDebt := k.CalculateDebt(ctx, c)
+		if k.ValidatePricesNotStale(ctx, c.Collateral, cdpDebt) != nil {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+
+		cp, found := k.GetCollateral(ctx, denom)
+		if !found {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		dp, found := randomDebtParam(r, ctx, k, cdpDebt)
+		if !found {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		price, sdkErr := pfk.GetCurrentPrice(ctx, cp.MarketID)
+		if sdkErr != nil {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		maxDebt, sdkErr := maxCdpDebt(ctx, k, pfk, c, dp)
+		if sdkErr != nil {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+
+		// each unit of collateral withdrawn reduces the debt the cdp can hold by its value over its liquidation ratio
+		debt := cdpDebt.AmountOf(dp.Denom)
+		unitDebt := collateralValue(sdk.OneInt(), cp, dp, price.Price).Quo(cp.LiquidationRatio)
+		if !maxDebt.GT(sdk.NewDecFromInt(debt)) || !unitDebt.IsPositive() {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		maxWithdraw := sdk.MinInt(deposit.Amount.AmountOf(denom), maxDebt.Sub(sdk.NewDecFromInt(debt)).Quo(unitDebt).TruncateInt())
+		amount, goErr := simulation.RandPositiveInt(r, maxWithdraw)
+		if goErr != nil {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+
+		msg := cdp.NewMsgWithdraw(c.Owner, deposit.Depositor, c.Type, sdk.NewCoins(sdk.NewCoin(denom, amount)))
+		return deliverMsg(handler, ctx, msg)
+	}
+}
+
+// SimulateMsgDrawDebt generates a MsgDrawDebt for a random cdp, drawing a random amount of principal
+// that keeps the cdp above the liquidation ratio and within the debt limit
+func SimulateMsgDrawDebt(k cdp.Keeper, pfk pricefeed.Keeper) simulation.Operation {
+	handler := cdp.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		c, found := randomCdp(r, ctx, k)
+		if !found {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		cdpDebt := k.CalculateDebt(ctx, c)
+		dp, found := randomDebtParam(r, ctx, k, cdpDebt)
+		if !found {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		if k.ValidatePricesNotStale(ctx, c.Collateral, cdpDebt) != nil {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		maxDebt, sdkErr := maxCdpDebt(ctx, k, pfk, c, dp)
+		if sdkErr != nil {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+
+		debt := cdpDebt.AmountOf(dp.Denom)
+		availableDebt := k.GetParams(ctx).GlobalDebtLimit.AmountOf(dp.Denom).Sub(k.GetTotalPrincipal(ctx, c.Type, dp.Denom))
+		amount, goErr := simulation.RandPositiveInt(r, sdk.MinInt(maxDebt.TruncateInt().Sub(debt), availableDebt))
+		if goErr != nil {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+
+		msg := cdp.NewMsgDrawDebt(c.Owner, c.Type, sdk.NewCoins(sdk.NewCoin(dp.Denom, amount)))
+		return deliverMsg(handler, ctx, msg)
+	}
+}
+
+// SimulateMsgRepayDebt generates a MsgRepayDebt for a random cdp, either repaying all of its debt
+// or a random part of it that leaves the remaining debt above the debt floor
+func SimulateMsgRepayDebt(ak auth.AccountKeeper, k cdp.Keeper) simulation.Operation {
+	handler := cdp.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		c, found := randomCdp(r, ctx, k)
+		if !found {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		cdpDebt := k.CalculateDebt(ctx, c)
+		dp, found := randomDebtParam(r, ctx, k, cdpDebt)
+		if !found {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		balance := ak.GetAccount(ctx, c.Owner).SpendableCoins(ctx.BlockTime()).AmountOf(dp.Denom)
+
+		// payments larger than the debt only repay the debt, so paying the full balance closes the cdp
+		var amount sdk.Int
+		debt := cdpDebt.AmountOf(dp.Denom)
+		if balance.GT(debt) && r.Intn(2) == 0 {
+			amount = balance
+		} else {
+			var goErr error
+			amount, goErr = simulation.RandPositiveInt(r, sdk.MinInt(balance, debt.Sub(dp.DebtFloor)))
+			if goErr != nil {
+				return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+			}
+		}
+
+		msg := cdp.NewMsgRepayDebt(c.Owner, c.Type, sdk.NewCoins(sdk.NewCoin(dp.Denom, amount)))
+		return deliverMsg(handler, ctx, msg)
+	}
+}
+
+// SimulateMsgLiquidate generates a MsgLiquidate from a random account for a random cdp that is below its liquidation ratio
+func SimulateMsgLiquidate(k cdp.Keeper) simulation.Operation {
+	handler := cdp.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		var liquidatable cdp.CDPs
+		for _, c := range k.GetAllCdps(ctx) {
+			collateralizationRatio, sdkErr := k.CalculateLiquidationCollateralizationRatio(ctx, c.Collateral, k.CalculateDebt(ctx, c), sdk.NewCoins())
+			if sdkErr != nil {
+				continue
+			}
+			liquidationRatio, sdkErr := k.CalculateLiquidationRatio(ctx, c.Type, c.Collateral)
+			if sdkErr != nil {
+				continue
+			}
+			if collateralizationRatio.LT(liquidationRatio) {
+				liquidatable = append(liquidatable, c)
+			}
+		}
+		if len(liquidatable) == 0 {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		c := liquidatable[r.Intn(len(liquidatable))]
+
+		keeper := simulation.RandomAcc(r, accs)
+		msg := cdp.NewMsgLiquidate(keeper.Address, c.Type, c.ID)
+		return deliverMsg(handler, ctx, msg)
+	}
+}
+
+// SimulateMsgDepositSavings generates a MsgDepositSavings from the owner of a random cdp,
+// depositing a random part of their balance of a random one of its debt assets
+func SimulateMsgDepositSavings(ak auth.AccountKeeper, k cdp.Keeper) simulation.Operation {
+	handler := cdp.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		// cdp owners are the accounts that hold debt assets
+		c, found := randomCdp(r, ctx, k)
+		if !found {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		dp, found := randomDebtParam(r, ctx, k, k.CalculateDebt(ctx, c))
+		if !found {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		denom := dp.Denom
+		balance := ak.GetAccount(ctx, c.Owner).SpendableCoins(ctx.BlockTime()).AmountOf(denom)
+		amount, goErr := simulation.RandPositiveInt(r, balance)
+		if goErr != nil {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+
+		msg := cdp.NewMsgDepositSavings(c.Owner, sdk.NewCoins(sdk.NewCoin(denom, amount)))
+		return deliverMsg(handler, ctx, msg)
+	}
+}
+
+// SimulateMsgWithdrawSavings generates a MsgWithdrawSavings for a random savings deposit,
+// withdrawing a random part of its current balance
+func SimulateMsgWithdrawSavings(k cdp.Keeper) simulation.Operation {
+	handler := cdp.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		deposits := k.GetAllSavingsDeposits(ctx)
+		if len(deposits) == 0 {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		deposit := deposits[r.Intn(len(deposits))]
+		balance := k.GetSavingsBalance(ctx, deposit)
+		if balance.Empty() {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+		coin := balance[r.Intn(len(balance))]
+		amount, goErr := simulation.RandPositiveInt(r, coin.Amount)
+		if goErr != nil {
+			return simulation.NoOpMsg(cdp.ModuleName), nil, nil
+		}
+
+		msg := cdp.NewMsgWithdrawSavings(deposit.Depositor, sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)))
+		return deliverMsg(handler, ctx, msg)
+	}
+}
+
+// randomCdp returns a random cdp from the store
+func randomCdp(r *rand.Rand, ctx sdk.Context, k cdp.Keeper) (cdp.CDP, bool) {
+	cdps := k.GetAllCdps(ctx)
+	if len(cdps) == 0 {
+		return cdp.CDP{}, false
+	}
+	return cdps[r.Intn(len(cdps))], true
+}
+
+// randomDebtParam returns the debt param of a random denom of the input cdp debt
+func randomDebtParam(r *rand.Rand, ctx sdk.Context, k cdp.Keeper, debt sdk.Coins) (cdp.DebtParam, bool) {
+	if debt.Empty() {
+		return cdp.DebtParam{}, false
+	}
+	return k.GetDebtParam(ctx, debt[r.Intn(len(debt))].Denom)
+}
+
+// collateralValue returns the value of an amount of collateral in units of the debt asset
+func collateralValue(amount sdk.Int, cp cdp.CollateralParam, dp cdp.DebtParam, price sdk.Dec) sdk.Dec {
+	return sdk.NewDecFromIntWithPrec(amount, cp.ConversionFactor.Int64()).
+		Mul(price).
+		MulInt(sdk.NewIntWithDecimal(1, int(dp.ConversionFactor.Int64())))
+}
+
+// maxCdpDebt returns the largest debt the collateral of a cdp can hold, the sum of the value of each of its collateral types
+// divided by that collateral type's liquidation ratio
+func maxCdpDebt(ctx sdk.Context, k cdp.Keeper, pfk pricefeed.Keeper, c cdp.CDP, dp cdp.DebtParam) (sdk.Dec, sdk.Error) {
+	maxDebt := sdk.ZeroDec()
+	for _, cc := range c.Collateral {
+		cp, found := k.GetCollateral(ctx, cc.Denom)
+		if !found {
+			return sdk.Dec{}, cdp.ErrCollateralNotSupported(cdp.DefaultCodespace, cc.Denom)
+		}
+		price, err := pfk.GetCurrentPrice(ctx, cp.MarketID)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		maxDebt = maxDebt.Add(collateralValue(cc.Amount, cp, dp, price.Price).Quo(cp.LiquidationRatio))
+	}
+	return maxDebt, nil
+}
+
+// deliverMsg validates the msg and runs it through the handler, only committing state changes if it succeeds
+func deliverMsg(handler sdk.Handler, ctx sdk.Context, msg sdk.Msg) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+	if msg.ValidateBasic() != nil {
+		return simulation.NoOpMsg(cdp.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+	}
+
+	ctx, write := ctx.CacheContext()
+	ok := handler(ctx, msg).IsOK()
+	if ok {
+		write()
+	}
+
+	return simulation.NewOperationMsg(msg, ok, ""), nil, nil
+}
@@ -0,0 +1,32 @@
+package operations
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govsimops "github.com/cosmos/cosmos-sdk/x/gov/simulation/operations"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/cdp"
+	cdpsim "github.com/kava-labs/kava/x/cdp/simulation"
+)
+
+// SimulateCollateralProposalContent generates a proposal updating the liquidation ratio, stability fee and interest rate model of a random collateral type
+func SimulateCollateralProposalContent(k cdp.Keeper) govsimops.ContentSimulator {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simulation.Account) govtypes.Content {
+		title := simulation.RandStringOfLength(r, 10)
+		description := simulation.RandStringOfLength(r, 100)
+
+		cps := k.GetParams(ctx).CollateralParams
+		if len(cps) == 0 {
+			return govsimops.SimulateTextProposalContent(r, ctx, accs)
+		}
+		cp := cps[r.Intn(len(cps))]
+		// liquidation ratio between 1.1 and 3.0, stability fee between 0 and ~10% apr
+		cp.LiquidationRatio = sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 110, 301)), 2)
+		cp.StabilityFee = sdk.OneDec().Add(sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 3000000000)), 18))
+		cp.InterestRateModel = cdpsim.GenInterestRateModel(r, cp.StabilityFee)
+		return cdp.NewUpdateCollateralProposal(title, description, cp)
+	}
+}
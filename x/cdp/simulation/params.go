@@ -0,0 +1,52 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+const (
+	keySurplusThreshold    = "SurplusThreshold"
+	keyDebtThreshold       = "DebtThreshold"
+	keyCircuitBreaker      = "CircuitBreaker"
+	keyLiquidationInterval = "LiquidationBlockInterval"
+	keySavingsRate         = "SavingsRate"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation. Collateral and debt params are not changed, as their debt limits
+// must stay within the global debt limit chosen at genesis.
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keySurplusThreshold, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSurplusAuctionThreshold(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyDebtThreshold, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenDebtAuctionThreshold(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyCircuitBreaker, "",
+			func(r *rand.Rand) string {
+				// the circuit breaker halts most cdp operations, so only trip it occasionally
+				return fmt.Sprintf("%t", r.Intn(20) == 0)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyLiquidationInterval, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenLiquidationInterval(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keySavingsRate, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSavingsRate(r))
+			},
+		),
+	}
+}
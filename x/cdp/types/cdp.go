@@ -3,31 +3,29 @@ package types
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // CDP is the state of a single collateralized debt position.
+// Its debt is stored normalized by the interest factor of its collateral type, so fees accrue without the cdp being updated:
+// the debt of each debt denom is the normalized principal multiplied by the current interest factor.
 type CDP struct {
-	ID              uint64         `json:"id" yaml:"id"`                 // unique id for cdp
-	Owner           sdk.AccAddress `json:"owner" yaml:"owner"`           // Account that authorizes changes to the CDP
-	Collateral      sdk.Coins      `json:"collateral" yaml:"collateral"` // Amount of collateral stored in this CDP
-	Principal       sdk.Coins      `json:"principal" yaml:"principal"`
-	AccumulatedFees sdk.Coins      `json:"accumulated_fees" yaml:"accumulated_fees"`
-	FeesUpdated     time.Time      `json:"fees_updated" yaml:"fees_updated"` // Amount of stable coin drawn from this CDP
+	ID                  uint64         `json:"id" yaml:"id"`                                     // unique id for cdp
+	Owner               sdk.AccAddress `json:"owner" yaml:"owner"`                               // Account that authorizes changes to the CDP
+	Type                string         `json:"type" yaml:"type"`                                 // Collateral type of the CDP, which sets its stability fee, debt limit and store prefix
+	Collateral          sdk.Coins      `json:"collateral" yaml:"collateral"`                     // Amount of collateral stored in this CDP, which may include other supported collateral assets
+	NormalizedPrincipal sdk.DecCoins   `json:"normalized_principal" yaml:"normalized_principal"` // Debt of each debt denom divided by the interest factor of the collateral type
 }
 
-// NewCDP creates a new CDP object
-func NewCDP(id uint64, owner sdk.AccAddress, collateral sdk.Coins, principal sdk.Coins, time time.Time) CDP {
-	var fees sdk.Coins
+// NewCDP creates a new CDP object, whose collateral type is the denom of its initial collateral
+func NewCDP(id uint64, owner sdk.AccAddress, collateral sdk.Coins, normalizedPrincipal sdk.DecCoins) CDP {
 	return CDP{
-		ID:              id,
-		Owner:           owner,
-		Collateral:      collateral,
-		Principal:       principal,
-		AccumulatedFees: fees,
-		FeesUpdated:     time,
+		ID:                  id,
+		Owner:               owner,
+		Type:                collateral[0].Denom,
+		Collateral:          collateral,
+		NormalizedPrincipal: normalizedPrincipal,
 	}
 }
 
@@ -38,19 +36,25 @@ func (cdp CDP) String() string {
 	ID: %d
 	Collateral Type: %s
 	Collateral: %s
-	Principal: %s
-	Fees: %s
-	Fees Last Updated: %s`,
+	Normalized Principal: %s`,
 		cdp.Owner,
 		cdp.ID,
-		cdp.Collateral[0].Denom,
+		cdp.Type,
 		cdp.Collateral,
-		cdp.Principal,
-		cdp.AccumulatedFees,
-		cdp.FeesUpdated,
+		cdp.NormalizedPrincipal,
 	))
 }
 
+// HoldsOtherCollateral returns whether the cdp holds collateral of any type other than its own collateral type
+func (cdp CDP) HoldsOtherCollateral() bool {
+	for _, c := range cdp.Collateral {
+		if c.Denom != cdp.Type {
+			return true
+		}
+	}
+	return false
+}
+
 // CDPs a collection of CDP objects
 type CDPs []CDP
 
@@ -66,21 +70,22 @@ func (cdps CDPs) String() string {
 // AugmentedCDP provides additional information about an active CDP
 type AugmentedCDP struct {
 	CDP                    `json:"cdp" yaml:"cdp"`
-	CollateralValue        sdk.Coin `json:"collateral_value" yaml:"collateral_value"`               // collateral's market value in debt coin
-	CollateralizationRatio sdk.Dec  `json:"collateralization_ratio" yaml:"collateralization_ratio"` // current collateralization ratio
+	Debt                   sdk.Coins `json:"debt" yaml:"debt"`                                       // current debt, principal plus fees, of each debt denom
+	CollateralValue        sdk.Coins `json:"collateral_value" yaml:"collateral_value"`               // collateral's market value in each debt denom
+	CollateralizationRatio sdk.Dec   `json:"collateralization_ratio" yaml:"collateralization_ratio"` // current collateralization ratio
 }
 
 // NewAugmentedCDP creates a new AugmentedCDP object
-func NewAugmentedCDP(cdp CDP, collateralValue sdk.Coin, collateralizationRatio sdk.Dec) AugmentedCDP {
+func NewAugmentedCDP(cdp CDP, debt sdk.Coins, collateralValue sdk.Coins, collateralizationRatio sdk.Dec) AugmentedCDP {
 	augmentedCDP := AugmentedCDP{
 		CDP: CDP{
-			ID:              cdp.ID,
-			Owner:           cdp.Owner,
-			Collateral:      cdp.Collateral,
-			Principal:       cdp.Principal,
-			AccumulatedFees: cdp.AccumulatedFees,
-			FeesUpdated:     cdp.FeesUpdated,
+			ID:                  cdp.ID,
+			Owner:               cdp.Owner,
+			Type:                cdp.Type,
+			Collateral:          cdp.Collateral,
+			NormalizedPrincipal: cdp.NormalizedPrincipal,
 		},
+		Debt:                   debt,
 		CollateralValue:        collateralValue,
 		CollateralizationRatio: collateralizationRatio,
 	}
@@ -95,18 +100,14 @@ func (augCDP AugmentedCDP) String() string {
 	Collateral Type: %s
 	Collateral: %s
 	Collateral Value: %s
-	Principal: %s
-	Fees: %s
-	Fees Last Updated: %s
+	Debt: %s
 	Collateralization ratio: %s`,
 		augCDP.Owner,
 		augCDP.ID,
-		augCDP.Collateral[0].Denom,
+		augCDP.Type,
 		augCDP.Collateral,
 		augCDP.CollateralValue,
-		augCDP.Principal,
-		augCDP.AccumulatedFees,
-		augCDP.FeesUpdated,
+		augCDP.Debt,
 		augCDP.CollateralizationRatio,
 	))
 }
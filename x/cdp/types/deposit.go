@@ -51,13 +51,23 @@ func (d Deposit) Empty() bool {
 	return d.Equals(Deposit{})
 }
 
-// SumCollateral returns the total amount of collateral in the input deposits
-func (ds Deposits) SumCollateral() (sum sdk.Int) {
-	sum = sdk.ZeroInt()
+// SumCollateral returns the total amount of collateral of each denom in the input deposits
+func (ds Deposits) SumCollateral() (sum sdk.Coins) {
+	sum = sdk.NewCoins()
 	for _, d := range ds {
-		if !d.Amount.IsZero() {
-			sum = sum.Add(d.Amount[0].Amount)
-		}
+		sum = sum.Add(d.Amount)
 	}
 	return
 }
+
+// FilterDenom returns the deposits that hold collateral of the input denom, with their amounts limited to that denom
+func (ds Deposits) FilterDenom(denom string) Deposits {
+	var filtered Deposits
+	for _, d := range ds {
+		amount := d.Amount.AmountOf(denom)
+		if amount.IsPositive() {
+			filtered = append(filtered, NewDeposit(d.CdpID, d.Depositor, sdk.NewCoins(sdk.NewCoin(denom, amount))))
+		}
+	}
+	return filtered
+}
@@ -3,6 +3,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,6 +28,16 @@ const (
 	CodeBelowDebtFloor          sdk.CodeType      = 15
 	CodePaymentExceedsDebt      sdk.CodeType      = 16
 	CodeLoadingAugmentedCDP     sdk.CodeType      = 17
+	CodeCircuitBreakerTripped   sdk.CodeType      = 18
+	CodeCdpNotLiquidatable      sdk.CodeType      = 19
+	CodePriceStale              sdk.CodeType      = 20
+	CodeCollateralExists        sdk.CodeType      = 21
+	CodeMarketNotFound          sdk.CodeType      = 22
+	CodeInvalidProposal         sdk.CodeType      = 23
+	CodeSavingsDepositNotFound  sdk.CodeType      = 24
+	CodeInsufficientSavings     sdk.CodeType      = 25
+	CodeInvalidSavingsAmount    sdk.CodeType      = 26
+	CodeMarketInUse             sdk.CodeType      = 27
 )
 
 // ErrCdpAlreadyExists error for duplicate cdps
@@ -36,7 +47,7 @@ func ErrCdpAlreadyExists(codespace sdk.CodespaceType, owner sdk.AccAddress, deno
 
 // ErrInvalidCollateralLength error for invalid collateral input length
 func ErrInvalidCollateralLength(codespace sdk.CodespaceType, length int) sdk.Error {
-	return sdk.NewError(codespace, CodeCollateralLengthInvalid, fmt.Sprintf("only one collateral type per cdp, has %d", length))
+	return sdk.NewError(codespace, CodeCollateralLengthInvalid, fmt.Sprintf("invalid number of collateral types: %d", length))
 }
 
 // ErrCollateralNotSupported error for unsupported collateral
@@ -113,3 +124,58 @@ func ErrPaymentExceedsDebt(codespace sdk.CodespaceType, payment sdk.Coins, princ
 func ErrLoadingAugmentedCDP(codespace sdk.CodespaceType, cdpID uint64) sdk.Error {
 	return sdk.NewError(codespace, CodeCdpNotFound, fmt.Sprintf("augmented cdp could not be loaded from cdp id %d", cdpID))
 }
+
+// ErrCircuitBreakerTripped error for actions that are disabled while the circuit breaker is tripped
+func ErrCircuitBreakerTripped(codespace sdk.CodespaceType, action string) sdk.Error {
+	return sdk.NewError(codespace, CodeCircuitBreakerTripped, fmt.Sprintf("cannot %s, cdp circuit breaker is tripped", action))
+}
+
+// ErrCdpIDNotFound error for cdp not found by id
+func ErrCdpIDNotFound(codespace sdk.CodespaceType, denom string, cdpID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeCdpNotFound, fmt.Sprintf("cdp %d for collateral %s not found", cdpID, denom))
+}
+
+// ErrCdpNotLiquidatable error for liquidating a cdp that is not below its liquidation ratio
+func ErrCdpNotLiquidatable(codespace sdk.CodespaceType, cdpID uint64, collateralRatio sdk.Dec, liquidationRatio sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeCdpNotLiquidatable, fmt.Sprintf("cdp %d has collateral ratio of %s, which is not below liquidation ratio of %s", cdpID, collateralRatio, liquidationRatio))
+}
+
+// ErrPriceStale error for drawing debt against collateral whose market price is stale
+func ErrPriceStale(codespace sdk.CodespaceType, marketID string, staleSince time.Time) sdk.Error {
+	return sdk.NewError(codespace, CodePriceStale, fmt.Sprintf("price of market %s is stale since %s", marketID, staleSince))
+}
+
+// ErrCollateralExists error for adding a collateral type that already exists
+func ErrCollateralExists(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeCollateralExists, fmt.Sprintf("collateral %s already exists", denom))
+}
+
+// ErrMarketNotFound error for collateral types whose market is not in the pricefeed
+func ErrMarketNotFound(codespace sdk.CodespaceType, denom string, marketID string) sdk.Error {
+	return sdk.NewError(codespace, CodeMarketNotFound, fmt.Sprintf("market %s of collateral %s not found in pricefeed", marketID, denom))
+}
+
+// ErrInvalidProposal error for invalid cdp governance proposals
+func ErrInvalidProposal(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposal, fmt.Sprintf("invalid proposal: %s", msg))
+}
+
+// ErrSavingsDepositNotFound error for withdrawing from a savings deposit that doesn't exist
+func ErrSavingsDepositNotFound(codespace sdk.CodespaceType, depositor sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeSavingsDepositNotFound, fmt.Sprintf("savings deposit of %s not found", depositor))
+}
+
+// ErrInsufficientSavings error for withdrawing more than the balance of a savings deposit
+func ErrInsufficientSavings(codespace sdk.CodespaceType, depositor sdk.AccAddress, balance sdk.Coins, amount sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientSavings, fmt.Sprintf("savings balance of %s is %s, less than %s", depositor, balance, amount))
+}
+
+// ErrInvalidSavingsAmount error for savings deposits of unsupported denoms or too small to be worth a share
+func ErrInvalidSavingsAmount(codespace sdk.CodespaceType, amount sdk.Coins, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSavingsAmount, fmt.Sprintf("invalid savings amount %s: %s", amount, reason))
+}
+
+// ErrMarketInUse error for removing a pricefeed market that a collateral or debt type is priced with
+func ErrMarketInUse(codespace sdk.CodespaceType, marketID string, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeMarketInUse, fmt.Sprintf("market %s is in use by %s", marketID, denom))
+}
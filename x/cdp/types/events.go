@@ -9,10 +9,16 @@ const (
 	EventTypeCdpClose          = "cdp_close"
 	EventTypeCdpWithdrawal     = "cdp_withdrawal"
 	EventTypeCdpLiquidation    = "cdp_liquidation"
+	EventTypeCdpKeeperReward   = "cdp_keeper_reward"
 	EventTypeBeginBlockerFatal = "cdp_begin_block_error"
+	EventTypeCircuitBreaker    = "cdp_circuit_breaker"
+	EventTypeSavingsDeposit    = "savings_deposit"
+	EventTypeSavingsWithdrawal = "savings_withdrawal"
+	EventTypeSavingsRate       = "savings_rate_distribution"
 
 	AttributeKeyCdpID      = "cdp_id"
 	AttributeKeyDepositor  = "depositor"
+	AttributeKeyKeeper     = "keeper"
 	AttributeValueCategory = "cdp"
 	AttributeKeyError      = "error_message"
 )
@@ -26,6 +26,7 @@ type SupplyKeeper interface {
 // PricefeedKeeper defines the expected interface for the pricefeed
 type PricefeedKeeper interface {
 	GetCurrentPrice(sdk.Context, string) (pftypes.CurrentPrice, sdk.Error)
+	GetTWAP(sdk.Context, string, time.Duration) (pftypes.CurrentPrice, sdk.Error)
 	GetParams(sdk.Context) pftypes.Params
 	// These are used for testing TODO replace mockApp with keeper in tests to remove these
 	SetParams(sdk.Context, pftypes.Params)
@@ -38,4 +39,5 @@ type AuctionKeeper interface {
 	StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string) (uint64, sdk.Error)
 	StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, initialLot sdk.Coin, debt sdk.Coin) (uint64, sdk.Error)
 	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, sdk.Error)
+	StartDutchCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, referencePrice sdk.Dec, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, sdk.Error)
 }
@@ -4,17 +4,21 @@ import (
 	"bytes"
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
-	Params            Params    `json:"params" yaml:"params"`
-	CDPs              CDPs      `json:"cdps" yaml:"cdps"`
-	Deposits          Deposits  `json:"deposits" yaml:"deposits"`
-	StartingCdpID     uint64    `json:"starting_cdp_id" yaml:"starting_cdp_id"`
-	DebtDenom         string    `json:"debt_denom" yaml:"debt_denom"`
-	GovDenom          string    `json:"gov_denom" yaml:"gov_denom"`
-	PreviousBlockTime time.Time `json:"previous_block_time" yaml:"previous_block_time"`
+	Params            Params          `json:"params" yaml:"params"`
+	CDPs              CDPs            `json:"cdps" yaml:"cdps"`
+	Deposits          Deposits        `json:"deposits" yaml:"deposits"`
+	StartingCdpID     uint64          `json:"starting_cdp_id" yaml:"starting_cdp_id"`
+	DebtDenom         string          `json:"debt_denom" yaml:"debt_denom"`
+	GovDenom          string          `json:"gov_denom" yaml:"gov_denom"`
+	PreviousBlockTime time.Time       `json:"previous_block_time" yaml:"previous_block_time"`
+	SavingsDeposits   SavingsDeposits `json:"savings_deposits" yaml:"savings_deposits"`
+	InterestFactors   InterestFactors `json:"interest_factors" yaml:"interest_factors"`
 }
 
 // DefaultGenesisState returns a default genesis state
@@ -27,6 +31,8 @@ func DefaultGenesisState() GenesisState {
 		DebtDenom:         DefaultDebtDenom,
 		GovDenom:          DefaultGovDenom,
 		PreviousBlockTime: DefaultPreviousBlockTime,
+		SavingsDeposits:   SavingsDeposits{},
+		InterestFactors:   InterestFactors{},
 	}
 }
 
@@ -47,6 +53,37 @@ func (gs GenesisState) Validate() error {
 
 	}
 
+	for _, dp := range gs.Params.DebtParams {
+		debtCoin := sdk.Coins{sdk.Coin{Denom: DebtCoinDenom(gs.DebtDenom, dp.Denom), Amount: sdk.OneInt()}}
+		if !debtCoin.IsValid() {
+			return fmt.Errorf("invalid debt coin denom for debt asset %s: %s", dp.Denom, debtCoin[0].Denom)
+		}
+	}
+
+	for _, cdp := range gs.CDPs {
+		if !cdp.NormalizedPrincipal.IsValid() {
+			return fmt.Errorf("invalid normalized principal for cdp %d: %s", cdp.ID, cdp.NormalizedPrincipal)
+		}
+	}
+
+	depositors := make(map[string]bool)
+	for _, sd := range gs.SavingsDeposits {
+		if sd.Depositor.Empty() {
+			return fmt.Errorf("savings deposit has no depositor")
+		}
+		if depositors[sd.Depositor.String()] {
+			return fmt.Errorf("duplicate savings deposit for %s", sd.Depositor)
+		}
+		depositors[sd.Depositor.String()] = true
+		if !sd.Shares.IsValid() || sd.Shares.IsZero() {
+			return fmt.Errorf("invalid savings shares for %s: %s", sd.Depositor, sd.Shares)
+		}
+	}
+
+	if err := gs.InterestFactors.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
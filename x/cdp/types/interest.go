@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InterestFactor is the cumulative interest factor of debt of a debt denom drawn against a collateral type.
+// It starts at one and is multiplied by the fee rate compounded over the time elapsed every block,
+// so a debt that was worth d when the factor was f0 is worth d * f1 / f0 when the factor is f1.
+type InterestFactor struct {
+	CollateralType string  `json:"collateral_type" yaml:"collateral_type"`
+	DebtDenom      string  `json:"debt_denom" yaml:"debt_denom"`
+	Factor         sdk.Dec `json:"factor" yaml:"factor"`
+}
+
+// NewInterestFactor returns a new InterestFactor
+func NewInterestFactor(collateralType string, debtDenom string, factor sdk.Dec) InterestFactor {
+	return InterestFactor{
+		CollateralType: collateralType,
+		DebtDenom:      debtDenom,
+		Factor:         factor,
+	}
+}
+
+// String implements fmt.Stringer
+func (f InterestFactor) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Interest Factor:
+	Collateral Type: %s
+	Debt Denom: %s
+	Factor: %s`, f.CollateralType, f.DebtDenom, f.Factor))
+}
+
+// Validate performs basic validation of an interest factor
+func (f InterestFactor) Validate() error {
+	if f.CollateralType == "" || f.DebtDenom == "" {
+		return fmt.Errorf("interest factor must have a collateral type and debt denom: %s", f)
+	}
+	if f.Factor.IsNil() || f.Factor.LT(sdk.OneDec()) {
+		return fmt.Errorf("interest factor must be ≥ 1.0, is %s for %s:%s", f.Factor, f.CollateralType, f.DebtDenom)
+	}
+	return nil
+}
+
+// InterestFactors array of InterestFactor
+type InterestFactors []InterestFactor
+
+// Validate checks that every interest factor is valid and that there is at most one per collateral type and debt denom
+func (fs InterestFactors) Validate() error {
+	seen := make(map[string]bool)
+	for _, f := range fs {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		key := f.CollateralType + ":" + f.DebtDenom
+		if seen[key] {
+			return fmt.Errorf("duplicate interest factor for %s", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
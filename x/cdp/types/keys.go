@@ -25,6 +25,9 @@ const (
 
 	// LiquidatorMacc module account for liquidator
 	LiquidatorMacc = "liquidator"
+
+	// SavingsMacc module account holding the savings deposits of debt assets
+	SavingsMacc = "savings"
 )
 
 var sep = []byte(":")
@@ -43,6 +46,9 @@ var sep = []byte(":")
 // - 0x06<denom>:totalPrincipal
 // - 0x07<denom>:feeRate
 // - 0x08:previousBlockTime
+// - 0x09<depositorAddr_bytes>: SavingsDeposit
+// - 0x0A: totalSavingsShares
+// - 0x0B<collateralDenom><debtDenom>: InterestFactor
 
 // KVStore key prefixes
 var (
@@ -55,6 +61,9 @@ var (
 	DepositKeyPrefix           = []byte{0x06}
 	PrincipalKeyPrefix         = []byte{0x07}
 	PreviousBlockTimeKey       = []byte{0x08}
+	SavingsDepositKeyPrefix    = []byte{0x09}
+	TotalSavingsSharesKey      = []byte{0x0A}
+	InterestFactorKeyPrefix    = []byte{0x0B}
 )
 
 var lenPositiveDec = len(SortableDecBytes(sdk.OneDec()))
@@ -13,6 +13,9 @@ var (
 	_ sdk.Msg = &MsgWithdraw{}
 	_ sdk.Msg = &MsgDrawDebt{}
 	_ sdk.Msg = &MsgRepayDebt{}
+	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgDepositSavings{}
+	_ sdk.Msg = &MsgWithdrawSavings{}
 )
 
 // MsgCreateCDP creates a cdp
@@ -84,14 +87,16 @@ func (msg MsgCreateCDP) String() string {
 type MsgDeposit struct {
 	Depositor  sdk.AccAddress `json:"depositor" yaml:"depositor"`
 	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`
+	CdpDenom   string         `json:"cdp_denom" yaml:"cdp_denom"`
 	Collateral sdk.Coins      `json:"collateral" yaml:"collateral"`
 }
 
 // NewMsgDeposit returns a new MsgDeposit
-func NewMsgDeposit(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coins) MsgDeposit {
+func NewMsgDeposit(owner sdk.AccAddress, depositor sdk.AccAddress, denom string, collateral sdk.Coins) MsgDeposit {
 	return MsgDeposit{
 		Owner:      owner,
 		Depositor:  depositor,
+		CdpDenom:   denom,
 		Collateral: collateral,
 	}
 }
@@ -110,8 +115,11 @@ func (msg MsgDeposit) ValidateBasic() sdk.Error {
 	if msg.Depositor.Empty() {
 		return sdk.ErrInternal("invalid (empty) owner address")
 	}
-	if len(msg.Collateral) != 1 {
-		return sdk.ErrInvalidCoins(fmt.Sprintf("cdps do not support multiple collateral types: received %s", msg.Collateral))
+	if msg.CdpDenom == "" {
+		return sdk.ErrInternal("invalid (empty) cdp denom")
+	}
+	if msg.Collateral.Empty() {
+		return sdk.ErrInvalidCoins("invalid (empty) collateral amount")
 	}
 	if !msg.Collateral.IsValid() {
 		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid collateral amount: %s", msg.Collateral))
@@ -138,22 +146,25 @@ func (msg MsgDeposit) String() string {
 	return fmt.Sprintf(`Deposit to CDP Message:
 	Sender:         %s
 	Owner: %s
+	CDP Denom: %s
 	Collateral: %s
-`, msg.Owner, msg.Owner, msg.Collateral)
+`, msg.Owner, msg.Owner, msg.CdpDenom, msg.Collateral)
 }
 
 // MsgWithdraw withdraw collateral from an existing cdp.
 type MsgWithdraw struct {
 	Depositor  sdk.AccAddress `json:"depositor" yaml:"depositor"`
 	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`
+	CdpDenom   string         `json:"cdp_denom" yaml:"cdp_denom"`
 	Collateral sdk.Coins      `json:"collateral" yaml:"collateral"`
 }
 
 // NewMsgWithdraw returns a new MsgDeposit
-func NewMsgWithdraw(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coins) MsgWithdraw {
+func NewMsgWithdraw(owner sdk.AccAddress, depositor sdk.AccAddress, denom string, collateral sdk.Coins) MsgWithdraw {
 	return MsgWithdraw{
 		Owner:      owner,
 		Depositor:  depositor,
+		CdpDenom:   denom,
 		Collateral: collateral,
 	}
 }
@@ -172,8 +183,11 @@ func (msg MsgWithdraw) ValidateBasic() sdk.Error {
 	if msg.Depositor.Empty() {
 		return sdk.ErrInternal("invalid (empty) owner address")
 	}
-	if len(msg.Collateral) != 1 {
-		return sdk.ErrInvalidCoins(fmt.Sprintf("cdps do not support multiple collateral types: received %s", msg.Collateral))
+	if msg.CdpDenom == "" {
+		return sdk.ErrInternal("invalid (empty) cdp denom")
+	}
+	if msg.Collateral.Empty() {
+		return sdk.ErrInvalidCoins("invalid (empty) collateral amount")
 	}
 	if !msg.Collateral.IsValid() {
 		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid collateral amount: %s", msg.Collateral))
@@ -200,8 +214,9 @@ func (msg MsgWithdraw) String() string {
 	return fmt.Sprintf(`Withdraw from CDP Message:
 	Owner:         %s
 	Depositor: %s
+	CDP Denom: %s
 	Collateral: %s
-`, msg.Owner, msg.Depositor, msg.Collateral)
+`, msg.Owner, msg.Depositor, msg.CdpDenom, msg.Collateral)
 }
 
 // MsgDrawDebt draw coins off of collateral in cdp
@@ -321,3 +336,166 @@ func (msg MsgRepayDebt) String() string {
 	Payment: %s
 `, msg.Sender, msg.CdpDenom, msg.Payment)
 }
+
+// MsgLiquidate liquidates a cdp that is below the liquidation ratio, paying the sender a reward
+type MsgLiquidate struct {
+	Keeper   sdk.AccAddress `json:"keeper" yaml:"keeper"`
+	CdpDenom string         `json:"cdp_denom" yaml:"cdp_denom"`
+	CdpID    uint64         `json:"cdp_id" yaml:"cdp_id"`
+}
+
+// NewMsgLiquidate returns a new MsgLiquidate
+func NewMsgLiquidate(keeper sdk.AccAddress, denom string, id uint64) MsgLiquidate {
+	return MsgLiquidate{
+		Keeper:   keeper,
+		CdpDenom: denom,
+		CdpID:    id,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgLiquidate) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgLiquidate) Type() string { return "liquidate_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgLiquidate) ValidateBasic() sdk.Error {
+	if msg.Keeper.Empty() {
+		return sdk.ErrInternal("invalid (empty) keeper address")
+	}
+	if msg.CdpDenom == "" {
+		return sdk.ErrInternal("invalid (empty) cdp denom")
+	}
+	if msg.CdpID == 0 {
+		return sdk.ErrInternal("invalid (zero) cdp id")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgLiquidate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Keeper}
+}
+
+// String implements the Stringer interface
+func (msg MsgLiquidate) String() string {
+	return fmt.Sprintf(`Liquidate CDP Message:
+	Keeper:         %s
+	CDP Denom: %s
+	CDP ID: %d
+`, msg.Keeper, msg.CdpDenom, msg.CdpID)
+}
+
+// MsgDepositSavings deposits debt assets into the savings module account, earning a share of the stability fees
+type MsgDepositSavings struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDepositSavings returns a new MsgDepositSavings
+func NewMsgDepositSavings(depositor sdk.AccAddress, amount sdk.Coins) MsgDepositSavings {
+	return MsgDepositSavings{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositSavings) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositSavings) Type() string { return "deposit_savings" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositSavings) ValidateBasic() sdk.Error {
+	if msg.Depositor.Empty() {
+		return sdk.ErrInternal("invalid (empty) depositor address")
+	}
+	return validateSavingsAmount(msg.Amount)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositSavings) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositSavings) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// String implements the Stringer interface
+func (msg MsgDepositSavings) String() string {
+	return fmt.Sprintf(`Deposit Savings Message:
+	Depositor: %s
+	Amount: %s
+`, msg.Depositor, msg.Amount)
+}
+
+// MsgWithdrawSavings withdraws debt assets from the savings module account
+type MsgWithdrawSavings struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgWithdrawSavings returns a new MsgWithdrawSavings
+func NewMsgWithdrawSavings(depositor sdk.AccAddress, amount sdk.Coins) MsgWithdrawSavings {
+	return MsgWithdrawSavings{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawSavings) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawSavings) Type() string { return "withdraw_savings" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawSavings) ValidateBasic() sdk.Error {
+	if msg.Depositor.Empty() {
+		return sdk.ErrInternal("invalid (empty) depositor address")
+	}
+	return validateSavingsAmount(msg.Amount)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawSavings) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawSavings) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// String implements the Stringer interface
+func (msg MsgWithdrawSavings) String() string {
+	return fmt.Sprintf(`Withdraw Savings Message:
+	Depositor: %s
+	Amount: %s
+`, msg.Depositor, msg.Amount)
+}
+
+func validateSavingsAmount(amount sdk.Coins) sdk.Error {
+	if amount.Empty() {
+		return sdk.ErrInvalidCoins("invalid (empty) savings amount")
+	}
+	if !amount.IsValid() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid savings amount: %s", amount))
+	}
+	if !amount.IsAllPositive() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("negative savings amount: %s", amount))
+	}
+	return nil
+}
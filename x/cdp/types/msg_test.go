@@ -52,21 +52,24 @@ func TestMsgDeposit(t *testing.T) {
 		description string
 		sender      sdk.AccAddress
 		depositor   sdk.AccAddress
+		denom       string
 		collateral  sdk.Coins
 		expectPass  bool
 	}{
-		{"deposit", addrs[0], addrs[1], coinsSingle, true},
-		{"deposit", addrs[0], addrs[0], coinsSingle, true},
-		{"deposit no collateral", addrs[0], addrs[1], coinsZero, false},
-		{"deposit multi collateral", addrs[0], addrs[1], coinsMulti, false},
-		{"deposit empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, false},
-		{"deposit empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, false},
+		{"deposit", addrs[0], addrs[1], sdk.DefaultBondDenom, coinsSingle, true},
+		{"deposit", addrs[0], addrs[0], sdk.DefaultBondDenom, coinsSingle, true},
+		{"deposit no collateral", addrs[0], addrs[1], sdk.DefaultBondDenom, coinsZero, false},
+		{"deposit multi collateral", addrs[0], addrs[1], sdk.DefaultBondDenom, coinsMulti, true},
+		{"deposit empty owner", sdk.AccAddress{}, addrs[1], sdk.DefaultBondDenom, coinsSingle, false},
+		{"deposit empty depositor", addrs[0], sdk.AccAddress{}, sdk.DefaultBondDenom, coinsSingle, false},
+		{"deposit empty denom", addrs[0], addrs[1], "", coinsSingle, false},
 	}
 
 	for i, tc := range tests {
 		msg := NewMsgDeposit(
 			tc.sender,
 			tc.depositor,
+			tc.denom,
 			tc.collateral,
 		)
 		if tc.expectPass {
@@ -82,21 +85,24 @@ func TestMsgWithdraw(t *testing.T) {
 		description string
 		sender      sdk.AccAddress
 		depositor   sdk.AccAddress
+		denom       string
 		collateral  sdk.Coins
 		expectPass  bool
 	}{
-		{"withdraw", addrs[0], addrs[1], coinsSingle, true},
-		{"withdraw", addrs[0], addrs[0], coinsSingle, true},
-		{"withdraw no collateral", addrs[0], addrs[1], coinsZero, false},
-		{"withdraw multi collateral", addrs[0], addrs[1], coinsMulti, false},
-		{"withdraw empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, false},
-		{"withdraw empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, false},
+		{"withdraw", addrs[0], addrs[1], sdk.DefaultBondDenom, coinsSingle, true},
+		{"withdraw", addrs[0], addrs[0], sdk.DefaultBondDenom, coinsSingle, true},
+		{"withdraw no collateral", addrs[0], addrs[1], sdk.DefaultBondDenom, coinsZero, false},
+		{"withdraw multi collateral", addrs[0], addrs[1], sdk.DefaultBondDenom, coinsMulti, true},
+		{"withdraw empty owner", sdk.AccAddress{}, addrs[1], sdk.DefaultBondDenom, coinsSingle, false},
+		{"withdraw empty depositor", addrs[0], sdk.AccAddress{}, sdk.DefaultBondDenom, coinsSingle, false},
+		{"withdraw empty denom", addrs[0], addrs[1], "", coinsSingle, false},
 	}
 
 	for i, tc := range tests {
 		msg := NewMsgWithdraw(
 			tc.sender,
 			tc.depositor,
+			tc.denom,
 			tc.collateral,
 		)
 		if tc.expectPass {
@@ -164,3 +170,83 @@ func TestMsgRepayDebt(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgLiquidate(t *testing.T) {
+	tests := []struct {
+		description string
+		keeper      sdk.AccAddress
+		denom       string
+		id          uint64
+		expectPass  bool
+	}{
+		{"liquidate", addrs[0], sdk.DefaultBondDenom, 1, true},
+		{"liquidate empty keeper", sdk.AccAddress{}, sdk.DefaultBondDenom, 1, false},
+		{"liquidate empty denom", addrs[0], "", 1, false},
+		{"liquidate zero id", addrs[0], sdk.DefaultBondDenom, 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgLiquidate(
+			tc.keeper,
+			tc.denom,
+			tc.id,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgDepositSavings(t *testing.T) {
+	tests := []struct {
+		description string
+		depositor   sdk.AccAddress
+		amount      sdk.Coins
+		expectPass  bool
+	}{
+		{"deposit savings", addrs[0], coinsSingle, true},
+		{"deposit savings multi", addrs[0], coinsMulti, true},
+		{"deposit savings no amount", addrs[0], coinsZero, false},
+		{"deposit savings empty depositor", sdk.AccAddress{}, coinsSingle, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgDepositSavings(
+			tc.depositor,
+			tc.amount,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgWithdrawSavings(t *testing.T) {
+	tests := []struct {
+		description string
+		depositor   sdk.AccAddress
+		amount      sdk.Coins
+		expectPass  bool
+	}{
+		{"withdraw savings", addrs[0], coinsSingle, true},
+		{"withdraw savings multi", addrs[0], coinsMulti, true},
+		{"withdraw savings no amount", addrs[0], coinsZero, false},
+		{"withdraw savings empty depositor", sdk.AccAddress{}, coinsSingle, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgWithdrawSavings(
+			tc.depositor,
+			tc.amount,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
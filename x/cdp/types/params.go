@@ -11,34 +11,40 @@ import (
 
 // Parameter keys
 var (
-	KeyGlobalDebtLimit       = []byte("GlobalDebtLimit")
-	KeyCollateralParams      = []byte("CollateralParams")
-	KeyDebtParams            = []byte("DebtParams")
-	KeyCircuitBreaker        = []byte("CircuitBreaker")
-	KeyDebtThreshold         = []byte("DebtThreshold")
-	KeySurplusThreshold      = []byte("SurplusThreshold")
-	DefaultGlobalDebt        = sdk.Coins{}
-	DefaultCircuitBreaker    = false
-	DefaultCollateralParams  = CollateralParams{}
-	DefaultDebtParams        = DebtParams{}
-	DefaultCdpStartingID     = uint64(1)
-	DefaultDebtDenom         = "debt"
-	DefaultGovDenom          = "ukava"
-	DefaultSurplusThreshold  = sdk.NewInt(1000000000)
-	DefaultDebtThreshold     = sdk.NewInt(1000000000)
-	DefaultPreviousBlockTime = tmtime.Canonical(time.Unix(0, 0))
-	minCollateralPrefix      = 0
-	maxCollateralPrefix      = 255
+	KeyGlobalDebtLimit         = []byte("GlobalDebtLimit")
+	KeyCollateralParams        = []byte("CollateralParams")
+	KeyDebtParams              = []byte("DebtParams")
+	KeyCircuitBreaker          = []byte("CircuitBreaker")
+	KeyDebtThreshold           = []byte("DebtThreshold")
+	KeySurplusThreshold        = []byte("SurplusThreshold")
+	KeyLiquidationInterval     = []byte("LiquidationBlockInterval")
+	KeySavingsRate             = []byte("SavingsRate")
+	DefaultGlobalDebt          = sdk.Coins{}
+	DefaultCircuitBreaker      = false
+	DefaultCollateralParams    = CollateralParams{}
+	DefaultDebtParams          = DebtParams{}
+	DefaultCdpStartingID       = uint64(1)
+	DefaultDebtDenom           = "debt"
+	DefaultGovDenom            = "ukava"
+	DefaultSurplusThreshold    = sdk.NewInt(1000000000)
+	DefaultDebtThreshold       = sdk.NewInt(1000000000)
+	DefaultLiquidationInterval = int64(1)
+	DefaultSavingsRate         = sdk.ZeroDec()
+	DefaultPreviousBlockTime   = tmtime.Canonical(time.Unix(0, 0))
+	minCollateralPrefix        = 0
+	maxCollateralPrefix        = 255
 )
 
 // Params governance parameters for cdp module
 type Params struct {
-	CollateralParams        CollateralParams `json:"collateral_params" yaml:"collateral_params"`
-	DebtParams              DebtParams       `json:"debt_params" yaml:"debt_params"`
-	GlobalDebtLimit         sdk.Coins        `json:"global_debt_limit" yaml:"global_debt_limit"`
-	SurplusAuctionThreshold sdk.Int          `json:"surplus_auction_threshold" yaml:"surplus_auction_threshold"`
-	DebtAuctionThreshold    sdk.Int          `json:"debt_auction_threshold" yaml:"debt_auction_threshold"`
-	CircuitBreaker          bool             `json:"circuit_breaker" yaml:"circuit_breaker"`
+	CollateralParams         CollateralParams `json:"collateral_params" yaml:"collateral_params"`
+	DebtParams               DebtParams       `json:"debt_params" yaml:"debt_params"`
+	GlobalDebtLimit          sdk.Coins        `json:"global_debt_limit" yaml:"global_debt_limit"`
+	SurplusAuctionThreshold  sdk.Int          `json:"surplus_auction_threshold" yaml:"surplus_auction_threshold"`
+	DebtAuctionThreshold     sdk.Int          `json:"debt_auction_threshold" yaml:"debt_auction_threshold"`
+	CircuitBreaker           bool             `json:"circuit_breaker" yaml:"circuit_breaker"`
+	LiquidationBlockInterval int64            `json:"liquidation_block_interval" yaml:"liquidation_block_interval"` // number of blocks between the begin blocker's scans for cdps to liquidate, 1 scans every block
+	SavingsRate              sdk.Dec          `json:"savings_rate" yaml:"savings_rate"`                             // fraction (between [0, 1]) of the stability fees paid to savings depositors of the debt asset
 }
 
 // String implements fmt.Stringer
@@ -49,39 +55,50 @@ func (p Params) String() string {
 	Debt Params: %s
 	Surplus Auction Threshold: %s
 	Debt Auction Threshold: %s
-	Circuit Breaker: %t`,
+	Circuit Breaker: %t
+	Liquidation Block Interval: %d
+	Savings Rate: %s`,
 		p.GlobalDebtLimit, p.CollateralParams, p.DebtParams, p.SurplusAuctionThreshold, p.DebtAuctionThreshold, p.CircuitBreaker,
+		p.LiquidationBlockInterval, p.SavingsRate,
 	)
 }
 
 // NewParams returns a new params object
-func NewParams(debtLimit sdk.Coins, collateralParams CollateralParams, debtParams DebtParams, surplusThreshold sdk.Int, debtThreshold sdk.Int, breaker bool) Params {
+func NewParams(debtLimit sdk.Coins, collateralParams CollateralParams, debtParams DebtParams, surplusThreshold sdk.Int, debtThreshold sdk.Int, breaker bool, liquidationInterval int64, savingsRate sdk.Dec) Params {
 	return Params{
-		GlobalDebtLimit:         debtLimit,
-		CollateralParams:        collateralParams,
-		DebtParams:              debtParams,
-		DebtAuctionThreshold:    debtThreshold,
-		SurplusAuctionThreshold: surplusThreshold,
-		CircuitBreaker:          breaker,
+		GlobalDebtLimit:          debtLimit,
+		CollateralParams:         collateralParams,
+		DebtParams:               debtParams,
+		DebtAuctionThreshold:     debtThreshold,
+		SurplusAuctionThreshold:  surplusThreshold,
+		CircuitBreaker:           breaker,
+		LiquidationBlockInterval: liquidationInterval,
+		SavingsRate:              savingsRate,
 	}
 }
 
 // DefaultParams returns default params for cdp module
 func DefaultParams() Params {
-	return NewParams(DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParams, DefaultSurplusThreshold, DefaultDebtThreshold, DefaultCircuitBreaker)
+	return NewParams(DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParams, DefaultSurplusThreshold, DefaultDebtThreshold, DefaultCircuitBreaker, DefaultLiquidationInterval, DefaultSavingsRate)
 }
 
 // CollateralParam governance parameters for each collateral type within the cdp module
 type CollateralParam struct {
-	Denom              string    `json:"denom" yaml:"denom"`                             // Coin name of collateral type
-	LiquidationRatio   sdk.Dec   `json:"liquidation_ratio" yaml:"liquidation_ratio"`     // The ratio (Collateral (priced in stable coin) / Debt) under which a CDP will be liquidated
-	DebtLimit          sdk.Coins `json:"debt_limit" yaml:"debt_limit"`                   // Maximum amount of debt allowed to be drawn from this collateral type
-	StabilityFee       sdk.Dec   `json:"stability_fee" yaml:"stability_fee"`             // per second stability fee for loans opened using this collateral
-	AuctionSize        sdk.Int   `json:"auction_size" yaml:"auction_size"`               // Max amount of collateral to sell off in any one auction.
-	LiquidationPenalty sdk.Dec   `json:"liquidation_penalty" yaml:"liquidation_penalty"` // percentage penalty (between [0, 1]) applied to a cdp if it is liquidated
-	Prefix             byte      `json:"prefix" yaml:"prefix"`
-	MarketID           string    `json:"market_id" yaml:"market_id"`                 // marketID for fetching price of the asset from the pricefeed
-	ConversionFactor   sdk.Int   `json:"conversion_factor" yaml:"conversion_factor"` // factor for converting internal units to one base unit of collateral
+	Denom                  string            `json:"denom" yaml:"denom"`                             // Coin name of collateral type
+	LiquidationRatio       sdk.Dec           `json:"liquidation_ratio" yaml:"liquidation_ratio"`     // The ratio (Collateral (priced in stable coin) / Debt) under which a CDP will be liquidated
+	DebtLimit              sdk.Coins         `json:"debt_limit" yaml:"debt_limit"`                   // Maximum amount of debt allowed to be drawn from this collateral type
+	StabilityFee           sdk.Dec           `json:"stability_fee" yaml:"stability_fee"`             // per second stability fee for loans opened using this collateral
+	AuctionSize            sdk.Int           `json:"auction_size" yaml:"auction_size"`               // Max amount of collateral to sell off in any one auction.
+	LiquidationPenalty     sdk.Dec           `json:"liquidation_penalty" yaml:"liquidation_penalty"` // percentage penalty (between [0, 1]) applied to a cdp if it is liquidated
+	Prefix                 byte              `json:"prefix" yaml:"prefix"`
+	MarketID               string            `json:"market_id" yaml:"market_id"`                               // marketID for fetching price of the asset from the pricefeed
+	ConversionFactor       sdk.Int           `json:"conversion_factor" yaml:"conversion_factor"`               // factor for converting internal units to one base unit of collateral
+	PartialLiquidation     bool              `json:"partial_liquidation" yaml:"partial_liquidation"`           // whether cdps are only liquidated until they are back above the liquidation ratio plus the buffer
+	LiquidationBuffer      sdk.Dec           `json:"liquidation_buffer" yaml:"liquidation_buffer"`             // ratio added to the liquidation ratio to give the target ratio of partially liquidated cdps
+	KeeperRewardPercentage sdk.Dec           `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"` // percentage of the seized collateral paid to accounts that liquidate cdps with MsgLiquidate, taken out of the liquidation penalty
+	LiquidationTWAPWindow  time.Duration     `json:"liquidation_twap_window" yaml:"liquidation_twap_window"`   // window of the time-weighted average price cdps are checked for liquidation with, zero uses the current price
+	InterestRateModel      InterestRateModel `json:"interest_rate_model" yaml:"interest_rate_model"`           // how the per second fee varies with the utilization of the debt limit, the stability fee is used at zero utilization
+	DutchAuction           bool              `json:"dutch_auction" yaml:"dutch_auction"`                       // whether seized collateral is sold in dutch (descending price) auctions instead of forward-reverse collateral auctions
 }
 
 // String implements fmt.Stringer
@@ -95,8 +112,48 @@ func (cp CollateralParam) String() string {
 	Auction Size: %s
 	Prefix: %b
 	Market ID: %s
-	Conversion Factor: %s`,
-		cp.Denom, cp.LiquidationRatio, cp.StabilityFee, cp.LiquidationPenalty, cp.DebtLimit, cp.AuctionSize, cp.Prefix, cp.MarketID, cp.ConversionFactor)
+	Conversion Factor: %s
+	Partial Liquidation: %t
+	Liquidation Buffer: %s
+	Keeper Reward Percentage: %s
+	Liquidation TWAP Window: %s
+	%s
+	Dutch Auction: %t`,
+		cp.Denom, cp.LiquidationRatio, cp.StabilityFee, cp.LiquidationPenalty, cp.DebtLimit, cp.AuctionSize, cp.Prefix, cp.MarketID, cp.ConversionFactor,
+		cp.PartialLiquidation, cp.LiquidationBuffer, cp.KeeperRewardPercentage, cp.LiquidationTWAPWindow, cp.InterestRateModel, cp.DutchAuction)
+}
+
+// Validate checks that a collateral param has valid values, independent of the other params
+func (cp CollateralParam) Validate() error {
+	prefix := int(cp.Prefix)
+	if prefix < minCollateralPrefix || prefix > maxCollateralPrefix {
+		return fmt.Errorf("invalid prefix for collateral denom %s: %b", cp.Denom, cp.Prefix)
+	}
+	if cp.DebtLimit.IsAnyNegative() {
+		return fmt.Errorf("debt limit for all collaterals should be positive, is %s for %s", cp.DebtLimit, cp.Denom)
+	}
+	if cp.LiquidationPenalty.LT(sdk.ZeroDec()) || cp.LiquidationPenalty.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquidation penalty should be between 0 and 1, is %s for %s", cp.LiquidationPenalty, cp.Denom)
+	}
+	if !cp.AuctionSize.IsPositive() {
+		return fmt.Errorf("auction size should be positive, is %s for %s", cp.AuctionSize, cp.Denom)
+	}
+	if cp.StabilityFee.LT(sdk.OneDec()) {
+		return fmt.Errorf("stability fee must be ≥ 1.0, is %s for %s", cp.StabilityFee, cp.Denom)
+	}
+	if cp.PartialLiquidation && (cp.LiquidationBuffer.IsNil() || cp.LiquidationBuffer.IsNegative()) {
+		return fmt.Errorf("liquidation buffer should not be negative, is %s for %s", cp.LiquidationBuffer, cp.Denom)
+	}
+	if !cp.KeeperRewardPercentage.IsNil() && (cp.KeeperRewardPercentage.IsNegative() || cp.KeeperRewardPercentage.GT(cp.LiquidationPenalty)) {
+		return fmt.Errorf("keeper reward percentage should be between 0 and the liquidation penalty %s, is %s for %s", cp.LiquidationPenalty, cp.KeeperRewardPercentage, cp.Denom)
+	}
+	if cp.LiquidationTWAPWindow < 0 {
+		return fmt.Errorf("liquidation twap window should not be negative, is %s for %s", cp.LiquidationTWAPWindow, cp.Denom)
+	}
+	if err := cp.InterestRateModel.Validate(cp.StabilityFee); err != nil {
+		return fmt.Errorf("invalid interest rate model for %s: %s", cp.Denom, err)
+	}
+	return nil
 }
 
 // CollateralParams array of CollateralParam
@@ -111,12 +168,108 @@ func (cps CollateralParams) String() string {
 	return out
 }
 
+// Interest rate model types
+const (
+	// RateModelFlat charges the stability fee regardless of utilization, an empty type is also flat
+	RateModelFlat = "flat"
+	// RateModelUtilization rises linearly from the stability fee at zero utilization to the max fee at full utilization
+	RateModelUtilization = "utilization"
+	// RateModelKink rises linearly from the stability fee to the kink fee at the kink utilization, then to the max fee at full utilization
+	RateModelKink = "kink"
+)
+
+// InterestRateModel sets the per second fee of a collateral type from the utilization of its debt limit,
+// the fraction of the debt limit that has been drawn. Fees are interpolated between the stability fee at zero utilization and the fees below.
+type InterestRateModel struct {
+	Type    string  `json:"type" yaml:"type"`         // one of flat, utilization or kink
+	MaxFee  sdk.Dec `json:"max_fee" yaml:"max_fee"`   // per second fee at full utilization, unused by flat models
+	Kink    sdk.Dec `json:"kink" yaml:"kink"`         // utilization (between (0, 1)) at which the slope of a kink model changes
+	KinkFee sdk.Dec `json:"kink_fee" yaml:"kink_fee"` // per second fee at the kink utilization of a kink model
+}
+
+// NewInterestRateModel returns a new InterestRateModel
+func NewInterestRateModel(modelType string, maxFee, kink, kinkFee sdk.Dec) InterestRateModel {
+	return InterestRateModel{
+		Type:    modelType,
+		MaxFee:  maxFee,
+		Kink:    kink,
+		KinkFee: kinkFee,
+	}
+}
+
+// String implements fmt.Stringer
+func (m InterestRateModel) String() string {
+	switch m.Type {
+	case RateModelUtilization:
+		return fmt.Sprintf("Interest Rate Model: %s, Max Fee: %s", m.Type, m.MaxFee)
+	case RateModelKink:
+		return fmt.Sprintf("Interest Rate Model: %s, Kink: %s, Kink Fee: %s, Max Fee: %s", m.Type, m.Kink, m.KinkFee, m.MaxFee)
+	default:
+		return fmt.Sprintf("Interest Rate Model: %s", RateModelFlat)
+	}
+}
+
+// Validate checks that the model's fees never fall as utilization rises from the stability fee
+func (m InterestRateModel) Validate(stabilityFee sdk.Dec) error {
+	switch m.Type {
+	case "", RateModelFlat:
+		return nil
+	case RateModelUtilization:
+		if m.MaxFee.IsNil() || m.MaxFee.LT(stabilityFee) {
+			return fmt.Errorf("max fee must be ≥ the stability fee %s, is %s", stabilityFee, m.MaxFee)
+		}
+	case RateModelKink:
+		if m.Kink.IsNil() || !m.Kink.IsPositive() || m.Kink.GTE(sdk.OneDec()) {
+			return fmt.Errorf("kink should be between 0 and 1, is %s", m.Kink)
+		}
+		if m.KinkFee.IsNil() || m.KinkFee.LT(stabilityFee) {
+			return fmt.Errorf("kink fee must be ≥ the stability fee %s, is %s", stabilityFee, m.KinkFee)
+		}
+		if m.MaxFee.IsNil() || m.MaxFee.LT(m.KinkFee) {
+			return fmt.Errorf("max fee must be ≥ the kink fee %s, is %s", m.KinkFee, m.MaxFee)
+		}
+	default:
+		return fmt.Errorf("unknown interest rate model type %s", m.Type)
+	}
+	return nil
+}
+
+// FeeRate returns the per second fee at the input utilization, which is capped at 1
+func (m InterestRateModel) FeeRate(stabilityFee sdk.Dec, utilization sdk.Dec) sdk.Dec {
+	if utilization.GT(sdk.OneDec()) {
+		utilization = sdk.OneDec()
+	}
+	switch m.Type {
+	case RateModelUtilization:
+		return interpolateFee(stabilityFee, m.MaxFee, utilization)
+	case RateModelKink:
+		// param changes are not validated, so a kink outside (0, 1) falls back to the stability fee rather than dividing by zero
+		if m.Kink.IsNil() || !m.Kink.IsPositive() || m.Kink.GTE(sdk.OneDec()) {
+			return stabilityFee
+		}
+		if utilization.LTE(m.Kink) {
+			return interpolateFee(stabilityFee, m.KinkFee, utilization.Quo(m.Kink))
+		}
+		return interpolateFee(m.KinkFee, m.MaxFee, utilization.Sub(m.Kink).Quo(sdk.OneDec().Sub(m.Kink)))
+	default:
+		return stabilityFee
+	}
+}
+
+// interpolateFee returns the fee a fraction (between [0, 1]) of the way from the low to the high fee
+func interpolateFee(low, high, fraction sdk.Dec) sdk.Dec {
+	return low.Add(high.Sub(low).Mul(fraction))
+}
+
 // DebtParam governance params for debt assets
 type DebtParam struct {
 	Denom            string  `json:"denom" yaml:"denom"`
 	ReferenceAsset   string  `json:"reference_asset" yaml:"reference_asset"`
 	ConversionFactor sdk.Int `json:"conversion_factor" yaml:"conversion_factor"`
 	DebtFloor        sdk.Int `json:"debt_floor" yaml:"debt_floor"` // minimum active loan size, used to prevent dust
+	// MarketID is the pricefeed market that prices the reference asset in the quote asset of the collateral markets.
+	// It is empty when the reference asset is that quote asset, in which case the debt is priced at one.
+	MarketID string `json:"market_id" yaml:"market_id"`
 }
 
 func (dp DebtParam) String() string {
@@ -124,7 +277,8 @@ func (dp DebtParam) String() string {
 	Denom: %s
 	Reference Asset: %s
 	Conversion Factor: %s
-	Debt Floor %s`, dp.Denom, dp.ReferenceAsset, dp.ConversionFactor, dp.DebtFloor)
+	Debt Floor %s
+	Market ID: %s`, dp.Denom, dp.ReferenceAsset, dp.ConversionFactor, dp.DebtFloor, dp.MarketID)
 }
 
 // DebtParams array of DebtParam
@@ -139,6 +293,12 @@ func (dps DebtParams) String() string {
 	return out
 }
 
+// DebtCoinDenom returns the denom of the internal debt coins that track debt of the input debt asset,
+// which is the system debt denom followed by the debt asset's denom
+func DebtCoinDenom(debtDenom string, denom string) string {
+	return debtDenom + denom
+}
+
 // ParamKeyTable Key declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
@@ -155,6 +315,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyCircuitBreaker, Value: &p.CircuitBreaker},
 		{Key: KeySurplusThreshold, Value: &p.SurplusAuctionThreshold},
 		{Key: KeyDebtThreshold, Value: &p.DebtAuctionThreshold},
+		{Key: KeyLiquidationInterval, Value: &p.LiquidationBlockInterval},
+		{Key: KeySavingsRate, Value: &p.SavingsRate},
 	}
 }
 
@@ -176,10 +338,10 @@ func (p Params) Validate() error {
 	prefixDupMap := make(map[int]int)
 	collateralParamsDebtLimit := sdk.Coins{}
 	for _, cp := range p.CollateralParams {
-		prefix := int(cp.Prefix)
-		if prefix < minCollateralPrefix || prefix > maxCollateralPrefix {
-			return fmt.Errorf("invalid prefix for collateral denom %s: %b", cp.Denom, cp.Prefix)
+		if err := cp.Validate(); err != nil {
+			return err
 		}
+		prefix := int(cp.Prefix)
 		_, found := prefixDupMap[prefix]
 		if found {
 			return fmt.Errorf("duplicate prefix for collateral denom %s: %v", cp.Denom, []byte{cp.Prefix})
@@ -193,9 +355,6 @@ func (p Params) Validate() error {
 		}
 		collateralDupMap[cp.Denom] = 1
 
-		if cp.DebtLimit.IsAnyNegative() {
-			return fmt.Errorf("debt limit for all collaterals should be positive, is %s for %s", cp.DebtLimit, cp.Denom)
-		}
 		collateralParamsDebtLimit = collateralParamsDebtLimit.Add(cp.DebtLimit)
 
 		for _, dc := range cp.DebtLimit {
@@ -208,15 +367,6 @@ func (p Params) Validate() error {
 			return fmt.Errorf("collateral debt limit for %s exceeds global debt limit: \n\tglobal debt limit: %s\n\tcollateral debt limits: %s",
 				cp.Denom, p.GlobalDebtLimit, cp.DebtLimit)
 		}
-		if cp.LiquidationPenalty.LT(sdk.ZeroDec()) || cp.LiquidationPenalty.GT(sdk.OneDec()) {
-			return fmt.Errorf("liquidation penalty should be between 0 and 1, is %s for %s", cp.LiquidationPenalty, cp.Denom)
-		}
-		if !cp.AuctionSize.IsPositive() {
-			return fmt.Errorf("auction size should be positive, is %s for %s", cp.AuctionSize, cp.Denom)
-		}
-		if cp.StabilityFee.LT(sdk.OneDec()) {
-			return fmt.Errorf("stability fee must be ≥ 1.0, is %s for %s", cp.StabilityFee, cp.Denom)
-		}
 	}
 	if collateralParamsDebtLimit.IsAnyGT(p.GlobalDebtLimit) {
 		return fmt.Errorf("collateral debt limit exceeds global debt limit:\n\tglobal debt limit: %s\n\tcollateral debt limits: %s",
@@ -233,5 +383,11 @@ func (p Params) Validate() error {
 	if !p.DebtAuctionThreshold.IsPositive() {
 		return fmt.Errorf("debt auction threshold should be positive, is %s", p.DebtAuctionThreshold)
 	}
+	if p.LiquidationBlockInterval <= 0 {
+		return fmt.Errorf("liquidation block interval should be positive, is %d", p.LiquidationBlockInterval)
+	}
+	if !p.SavingsRate.IsNil() && (p.SavingsRate.IsNegative() || p.SavingsRate.GT(sdk.OneDec())) {
+		return fmt.Errorf("savings rate should be between 0 and 1, is %s", p.SavingsRate)
+	}
 	return nil
 }
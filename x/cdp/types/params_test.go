@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterestRateModel_Validate(t *testing.T) {
+	fee := sdk.MustNewDecFromStr("1.000000001")
+	higherFee := sdk.MustNewDecFromStr("1.000000002")
+	lowerFee := sdk.MustNewDecFromStr("1.0000000005")
+	half := sdk.MustNewDecFromStr("0.5")
+
+	tests := []struct {
+		name       string
+		model      InterestRateModel
+		expectPass bool
+	}{
+		{"empty", InterestRateModel{}, true},
+		{"flat", NewInterestRateModel(RateModelFlat, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}), true},
+		{"utilization", NewInterestRateModel(RateModelUtilization, higherFee, sdk.Dec{}, sdk.Dec{}), true},
+		{"utilization flat", NewInterestRateModel(RateModelUtilization, fee, sdk.Dec{}, sdk.Dec{}), true},
+		{"utilization missing max fee", NewInterestRateModel(RateModelUtilization, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}), false},
+		{"utilization falling", NewInterestRateModel(RateModelUtilization, lowerFee, sdk.Dec{}, sdk.Dec{}), false},
+		{"kink", NewInterestRateModel(RateModelKink, higherFee, half, fee), true},
+		{"kink zero", NewInterestRateModel(RateModelKink, higherFee, sdk.ZeroDec(), fee), false},
+		{"kink one", NewInterestRateModel(RateModelKink, higherFee, sdk.OneDec(), fee), false},
+		{"kink fee falling", NewInterestRateModel(RateModelKink, higherFee, half, lowerFee), false},
+		{"kink max fee falling", NewInterestRateModel(RateModelKink, fee, half, higherFee), false},
+		{"unknown", NewInterestRateModel("exponential", higherFee, half, fee), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.model.Validate(fee)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestInterestRateModel_FeeRate(t *testing.T) {
+	d := sdk.MustNewDecFromStr
+	base := d("1.000000001")
+
+	tests := []struct {
+		name        string
+		model       InterestRateModel
+		utilization sdk.Dec
+		expected    sdk.Dec
+	}{
+		{"flat", InterestRateModel{}, d("0.9"), base},
+		{"utilization zero", NewInterestRateModel(RateModelUtilization, d("1.000000003"), sdk.Dec{}, sdk.Dec{}), sdk.ZeroDec(), base},
+		{"utilization half", NewInterestRateModel(RateModelUtilization, d("1.000000003"), sdk.Dec{}, sdk.Dec{}), d("0.5"), d("1.000000002")},
+		{"utilization full", NewInterestRateModel(RateModelUtilization, d("1.000000003"), sdk.Dec{}, sdk.Dec{}), sdk.OneDec(), d("1.000000003")},
+		{"utilization over limit", NewInterestRateModel(RateModelUtilization, d("1.000000003"), sdk.Dec{}, sdk.Dec{}), d("1.2"), d("1.000000003")},
+		{"kink below", NewInterestRateModel(RateModelKink, d("1.000000011"), d("0.8"), d("1.000000003")), d("0.4"), d("1.000000002")},
+		{"kink at kink", NewInterestRateModel(RateModelKink, d("1.000000011"), d("0.8"), d("1.000000003")), d("0.8"), d("1.000000003")},
+		{"kink above", NewInterestRateModel(RateModelKink, d("1.000000011"), d("0.8"), d("1.000000003")), d("0.9"), d("1.000000007")},
+		{"kink full", NewInterestRateModel(RateModelKink, d("1.000000011"), d("0.8"), d("1.000000003")), sdk.OneDec(), d("1.000000011")},
+		{"kink zero", NewInterestRateModel(RateModelKink, d("1.000000011"), sdk.ZeroDec(), d("1.000000003")), d("0.5"), base},
+		{"kink one", NewInterestRateModel(RateModelKink, d("1.000000011"), sdk.OneDec(), d("1.000000003")), d("0.5"), base},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.model.FeeRate(base, tc.utilization))
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeAddCollateral defines the type for an AddCollateralProposal
+	ProposalTypeAddCollateral = "AddCollateral"
+	// ProposalTypeUpdateCollateral defines the type for an UpdateCollateralProposal
+	ProposalTypeUpdateCollateral = "UpdateCollateral"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = AddCollateralProposal{}
+	_ govtypes.Content = UpdateCollateralProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddCollateral)
+	govtypes.RegisterProposalTypeCodec(AddCollateralProposal{}, "cdp/AddCollateralProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateCollateral)
+	govtypes.RegisterProposalTypeCodec(UpdateCollateralProposal{}, "cdp/UpdateCollateralProposal")
+}
+
+// AddCollateralProposal adds a collateral type to the cdp params
+type AddCollateralProposal struct {
+	Title           string          `json:"title" yaml:"title"`
+	Description     string          `json:"description" yaml:"description"`
+	CollateralParam CollateralParam `json:"collateral_param" yaml:"collateral_param"`
+}
+
+// NewAddCollateralProposal creates a new add collateral proposal
+func NewAddCollateralProposal(title, description string, collateralParam CollateralParam) AddCollateralProposal {
+	return AddCollateralProposal{title, description, collateralParam}
+}
+
+// GetTitle returns the title of the proposal
+func (p AddCollateralProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p AddCollateralProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p AddCollateralProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p AddCollateralProposal) ProposalType() string { return ProposalTypeAddCollateral }
+
+// ValidateBasic runs basic stateless validity checks
+func (p AddCollateralProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+	return validateCollateralProposal(p.CollateralParam)
+}
+
+// String implements fmt.Stringer
+func (p AddCollateralProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Add Collateral Proposal:
+  Title:       %s
+  Description: %s
+  %s`, p.Title, p.Description, p.CollateralParam))
+}
+
+// UpdateCollateralProposal replaces the params of an existing collateral type.
+// The prefix and conversion factor of a collateral type can't be changed, as stored cdps are indexed by them.
+type UpdateCollateralProposal struct {
+	Title           string          `json:"title" yaml:"title"`
+	Description     string          `json:"description" yaml:"description"`
+	CollateralParam CollateralParam `json:"collateral_param" yaml:"collateral_param"`
+}
+
+// NewUpdateCollateralProposal creates a new update collateral proposal
+func NewUpdateCollateralProposal(title, description string, collateralParam CollateralParam) UpdateCollateralProposal {
+	return UpdateCollateralProposal{title, description, collateralParam}
+}
+
+// GetTitle returns the title of the proposal
+func (p UpdateCollateralProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p UpdateCollateralProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p UpdateCollateralProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p UpdateCollateralProposal) ProposalType() string { return ProposalTypeUpdateCollateral }
+
+// ValidateBasic runs basic stateless validity checks
+func (p UpdateCollateralProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+	return validateCollateralProposal(p.CollateralParam)
+}
+
+// String implements fmt.Stringer
+func (p UpdateCollateralProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Update Collateral Proposal:
+  Title:       %s
+  Description: %s
+  %s`, p.Title, p.Description, p.CollateralParam))
+}
+
+func validateCollateralProposal(cp CollateralParam) sdk.Error {
+	if strings.TrimSpace(cp.Denom) == "" {
+		return ErrInvalidProposal(DefaultCodespace, "missing collateral denom")
+	}
+	if strings.TrimSpace(cp.MarketID) == "" {
+		return ErrInvalidProposal(DefaultCodespace, "missing market ID")
+	}
+	if cp.LiquidationRatio.IsNil() || cp.StabilityFee.IsNil() || cp.LiquidationPenalty.IsNil() ||
+		cp.AuctionSize == (sdk.Int{}) || cp.ConversionFactor == (sdk.Int{}) {
+		return ErrInvalidProposal(DefaultCodespace, fmt.Sprintf("missing required fields for collateral %s", cp.Denom))
+	}
+	if !cp.ConversionFactor.IsPositive() {
+		return ErrInvalidProposal(DefaultCodespace, fmt.Sprintf("conversion factor should be positive, is %s for %s", cp.ConversionFactor, cp.Denom))
+	}
+	if err := cp.Validate(); err != nil {
+		return ErrInvalidProposal(DefaultCodespace, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposals_ValidateBasic(t *testing.T) {
+	cp := CollateralParam{
+		Denom:              "bnb",
+		LiquidationRatio:   sdk.MustNewDecFromStr("1.5"),
+		DebtLimit:          sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000)),
+		StabilityFee:       sdk.MustNewDecFromStr("1.000000001547125958"),
+		AuctionSize:        sdk.NewInt(100000000),
+		LiquidationPenalty: sdk.MustNewDecFromStr("0.05"),
+		Prefix:             0x22,
+		MarketID:           "bnb:usd",
+		ConversionFactor:   sdk.NewInt(8),
+	}
+	missingMarket := cp
+	missingMarket.MarketID = ""
+	missingFields := cp
+	missingFields.StabilityFee = sdk.Dec{}
+	invalidConversionFactor := cp
+	invalidConversionFactor.ConversionFactor = sdk.ZeroInt()
+	invalidPenalty := cp
+	invalidPenalty.LiquidationPenalty = sdk.MustNewDecFromStr("1.1")
+	invalidRateModel := cp
+	invalidRateModel.InterestRateModel = NewInterestRateModel(RateModelUtilization, sdk.OneDec(), sdk.Dec{}, sdk.Dec{})
+
+	tests := []struct {
+		name       string
+		proposal   govtypes.Content
+		expectPass bool
+	}{
+		{"addCollateral", NewAddCollateralProposal("title", "description", cp), true},
+		{"addCollateralEmptyTitle", NewAddCollateralProposal("", "description", cp), false},
+		{"addCollateralEmpty", NewAddCollateralProposal("title", "description", CollateralParam{}), false},
+		{"addCollateralMissingMarket", NewAddCollateralProposal("title", "description", missingMarket), false},
+		{"addCollateralMissingFields", NewAddCollateralProposal("title", "description", missingFields), false},
+		{"updateCollateral", NewUpdateCollateralProposal("title", "description", cp), true},
+		{"updateCollateralEmptyDescription", NewUpdateCollateralProposal("title", "", cp), false},
+		{"updateCollateralInvalidConversionFactor", NewUpdateCollateralProposal("title", "description", invalidConversionFactor), false},
+		{"updateCollateralInvalidPenalty", NewUpdateCollateralProposal("title", "description", invalidPenalty), false},
+		{"updateCollateralInvalidRateModel", NewUpdateCollateralProposal("title", "description", invalidRateModel), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.proposal.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.proposal.ValidateBasic())
+			}
+		})
+	}
+}
@@ -11,9 +11,12 @@ const (
 	QueryGetCdps                    = "cdps"
 	QueryGetCdpsByCollateralization = "ratio"
 	QueryGetParams                  = "params"
+	QueryGetSavingsDeposit          = "savings-deposit"
+	QueryGetSavingsRate             = "savings-rate"
 	RestOwner                       = "owner"
 	RestCollateralDenom             = "collateral-denom"
 	RestRatio                       = "ratio"
+	RestDepositor                   = "depositor"
 )
 
 // QueryCdpsParams params for query /cdp/cdps
@@ -69,3 +72,15 @@ func NewQueryCdpsByRatioParams(denom string, ratio sdk.Dec) QueryCdpsByRatioPara
 		Ratio:           ratio,
 	}
 }
+
+// QuerySavingsDepositParams params for query /cdp/savings-deposit
+type QuerySavingsDepositParams struct {
+	Depositor sdk.AccAddress // get the savings deposit of this depositor
+}
+
+// NewQuerySavingsDepositParams returns QuerySavingsDepositParams
+func NewQuerySavingsDepositParams(depositor sdk.AccAddress) QuerySavingsDepositParams {
+	return QuerySavingsDepositParams{
+		Depositor: depositor,
+	}
+}
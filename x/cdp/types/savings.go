@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SavingsDeposit is the savings position of a depositor. Shares are denominated in the debt asset they are a share of,
+// and are worth a proportional part of the savings module account's balance of that asset.
+type SavingsDeposit struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Shares    sdk.Coins      `json:"shares" yaml:"shares"`
+}
+
+// NewSavingsDeposit returns a new SavingsDeposit
+func NewSavingsDeposit(depositor sdk.AccAddress, shares sdk.Coins) SavingsDeposit {
+	return SavingsDeposit{
+		Depositor: depositor,
+		Shares:    shares,
+	}
+}
+
+// String implements fmt.Stringer
+func (sd SavingsDeposit) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Savings Deposit:
+	Depositor: %s
+	Shares: %s`, sd.Depositor, sd.Shares))
+}
+
+// SavingsDeposits array of SavingsDeposit
+type SavingsDeposits []SavingsDeposit
+
+// TotalShares returns the sum of the shares of all savings deposits
+func (sds SavingsDeposits) TotalShares() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, sd := range sds {
+		total = total.Add(sd.Shares)
+	}
+	return total
+}
+
+// SavingsPosition is a savings deposit with the current balance of its shares
+type SavingsPosition struct {
+	SavingsDeposit `json:"savings_deposit" yaml:"savings_deposit"`
+	Balance        sdk.Coins `json:"balance" yaml:"balance"` // amount of debt assets the shares can be withdrawn for
+}
+
+// NewSavingsPosition returns a new SavingsPosition
+func NewSavingsPosition(deposit SavingsDeposit, balance sdk.Coins) SavingsPosition {
+	return SavingsPosition{
+		SavingsDeposit: deposit,
+		Balance:        balance,
+	}
+}
+
+// String implements fmt.Stringer
+func (sp SavingsPosition) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`%s
+	Balance: %s`, sp.SavingsDeposit, sp.Balance))
+}
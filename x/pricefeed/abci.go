@@ -6,12 +6,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// EndBlocker updates the current pricefeed
+// EndBlocker prunes expired and de-authorized posted prices and updates the current pricefeed
 func EndBlocker(ctx sdk.Context, k Keeper) {
 	// Update the current price of each asset.
 	for _, a := range k.GetMarkets(ctx) {
+		k.PruneRawPrices(ctx, a.MarketID)
 		if a.Active {
 			err := k.SetCurrentPrices(ctx, a.MarketID)
+			// oracles are tracked in every block, including blocks where none of them have an unexpired price
+			if perfErr := k.UpdateOraclePerformance(ctx, a.MarketID); perfErr != nil {
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						EventTypeOracleTrackingFailed,
+						sdk.NewAttribute(AttributeMarketID, fmt.Sprintf("%s", a.MarketID)),
+						sdk.NewAttribute(AttributeKeyError, fmt.Sprintf("%s", perfErr)),
+					),
+				)
+			}
 			if err != nil {
 				// In the event of failure, emit an event.
 				ctx.EventManager().EmitEvent(
@@ -17,15 +17,34 @@ const (
 	CodeInvalidPrice              = types.CodeInvalidPrice
 	CodeInvalidAsset              = types.CodeInvalidAsset
 	CodeInvalidOracle             = types.CodeInvalidOracle
+	CodeInvalidWindow             = types.CodeInvalidWindow
+	CodeInsufficientBond          = types.CodeInsufficientBond
+	CodeOutstandingOutliers       = types.CodeOutstandingOutliers
+	CodeMarketExists              = types.CodeMarketExists
+	CodeOracleExists              = types.CodeOracleExists
+	CodeInvalidProposal           = types.CodeInvalidProposal
+	CodeBondsExceedBalance        = types.CodeBondsExceedBalance
 	EventTypeMarketPriceUpdated   = types.EventTypeMarketPriceUpdated
+	EventTypeMarketPriceRejected  = types.EventTypeMarketPriceRejected
+	EventTypeMarketPriceStale     = types.EventTypeMarketPriceStale
 	EventTypeOracleUpdatedPrice   = types.EventTypeOracleUpdatedPrice
 	EventTypeNoValidPrices        = types.EventTypeNoValidPrices
+	EventTypeOracleRewarded       = types.EventTypeOracleRewarded
+	EventTypeOracleSlashed        = types.EventTypeOracleSlashed
+	EventTypeOracleTrackingFailed = types.EventTypeOracleTrackingFailed
+	EventTypeRawPricesPruned      = types.EventTypeRawPricesPruned
+	EventTypeRewardPoolFunded     = types.EventTypeRewardPoolFunded
 	AttributeValueCategory        = types.AttributeValueCategory
 	AttributeMarketID             = types.AttributeMarketID
 	AttributeMarketPrice          = types.AttributeMarketPrice
 	AttributeOracle               = types.AttributeOracle
+	AttributeDepositor            = types.AttributeDepositor
 	AttributeExpiry               = types.AttributeExpiry
+	AttributeConfirmations        = types.AttributeConfirmations
+	AttributeAmount               = types.AttributeAmount
+	AttributePrunedPrices         = types.AttributePrunedPrices
 	AttributeKeyPriceUpdateFailed = types.AttributeKeyPriceUpdateFailed
+	AttributeKeyError             = types.AttributeKeyError
 	ModuleName                    = types.ModuleName
 	StoreKey                      = types.StoreKey
 	RouterKey                     = types.RouterKey
@@ -33,46 +52,138 @@ const (
 	DefaultParamspace             = types.DefaultParamspace
 	RawPriceFeedPrefix            = types.RawPriceFeedPrefix
 	CurrentPricePrefix            = types.CurrentPricePrefix
+	PendingPricePrefix            = types.PendingPricePrefix
+	PriceHistoryPrefix            = types.PriceHistoryPrefix
+	OraclePerformancePrefix       = types.OraclePerformancePrefix
+	OracleBondPrefix              = types.OracleBondPrefix
 	MarketPrefix                  = types.MarketPrefix
 	OraclePrefix                  = types.OraclePrefix
+	MaxMarketIDLength             = types.MaxMarketIDLength
 	TypeMsgPostPrice              = types.TypeMsgPostPrice
+	TypeMsgBondOracle             = types.TypeMsgBondOracle
+	TypeMsgUnbondOracle           = types.TypeMsgUnbondOracle
+	TypeMsgFundRewardPool         = types.TypeMsgFundRewardPool
 	QueryPrice                    = types.QueryPrice
 	QueryRawPrices                = types.QueryRawPrices
+	QueryTWAP                     = types.QueryTWAP
+	QueryOraclePerformance        = types.QueryOraclePerformance
+	QueryOracleBond               = types.QueryOracleBond
+	QueryRewardPool               = types.QueryRewardPool
 	QueryMarkets                  = types.QueryMarkets
+	AggregationMedian             = types.AggregationMedian
+	AggregationTrimmedMean        = types.AggregationTrimmedMean
+	AggregationWeightedMedian     = types.AggregationWeightedMedian
+	AggregationTrustedOracle      = types.AggregationTrustedOracle
+	ProposalTypeAddMarket         = types.ProposalTypeAddMarket
+	ProposalTypeRemoveMarket      = types.ProposalTypeRemoveMarket
+	ProposalTypeAddOracle         = types.ProposalTypeAddOracle
+	ProposalTypeRemoveOracle      = types.ProposalTypeRemoveOracle
+	ProposalTypeActivateMarket    = types.ProposalTypeActivateMarket
+	ProposalTypeDeactivateMarket  = types.ProposalTypeDeactivateMarket
 )
 
 var (
 	// functions aliases
-	RegisterCodec       = types.RegisterCodec
-	ErrEmptyInput       = types.ErrEmptyInput
-	ErrExpired          = types.ErrExpired
-	ErrNoValidPrice     = types.ErrNoValidPrice
-	ErrInvalidMarket    = types.ErrInvalidMarket
-	ErrInvalidOracle    = types.ErrInvalidOracle
-	NewGenesisState     = types.NewGenesisState
-	DefaultGenesisState = types.DefaultGenesisState
-	NewMsgPostPrice     = types.NewMsgPostPrice
-	NewParams           = types.NewParams
-	DefaultParams       = types.DefaultParams
-	ParamKeyTable       = types.ParamKeyTable
-	NewKeeper           = keeper.NewKeeper
-	NewQuerier          = keeper.NewQuerier
+	RegisterCodec                  = types.RegisterCodec
+	ErrEmptyInput                  = types.ErrEmptyInput
+	ErrExpired                     = types.ErrExpired
+	ErrNoValidPrice                = types.ErrNoValidPrice
+	ErrInvalidMarket               = types.ErrInvalidMarket
+	ErrInvalidOracle               = types.ErrInvalidOracle
+	ErrInvalidTWAPWindow           = types.ErrInvalidTWAPWindow
+	ErrInsufficientBond            = types.ErrInsufficientBond
+	ErrOutstandingOutliers         = types.ErrOutstandingOutliers
+	ErrMarketExists                = types.ErrMarketExists
+	ErrOracleExists                = types.ErrOracleExists
+	ErrInvalidProposal             = types.ErrInvalidProposal
+	ErrBondsExceedBalance          = types.ErrBondsExceedBalance
+	GetAggregator                  = types.GetAggregator
+	MedianPrice                    = types.MedianPrice
+	NewGenesisState                = types.NewGenesisState
+	DefaultGenesisState            = types.DefaultGenesisState
+	RawPriceMarketPrefix           = types.RawPriceMarketPrefix
+	RawPriceKey                    = types.RawPriceKey
+	PriceHistoryMarketPrefix       = types.PriceHistoryMarketPrefix
+	PriceHistoryKey                = types.PriceHistoryKey
+	OraclePerformanceMarketPrefix  = types.OraclePerformanceMarketPrefix
+	OraclePerformanceKey           = types.OraclePerformanceKey
+	OracleBondKey                  = types.OracleBondKey
+	NewMsgPostPrice                = types.NewMsgPostPrice
+	NewMsgBondOracle               = types.NewMsgBondOracle
+	NewMsgUnbondOracle             = types.NewMsgUnbondOracle
+	NewMsgFundRewardPool           = types.NewMsgFundRewardPool
+	NewOraclePerformance           = types.NewOraclePerformance
+	NewOracleBond                  = types.NewOracleBond
+	NewParams                      = types.NewParams
+	DefaultParams                  = types.DefaultParams
+	ParamKeyTable                  = types.ParamKeyTable
+	NewQueryTWAPParams             = types.NewQueryTWAPParams
+	NewQueryOracleBondParams       = types.NewQueryOracleBondParams
+	NewAddMarketProposal           = types.NewAddMarketProposal
+	NewRemoveMarketProposal        = types.NewRemoveMarketProposal
+	NewAddOracleProposal           = types.NewAddOracleProposal
+	NewRemoveOracleProposal        = types.NewRemoveOracleProposal
+	NewActivateMarketProposal      = types.NewActivateMarketProposal
+	NewDeactivateMarketProposal    = types.NewDeactivateMarketProposal
+	NewKeeper                      = keeper.NewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	HandleAddMarketProposal        = keeper.HandleAddMarketProposal
+	HandleRemoveMarketProposal     = keeper.HandleRemoveMarketProposal
+	HandleAddOracleProposal        = keeper.HandleAddOracleProposal
+	HandleRemoveOracleProposal     = keeper.HandleRemoveOracleProposal
+	HandleActivateMarketProposal   = keeper.HandleActivateMarketProposal
+	HandleDeactivateMarketProposal = keeper.HandleDeactivateMarketProposal
 
 	// variable aliases
-	ModuleCdc      = types.ModuleCdc
-	KeyMarkets     = types.KeyMarkets
-	DefaultMarkets = types.DefaultMarkets
+	ModuleCdc               = types.ModuleCdc
+	KeyMarkets              = types.KeyMarkets
+	KeyMaxTWAPWindow        = types.KeyMaxTWAPWindow
+	DefaultMarkets          = types.DefaultMarkets
+	DefaultMaxTWAPWindow    = types.DefaultMaxTWAPWindow
+	KeyOutlierThreshold     = types.KeyOutlierThreshold
+	KeySlashThreshold       = types.KeySlashThreshold
+	KeySlashFraction        = types.KeySlashFraction
+	KeyOracleReward         = types.KeyOracleReward
+	DefaultOutlierThreshold = types.DefaultOutlierThreshold
+	DefaultSlashThreshold   = types.DefaultSlashThreshold
+	DefaultSlashFraction    = types.DefaultSlashFraction
+	DefaultOracleReward     = types.DefaultOracleReward
 )
 
 type (
-	GenesisState            = types.GenesisState
-	Market                  = types.Market
-	Markets                 = types.Markets
-	CurrentPrice            = types.CurrentPrice
-	PostedPrice             = types.PostedPrice
-	SortDecs                = types.SortDecs
-	MsgPostPrice            = types.MsgPostPrice
-	Params                  = types.Params
-	QueryWithMarketIDParams = types.QueryWithMarketIDParams
-	Keeper                  = keeper.Keeper
+	GenesisState             = types.GenesisState
+	Market                   = types.Market
+	Markets                  = types.Markets
+	OracleWeight             = types.OracleWeight
+	Aggregator               = types.Aggregator
+	MedianAggregator         = types.MedianAggregator
+	TrimmedMeanAggregator    = types.TrimmedMeanAggregator
+	WeightedMedianAggregator = types.WeightedMedianAggregator
+	TrustedOracleAggregator  = types.TrustedOracleAggregator
+	CurrentPrice             = types.CurrentPrice
+	PendingPrice             = types.PendingPrice
+	PriceObservation         = types.PriceObservation
+	PostedPrice              = types.PostedPrice
+	SortDecs                 = types.SortDecs
+	MsgPostPrice             = types.MsgPostPrice
+	MsgBondOracle            = types.MsgBondOracle
+	MsgUnbondOracle          = types.MsgUnbondOracle
+	MsgFundRewardPool        = types.MsgFundRewardPool
+	OraclePerformance        = types.OraclePerformance
+	OraclePerformances       = types.OraclePerformances
+	OracleBond               = types.OracleBond
+	OracleBonds              = types.OracleBonds
+	SupplyKeeper             = types.SupplyKeeper
+	PricefeedHooks           = types.PricefeedHooks
+	Params                   = types.Params
+	QueryWithMarketIDParams  = types.QueryWithMarketIDParams
+	QueryTWAPParams          = types.QueryTWAPParams
+	QueryOracleBondParams    = types.QueryOracleBondParams
+	AddMarketProposal        = types.AddMarketProposal
+	RemoveMarketProposal     = types.RemoveMarketProposal
+	AddOracleProposal        = types.AddOracleProposal
+	RemoveOracleProposal     = types.RemoveOracleProposal
+	ActivateMarketProposal   = types.ActivateMarketProposal
+	DeactivateMarketProposal = types.DeactivateMarketProposal
+	Keeper                   = keeper.Keeper
 )
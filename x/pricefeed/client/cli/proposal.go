@@ -0,0 +1,254 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+type (
+	// AddMarketProposalJSON defines an AddMarketProposal with a deposit
+	AddMarketProposalJSON struct {
+		Title       string       `json:"title" yaml:"title"`
+		Description string       `json:"description" yaml:"description"`
+		Market      types.Market `json:"market" yaml:"market"`
+		Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	}
+
+	// MarketProposalJSON defines a proposal that changes a market, with a deposit
+	MarketProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		MarketID    string    `json:"market_id" yaml:"market_id"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+
+	// OracleProposalJSON defines a proposal that changes an oracle of a market, with a deposit
+	OracleProposalJSON struct {
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		MarketID    string         `json:"market_id" yaml:"market_id"`
+		Oracle      sdk.AccAddress `json:"oracle" yaml:"oracle"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// GetCmdSubmitAddMarketProposal implements the command to submit an add-market proposal
+func GetCmdSubmitAddMarketProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-market [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add a pricefeed market",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add a pricefeed market along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal add-market <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Add BNB market",
+  "description": "Add a bnb:usd market",
+  "market": {
+    "market_id": "bnb:usd",
+    "base_asset": "bnb",
+    "quote_asset": "usd",
+    "oracles": ["kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw"],
+    "active": true
+  },
+  "deposit": [
+    {
+      "denom": "ukava",
+      "amount": "10000000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal AddMarketProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewAddMarketProposal(proposal.Title, proposal.Description, proposal.Market)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+}
+
+// GetCmdSubmitRemoveMarketProposal implements the command to submit a remove-market proposal
+func GetCmdSubmitRemoveMarketProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-market [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to remove a pricefeed market",
+		Long:  marketProposalLong("remove-market", "Remove BNB market", "Remove the bnb:usd market and its prices"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal MarketProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewRemoveMarketProposal(proposal.Title, proposal.Description, proposal.MarketID)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+}
+
+// GetCmdSubmitAddOracleProposal implements the command to submit an add-oracle proposal
+func GetCmdSubmitAddOracleProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-oracle [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add an oracle to a pricefeed market",
+		Long:  oracleProposalLong("add-oracle", "Add BNB oracle", "Add an oracle to the bnb:usd market"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal OracleProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewAddOracleProposal(proposal.Title, proposal.Description, proposal.MarketID, proposal.Oracle)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+}
+
+// GetCmdSubmitRemoveOracleProposal implements the command to submit a remove-oracle proposal
+func GetCmdSubmitRemoveOracleProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-oracle [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to remove an oracle from a pricefeed market",
+		Long:  oracleProposalLong("remove-oracle", "Remove BNB oracle", "Remove an oracle from the bnb:usd market"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal OracleProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewRemoveOracleProposal(proposal.Title, proposal.Description, proposal.MarketID, proposal.Oracle)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+}
+
+// GetCmdSubmitActivateMarketProposal implements the command to submit an activate-market proposal
+func GetCmdSubmitActivateMarketProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "activate-market [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to activate a pricefeed market",
+		Long:  marketProposalLong("activate-market", "Activate BNB market", "Resume price updates of the bnb:usd market"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal MarketProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewActivateMarketProposal(proposal.Title, proposal.Description, proposal.MarketID)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+}
+
+// GetCmdSubmitDeactivateMarketProposal implements the command to submit a deactivate-market proposal
+func GetCmdSubmitDeactivateMarketProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deactivate-market [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to deactivate a pricefeed market",
+		Long:  marketProposalLong("deactivate-market", "Deactivate BNB market", "Stop price updates of the bnb:usd market"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var proposal MarketProposalJSON
+			if err := parseProposalJSON(cdc, args[0], &proposal); err != nil {
+				return err
+			}
+			content := types.NewDeactivateMarketProposal(proposal.Title, proposal.Description, proposal.MarketID)
+			return submitProposal(cdc, content, proposal.Deposit)
+		},
+	}
+}
+
+func marketProposalLong(use, title, description string) string {
+	return strings.TrimSpace(
+		fmt.Sprintf(`Submit a %s proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal %s <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "%s",
+  "description": "%s",
+  "market_id": "bnb:usd",
+  "deposit": [
+    {
+      "denom": "ukava",
+      "amount": "10000000"
+    }
+  ]
+}
+`, use, version.ClientName, use, title, description),
+	)
+}
+
+func oracleProposalLong(use, title, description string) string {
+	return strings.TrimSpace(
+		fmt.Sprintf(`Submit a %s proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal %s <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "%s",
+  "description": "%s",
+  "market_id": "bnb:usd",
+  "oracle": "kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw",
+  "deposit": [
+    {
+      "denom": "ukava",
+      "amount": "10000000"
+    }
+  ]
+}
+`, use, version.ClientName, use, title, description),
+	)
+}
+
+// parseProposalJSON reads and parses a proposal from a JSON file
+func parseProposalJSON(cdc *codec.Codec, proposalFile string, proposal interface{}) error {
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return err
+	}
+	return cdc.UnmarshalJSON(contents, proposal)
+}
+
+// submitProposal generates or broadcasts a msg submitting the proposal content with a deposit from the sender
+func submitProposal(cdc *codec.Codec, content gov.Content, deposit sdk.Coins) error {
+	txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	msg := gov.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
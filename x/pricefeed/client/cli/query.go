@@ -2,11 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/kava-labs/kava/x/pricefeed/types"
 	"github.com/spf13/cobra"
 )
@@ -24,8 +26,12 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	pricefeedQueryCmd.AddCommand(client.GetCommands(
 		GetCmdPrice(queryRoute, cdc),
+		GetCmdTWAP(queryRoute, cdc),
 		GetCmdRawPrices(queryRoute, cdc),
 		GetCmdOracles(queryRoute, cdc),
+		GetCmdOraclePerformance(queryRoute, cdc),
+		GetCmdOracleBond(queryRoute, cdc),
+		GetCmdRewardPool(queryRoute, cdc),
 		GetCmdMarkets(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 	)...)
@@ -62,6 +68,82 @@ func GetCmdOracles(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdOraclePerformance queries the performance of the oracles of a market
+func GetCmdOraclePerformance(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-performance [marketID]",
+		Short: "get the performance of the oracles of a market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryWithMarketIDParams(args[0]))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOraclePerformance)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var performances types.OraclePerformances
+			cdc.MustUnmarshalJSON(res, &performances)
+			return cliCtx.PrintOutput(performances)
+		},
+	}
+}
+
+// GetCmdOracleBond queries the coins bonded by an oracle
+func GetCmdOracleBond(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-bond [oracle-addr]",
+		Short: "get the coins bonded by an oracle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			oracle, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryOracleBondParams(oracle))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOracleBond)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var bond types.OracleBond
+			cdc.MustUnmarshalJSON(res, &bond)
+			return cliCtx.PrintOutput(bond)
+		},
+	}
+}
+
+// GetCmdRewardPool queries the coins available to reward oracles
+func GetCmdRewardPool(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reward-pool",
+		Short: "get the coins available to reward oracles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRewardPool)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			var pool sdk.Coins
+			cdc.MustUnmarshalJSON(res, &pool)
+			return cliCtx.PrintOutput(pool)
+		},
+	}
+}
+
 // GetCmdPrice queries the current price of an asset
 func GetCmdPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -91,6 +173,38 @@ func GetCmdPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdTWAP queries the time-weighted average price of an asset
+func GetCmdTWAP(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "twap [marketID] [window]",
+		Short:   "get the time-weighted average price for the input market",
+		Example: fmt.Sprintf("%s query %s twap btc:usd 1h", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			marketID := args[0]
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTWAPParams(marketID, window))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTWAP)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var price types.CurrentPrice
+			cdc.MustUnmarshalJSON(res, &price)
+			return cliCtx.PrintOutput(price)
+		},
+	}
+}
+
 // GetCmdRawPrices queries the current price of an asset
 func GetCmdRawPrices(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/kava-labs/kava/x/pricefeed/types"
@@ -28,6 +29,9 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	pricefeedTxCmd.AddCommand(client.PostCommands(
 		GetCmdPostPrice(cdc),
+		GetCmdBondOracle(cdc),
+		GetCmdUnbondOracle(cdc),
+		GetCmdFundRewardPool(cdc),
 	)...)
 
 	return pricefeedTxCmd
@@ -62,3 +66,81 @@ func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdBondOracle cli command for oracles bonding coins.
+func GetCmdBondOracle(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "bond [amount]",
+		Short:   "bond coins as an oracle, which are slashed after repeated outlying prices",
+		Example: fmt.Sprintf("%s tx %s bond 1000000ukava --from myOracleKey", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBondOracle(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdUnbondOracle cli command for oracles withdrawing bonded coins.
+func GetCmdUnbondOracle(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "unbond [amount]",
+		Short:   "withdraw coins bonded as an oracle",
+		Example: fmt.Sprintf("%s tx %s unbond 1000000ukava --from myOracleKey", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnbondOracle(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdFundRewardPool cli command for adding coins to the oracle reward pool.
+func GetCmdFundRewardPool(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "fund-reward-pool [amount]",
+		Short:   "add coins to the reward pool that oracles are paid from",
+		Example: fmt.Sprintf("%s tx %s fund-reward-pool 1000000ukava --from myKeyName", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundRewardPool(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
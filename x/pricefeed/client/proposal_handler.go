@@ -0,0 +1,17 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/kava-labs/kava/x/pricefeed/client/cli"
+	"github.com/kava-labs/kava/x/pricefeed/client/rest"
+)
+
+// pricefeed proposal handlers
+var (
+	AddMarketProposalHandler        = govclient.NewProposalHandler(cli.GetCmdSubmitAddMarketProposal, rest.AddMarketProposalRESTHandler)
+	RemoveMarketProposalHandler     = govclient.NewProposalHandler(cli.GetCmdSubmitRemoveMarketProposal, rest.RemoveMarketProposalRESTHandler)
+	AddOracleProposalHandler        = govclient.NewProposalHandler(cli.GetCmdSubmitAddOracleProposal, rest.AddOracleProposalRESTHandler)
+	RemoveOracleProposalHandler     = govclient.NewProposalHandler(cli.GetCmdSubmitRemoveOracleProposal, rest.RemoveOracleProposalRESTHandler)
+	ActivateMarketProposalHandler   = govclient.NewProposalHandler(cli.GetCmdSubmitActivateMarketProposal, rest.ActivateMarketProposalRESTHandler)
+	DeactivateMarketProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitDeactivateMarketProposal, rest.DeactivateMarketProposalRESTHandler)
+)
@@ -0,0 +1,152 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+type (
+	// AddMarketProposalReq defines the properties of an add market proposal request's body.
+	AddMarketProposalReq struct {
+		BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Market      types.Market   `json:"market" yaml:"market"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// MarketProposalReq defines the properties of the body of a request for a proposal that changes a market.
+	MarketProposalReq struct {
+		BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		MarketID    string         `json:"market_id" yaml:"market_id"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// OracleProposalReq defines the properties of the body of a request for a proposal that changes an oracle of a market.
+	OracleProposalReq struct {
+		BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		MarketID    string         `json:"market_id" yaml:"market_id"`
+		Oracle      sdk.AccAddress `json:"oracle" yaml:"oracle"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// AddMarketProposalRESTHandler returns the REST handler for submitting add market proposals
+func AddMarketProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_market",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req AddMarketProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			content := types.NewAddMarketProposal(req.Title, req.Description, req.Market)
+			writeProposalResponse(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// RemoveMarketProposalRESTHandler returns the REST handler for submitting remove market proposals
+func RemoveMarketProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_market",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req MarketProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			content := types.NewRemoveMarketProposal(req.Title, req.Description, req.MarketID)
+			writeProposalResponse(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// AddOracleProposalRESTHandler returns the REST handler for submitting add oracle proposals
+func AddOracleProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_oracle",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req OracleProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			content := types.NewAddOracleProposal(req.Title, req.Description, req.MarketID, req.Oracle)
+			writeProposalResponse(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// RemoveOracleProposalRESTHandler returns the REST handler for submitting remove oracle proposals
+func RemoveOracleProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_oracle",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req OracleProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			content := types.NewRemoveOracleProposal(req.Title, req.Description, req.MarketID, req.Oracle)
+			writeProposalResponse(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// ActivateMarketProposalRESTHandler returns the REST handler for submitting activate market proposals
+func ActivateMarketProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "activate_market",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req MarketProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			content := types.NewActivateMarketProposal(req.Title, req.Description, req.MarketID)
+			writeProposalResponse(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// DeactivateMarketProposalRESTHandler returns the REST handler for submitting deactivate market proposals
+func DeactivateMarketProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "deactivate_market",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req MarketProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+				return
+			}
+			content := types.NewDeactivateMarketProposal(req.Title, req.Description, req.MarketID)
+			writeProposalResponse(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// writeProposalResponse writes an unsigned tx submitting the proposal content with a deposit from the proposer
+func writeProposalResponse(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq, content gov.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg := gov.NewMsgSubmitProposal(content, deposit, proposer)
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+}
@@ -2,12 +2,15 @@ package rest
 
 import (
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 )
 
 const (
 	RestMarketID = "market_id"
+	RestWindow   = "window"
+	RestOracle   = "oracle"
 )
 
 // PostPriceReq defines the properties of a PostPrice request's body.
@@ -23,3 +26,15 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
 	registerTxRoutes(cliCtx, r)
 }
+
+// OracleBondReq defines the properties of a bond or unbond request's body.
+type OracleBondReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Amount  sdk.Coins    `json:"amount"`
+}
+
+// FundRewardPoolReq defines the properties of a fund reward pool request's body.
+type FundRewardPoolReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Amount  sdk.Coins    `json:"amount"`
+}
@@ -1,6 +1,18 @@
 /*
 
-Package pricefeed allows a group of white-listed oracles to post price information of specific markets that are tracked by the system. For each market, the module computes the median of all posted prices by white-listed oracles and takes that as the current price value.
+Package pricefeed allows a group of white-listed oracles to post price information of specific markets that are tracked by the system. For each market, the module aggregates all unexpired prices posted by white-listed oracles and takes that as the current price value. The market's aggregation method selects how: the median (the default), a trimmed mean that leaves out the market's trim fraction of the lowest and highest prices, a median weighted by the market's oracle weights, or the price of the market's trusted oracle.
+
+Each oracle's posted price for a market is stored under its own key, so posting a price does not rewrite the prices of the other oracles. Posted prices are pruned in the EndBlocker once they expire or once the account that posted them is no longer an oracle of the market, and only these live prices are exported in the genesis state.
+
+Markets can require a quorum of MinOracles oracles with unexpired prices. While fewer oracles have unexpired prices the market keeps its last price, flagged as stale with the time since which it has been stale, and no new price is published.
+
+Markets can limit how far the current price moves in one block. An aggregated price that moves further than the market's max price deviation is held, and only accepted once it has been confirmed for the market's number of confirmation blocks. A block only confirms the held price if its aggregated price is within the max price deviation of the held price, otherwise the new price is held in its place. Held prices are exported in genesis.
+
+Every change of the current price is recorded in the price history of the market, which is used to calculate time-weighted average prices over windows ending at the current block time. History older than the MaxTWAPWindow param is pruned, except the price at the start of the longest window. The price history is exported in genesis.
+
+The performance of each oracle is tracked in every block: how far its unexpired price is from the aggregated price, whether it was an outlier (further from the aggregated price than the OutlierThreshold param), and the blocks in which it had no unexpired price. Oracles can bond coins with MsgBondOracle, and after SlashThreshold consecutive outliers the SlashFraction of their bond is slashed into the reward pool, which holds the unbonded coins of the module account. Every oracle that posted a price in the block that is not an outlier is paid the OracleReward from the reward pool while it can cover it. Any account can add coins to the reward pool with MsgFundRewardPool. Bonds can be withdrawn with MsgUnbondOracle, unless the oracle has consecutive outliers in any market.
+
+Markets and their oracles are managed through governance proposals: AddMarketProposal, RemoveMarketProposal, AddOracleProposal, RemoveOracleProposal, ActivateMarketProposal and DeactivateMarketProposal. Removing a market also removes its prices and the performance of its oracles, and removing an oracle removes its posted price for the market. Proposals that would leave the markets invalid, such as removing an oracle the market's quorum or trusted oracle aggregation depends on, fail when they are executed. Modules can register PricefeedHooks to veto removing markets they depend on; the cdp module rejects removing markets its collateral or debt params are priced with.
 
 */
 package pricefeed
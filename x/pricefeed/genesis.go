@@ -1,6 +1,8 @@
 package pricefeed
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,18 +24,47 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 	}
 	params := keeper.GetParams(ctx)
 
-	// Set the current price (if any) based on what's now in the store
+	// Set the oracle bonds, which must be held by the module account
+	totalBonded := sdk.NewCoins()
+	for _, bond := range gs.OracleBonds {
+		keeper.SetOracleBond(ctx, bond)
+		totalBonded = totalBonded.Add(bond.Amount)
+	}
+	if !keeper.GetModuleAccountCoins(ctx).IsAllGTE(totalBonded) {
+		panic(fmt.Sprintf("oracle bonds %s exceed the %s module account balance", totalBonded, ModuleName))
+	}
+	for _, performance := range gs.OraclePerformances {
+		keeper.SetOraclePerformance(ctx, performance)
+	}
+
+	// Restore the exported current prices, which keep the staleness of markets below their oracle quorum
+	importedPrices := make(map[string]bool)
+	for _, cp := range gs.CurrentPrices {
+		keeper.SetCurrentPrice(ctx, cp)
+		importedPrices[cp.MarketID] = true
+	}
+
+	// Set the current price (if any) of the other markets based on what's now in the store
 	for _, market := range params.Markets {
-		if market.Active {
+		if market.Active && !importedPrices[market.MarketID] {
 			rps := keeper.GetRawPrices(ctx, market.MarketID)
 			if len(rps) > 0 {
 				err := keeper.SetCurrentPrices(ctx, market.MarketID)
-				if err != nil {
+				// markets without a valid price, such as markets below their oracle quorum, are left without one as in the EndBlocker
+				if err != nil && err.Code() != CodeInvalidPrice {
 					panic(err)
 				}
 			}
 		}
 	}
+
+	// the price history and pending prices are set after the current prices, so that they replace any recorded by setting the current prices
+	for _, observation := range gs.PriceHistory {
+		keeper.SetPriceObservation(ctx, observation)
+	}
+	for _, pp := range gs.PendingPrices {
+		keeper.SetPendingPrice(ctx, pp)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -42,14 +73,22 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	// Get the params for markets and oracles
 	params := keeper.GetParams(ctx)
 
+	// only live posts are exported, expired posts can't be set in InitGenesis
 	var postedPrices []PostedPrice
 	for _, market := range keeper.GetMarkets(ctx) {
-		pp := keeper.GetRawPrices(ctx, market.MarketID)
+		pp := keeper.GetLivePrices(ctx, market.MarketID)
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return GenesisState{
-		Params:       params,
-		PostedPrices: postedPrices,
+	// markets without a valid current price have none to export
+	var currentPrices []CurrentPrice
+	for _, market := range keeper.GetMarkets(ctx) {
+		cp, err := keeper.GetCurrentPrice(ctx, market.MarketID)
+		if err == nil {
+			currentPrices = append(currentPrices, cp)
+		}
 	}
+
+	return NewGenesisState(params, postedPrices, keeper.GetAllOracleBonds(ctx), keeper.GetAllOraclePerformances(ctx),
+		keeper.GetAllPriceHistory(ctx), keeper.GetAllPendingPrices(ctx), currentPrices)
 }
@@ -2,12 +2,14 @@ package pricefeed_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/pricefeed"
 
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 type GenesisTestSuite struct {
@@ -34,6 +36,155 @@ func (suite *GenesisTestSuite) TestValidGenState() {
 	})
 }
 
+func (suite *GenesisTestSuite) TestExportLivePrices() {
+	tApp := app.NewTestApp()
+	now := time.Now()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: now})
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateWithOracles(addrs[:2]),
+	)
+	keeper := tApp.GetPriceFeedKeeper()
+	_, err := keeper.SetPrice(ctx, addrs[1], "btc:usd", sdk.MustNewDecFromStr("8100.00"), now.Add(10*time.Minute))
+	suite.NoError(err)
+	_, err = keeper.SetPrice(ctx, addrs[2], "btc:usd", sdk.MustNewDecFromStr("8200.00"), now.Add(time.Hour))
+	suite.NoError(err)
+
+	// the expired price and the price of the account that isn't an oracle are not exported
+	gs := pricefeed.ExportGenesis(ctx.WithBlockTime(now.Add(30*time.Minute)), keeper)
+	suite.Equal(2, len(gs.PostedPrices))
+	for _, pp := range gs.PostedPrices {
+		suite.Equal(addrs[0], pp.OracleAddress)
+	}
+
+	// the exported genesis state can be imported
+	suite.NotPanics(func() {
+		app.NewTestApp().InitializeFromGenesisStates(
+			app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(gs)},
+		)
+	})
+}
+
+func (suite *GenesisTestSuite) TestExportPriceHistory() {
+	tApp := app.NewTestApp()
+	now := time.Now()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: now})
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateWithOracles(addrs),
+	)
+	keeper := tApp.GetPriceFeedKeeper()
+	_, err := keeper.SetPrice(ctx, addrs[0], "btc:usd", sdk.MustNewDecFromStr("8100.00"), now.Add(time.Hour))
+	suite.NoError(err)
+	suite.NoError(keeper.SetCurrentPrices(ctx, "btc:usd"))
+	history := keeper.GetPriceHistory(ctx, "btc:usd")
+	suite.NotEmpty(history)
+
+	gs := pricefeed.ExportGenesis(ctx, keeper)
+	suite.Equal(keeper.GetAllPriceHistory(ctx), gs.PriceHistory)
+
+	// the imported price history gives the same time-weighted average price
+	ctx = ctx.WithBlockTime(now.Add(30 * time.Minute))
+	twap, err := keeper.GetTWAP(ctx, "btc:usd", time.Hour)
+	suite.NoError(err)
+	newApp := app.NewTestApp()
+	newApp.InitializeFromGenesisStates(
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(gs)},
+	)
+	newCtx := newApp.NewContext(true, abci.Header{Height: 1, Time: now.Add(30 * time.Minute)})
+	suite.Equal(history, newApp.GetPriceFeedKeeper().GetPriceHistory(newCtx, "btc:usd"))
+	newTWAP, err := newApp.GetPriceFeedKeeper().GetTWAP(newCtx, "btc:usd", time.Hour)
+	suite.NoError(err)
+	suite.Equal(twap.Price, newTWAP.Price)
+
+	// observations of unknown markets are invalid
+	gs.PriceHistory = append(gs.PriceHistory, pricefeed.PriceObservation{MarketID: "lol:usd", Price: sdk.OneDec(), Time: now})
+	suite.Error(gs.Validate())
+}
+
+func (suite *GenesisTestSuite) TestExportPendingPrices() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateWithOracles(addrs),
+	)
+	keeper := tApp.GetPriceFeedKeeper()
+	pendingPrice := pricefeed.PendingPrice{MarketID: "btc:usd", Price: sdk.MustNewDecFromStr("4000.00"), Confirmations: 1}
+	keeper.SetPendingPrice(ctx, pendingPrice)
+
+	gs := pricefeed.ExportGenesis(ctx, keeper)
+	suite.Equal([]pricefeed.PendingPrice{pendingPrice}, gs.PendingPrices)
+	newApp := app.NewTestApp()
+	newApp.InitializeFromGenesisStates(
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(gs)},
+	)
+	imported, found := newApp.GetPriceFeedKeeper().GetPendingPrice(newApp.NewContext(true, abci.Header{}), "btc:usd")
+	suite.True(found)
+	suite.Equal(pendingPrice, imported)
+
+	// pending prices of unknown markets are invalid
+	gs.PendingPrices = append(gs.PendingPrices, pricefeed.PendingPrice{MarketID: "lol:usd", Price: sdk.OneDec()})
+	suite.Error(gs.Validate())
+}
+
+func (suite *GenesisTestSuite) TestExportStalePrices() {
+	now := time.Now()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	gs := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			MaxTWAPWindow: pricefeed.DefaultMaxTWAPWindow,
+			Markets: []pricefeed.Market{
+				pricefeed.Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: addrs, Active: true, MinOracles: 2},
+				pricefeed.Market{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: addrs, Active: true, MinOracles: 2},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			pricefeed.PostedPrice{MarketID: "btc:usd", OracleAddress: addrs[0], Price: sdk.MustNewDecFromStr("8000.00"), Expiry: now.Add(time.Hour)},
+			pricefeed.PostedPrice{MarketID: "btc:usd", OracleAddress: addrs[1], Price: sdk.MustNewDecFromStr("8200.00"), Expiry: now.Add(10 * time.Minute)},
+			pricefeed.PostedPrice{MarketID: "xrp:usd", OracleAddress: addrs[0], Price: sdk.MustNewDecFromStr("0.25"), Expiry: now.Add(time.Hour)},
+		},
+	}
+	// a market below its quorum without a previous price can be imported
+	tApp := app.NewTestApp()
+	suite.NotPanics(func() {
+		tApp.InitializeFromGenesisStates(
+			app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(gs)},
+		)
+	})
+	keeper := tApp.GetPriceFeedKeeper()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: now})
+	_, err := keeper.GetCurrentPrice(ctx, "xrp:usd")
+	suite.Error(err)
+
+	// the btc price goes stale once one of its two oracles' prices expires
+	suite.NoError(keeper.SetCurrentPrices(ctx, "btc:usd"))
+	ctx = ctx.WithBlockTime(now.Add(30 * time.Minute))
+	suite.NoError(keeper.SetCurrentPrices(ctx, "btc:usd"))
+	stalePrice, err := keeper.GetCurrentPrice(ctx, "btc:usd")
+	suite.NoError(err)
+	suite.True(stalePrice.IsStale())
+
+	exported := pricefeed.ExportGenesis(ctx, keeper)
+	suite.Equal([]pricefeed.CurrentPrice{stalePrice}, exported.CurrentPrices)
+
+	// the imported market below its quorum keeps its stale price and the time it went stale
+	newApp := app.NewTestApp()
+	suite.NotPanics(func() {
+		newApp.InitializeFromGenesisStates(
+			app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(exported)},
+		)
+	})
+	imported, err := newApp.GetPriceFeedKeeper().GetCurrentPrice(newApp.NewContext(true, abci.Header{}), "btc:usd")
+	suite.NoError(err)
+	suite.Equal(stalePrice.Price, imported.Price)
+	suite.True(stalePrice.StaleSince.Equal(imported.StaleSince))
+
+	// current prices of unknown markets are invalid
+	exported.CurrentPrices = append(exported.CurrentPrices, pricefeed.CurrentPrice{MarketID: "lol:usd", Price: sdk.OneDec()})
+	suite.Error(exported.Validate())
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
@@ -12,6 +12,12 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgPostPrice:
 			return HandleMsgPostPrice(ctx, k, msg)
+		case MsgBondOracle:
+			return HandleMsgBondOracle(ctx, k, msg)
+		case MsgUnbondOracle:
+			return HandleMsgUnbondOracle(ctx, k, msg)
+		case MsgFundRewardPool:
+			return HandleMsgFundRewardPool(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized pricefeed message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -47,3 +53,57 @@ func HandleMsgPostPrice(
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// HandleMsgBondOracle handles oracles bonding coins
+func HandleMsgBondOracle(ctx sdk.Context, k Keeper, msg MsgBondOracle) sdk.Result {
+	err := k.BondOracle(ctx, msg.Oracle, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Oracle.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// HandleMsgUnbondOracle handles oracles withdrawing bonded coins
+func HandleMsgUnbondOracle(ctx sdk.Context, k Keeper, msg MsgUnbondOracle) sdk.Result {
+	err := k.UnbondOracle(ctx, msg.Oracle, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Oracle.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// HandleMsgFundRewardPool handles accounts adding coins to the oracle reward pool
+func HandleMsgFundRewardPool(ctx sdk.Context, k Keeper, msg MsgFundRewardPool) sdk.Result {
+	err := k.FundRewardPool(ctx, msg.Depositor, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
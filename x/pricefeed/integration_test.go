@@ -12,6 +12,7 @@ import (
 func NewPricefeedGenStateMulti() app.GenesisState {
 	pfGenesis := pricefeed.GenesisState{
 		Params: pricefeed.Params{
+			MaxTWAPWindow: pricefeed.DefaultMaxTWAPWindow,
 			Markets: []pricefeed.Market{
 				pricefeed.Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
 				pricefeed.Market{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
@@ -38,6 +39,7 @@ func NewPricefeedGenStateMulti() app.GenesisState {
 func NewPricefeedGenStateWithOracles(addrs []sdk.AccAddress) app.GenesisState {
 	pfGenesis := pricefeed.GenesisState{
 		Params: pricefeed.Params{
+			MaxTWAPWindow: pricefeed.DefaultMaxTWAPWindow,
 			Markets: []pricefeed.Market{
 				pricefeed.Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: addrs, Active: true},
 				pricefeed.Market{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: addrs, Active: true},
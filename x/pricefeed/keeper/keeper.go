@@ -2,10 +2,10 @@ package keeper
 
 import (
 	"fmt"
-	"sort"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 
@@ -20,22 +20,36 @@ type Keeper struct {
 	cdc *codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace subspace.Subspace
+	// The reference to the supply keeper that holds oracle bonds and the reward pool
+	supplyKeeper types.SupplyKeeper
+	// Hooks called by modules that depend on pricefeed markets
+	hooks types.PricefeedHooks
 	// Reserved codespace
 	codespace sdk.CodespaceType
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, paramSubspace subspace.Subspace, codespace sdk.CodespaceType,
+	cdc *codec.Codec, key sdk.StoreKey, paramSubspace subspace.Subspace, sk types.SupplyKeeper, codespace sdk.CodespaceType,
 ) Keeper {
 	return Keeper{
 		paramSubspace: paramSubspace.WithKeyTable(types.ParamKeyTable()),
 		key:           key,
 		cdc:           cdc,
+		supplyKeeper:  sk,
 		codespace:     codespace,
 	}
 }
 
+// SetHooks sets the pricefeed hooks, which can only be set once
+func (k *Keeper) SetHooks(h types.PricefeedHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set pricefeed hooks twice")
+	}
+	k.hooks = h
+	return k
+}
+
 // SetPrice updates the posted price for a specific oracle
 func (k Keeper) SetPrice(
 	ctx sdk.Context,
@@ -45,28 +59,10 @@ func (k Keeper) SetPrice(
 	expiry time.Time) (types.PostedPrice, sdk.Error) {
 	// If the expiry is less than or equal to the current blockheight, we consider the price valid
 	if expiry.After(ctx.BlockTime()) {
-		store := ctx.KVStore(k.key)
-		prices := k.GetRawPrices(ctx, marketID)
-		var index int
-		found := false
-		for i := range prices {
-			if prices[i].OracleAddress.Equals(oracle) {
-				index = i
-				found = true
-				break
-			}
-		}
 		// set the price for that particular oracle
-		if found {
-			prices[index] = types.PostedPrice{
-				MarketID: marketID, OracleAddress: oracle,
-				Price: price, Expiry: expiry}
-		} else {
-			prices = append(prices, types.PostedPrice{
-				MarketID: marketID, OracleAddress: oracle,
-				Price: price, Expiry: expiry})
-			index = len(prices) - 1
-		}
+		postedPrice := types.PostedPrice{
+			MarketID: marketID, OracleAddress: oracle,
+			Price: price, Expiry: expiry, PostHeight: ctx.BlockHeight()}
 
 		// Emit an event containing the oracle's new price
 		ctx.EventManager().EmitEvent(
@@ -78,18 +74,17 @@ func (k Keeper) SetPrice(
 				sdk.NewAttribute(types.AttributeExpiry, fmt.Sprintf("%d", expiry.Unix())),
 			),
 		)
-		store.Set(
-			[]byte(types.RawPriceFeedPrefix+marketID), k.cdc.MustMarshalBinaryBare(prices),
-		)
-		return prices[index], nil
+		store := ctx.KVStore(k.key)
+		store.Set(types.RawPriceKey(marketID, oracle), k.cdc.MustMarshalBinaryBare(postedPrice))
+		return postedPrice, nil
 	}
 	return types.PostedPrice{}, types.ErrExpired(k.codespace)
 
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+// SetCurrentPrices updates the price of an asset to the aggregate of all valid oracle inputs, calculated with the market's aggregation method
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) sdk.Error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return types.ErrInvalidMarket(k.codespace, marketID)
 	}
@@ -100,35 +95,77 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) sdk.Error {
 		validPrevPrice = false
 	}
 
-	prices := k.GetRawPrices(ctx, marketID)
-	var notExpiredPrices []types.CurrentPrice
-	// filter out expired prices
-	for _, v := range prices {
-		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.CurrentPrice{
-				MarketID: v.MarketID,
-				Price:    v.Price,
-			})
+	unexpiredPrices := k.getUnexpiredPrices(ctx, marketID)
+	// keep the last price, flagged as stale, while fewer oracles than the market's quorum have unexpired prices
+	if market.MinOracles > 0 && int64(len(unexpiredPrices)) < market.MinOracles {
+		store := ctx.KVStore(k.key)
+		if !validPrevPrice {
+			store.Set(
+				[]byte(types.CurrentPricePrefix+marketID), k.cdc.MustMarshalBinaryBare(types.CurrentPrice{}),
+			)
+			return types.ErrNoValidPrice(k.codespace)
 		}
+		if !prevPrice.IsStale() {
+			prevPrice.StaleSince = ctx.BlockTime()
+			store.Set(
+				[]byte(types.CurrentPricePrefix+marketID), k.cdc.MustMarshalBinaryBare(prevPrice),
+			)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeMarketPriceStale,
+					sdk.NewAttribute(types.AttributeMarketID, fmt.Sprintf("%s", marketID)),
+					sdk.NewAttribute(types.AttributeMarketPrice, fmt.Sprintf("%s", prevPrice.Price.String())),
+				),
+			)
+		}
+		return nil
 	}
-	if len(notExpiredPrices) == 0 {
+
+	aggregatedPrice, err := k.aggregatePrice(market, unexpiredPrices)
+	if err != nil {
 		store := ctx.KVStore(k.key)
 		store.Set(
 			[]byte(types.CurrentPricePrefix+marketID), k.cdc.MustMarshalBinaryBare(types.CurrentPrice{}),
 		)
-		return types.ErrNoValidPrice(k.codespace)
+		return err
 	}
-	medianPrice := k.CalculateMedianPrice(ctx, notExpiredPrices)
+
+	// hold prices that move the price further than the market allows in one block, until later blocks confirm them.
+	// A block only confirms the held price if its price is within the max price deviation of the held price.
+	if validPrevPrice && exceedsMaxPriceDeviation(market, prevPrice.Price, aggregatedPrice) {
+		confirmations := int64(0)
+		pendingPrice, found := k.GetPendingPrice(ctx, marketID)
+		if found && !exceedsMaxPriceDeviation(market, pendingPrice.Price, aggregatedPrice) {
+			confirmations = pendingPrice.Confirmations + 1
+		}
+		if confirmations < market.ConfirmationBlocks {
+			k.SetPendingPrice(ctx, types.PendingPrice{
+				MarketID:      marketID,
+				Price:         aggregatedPrice,
+				Confirmations: confirmations,
+			})
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeMarketPriceRejected,
+					sdk.NewAttribute(types.AttributeMarketID, fmt.Sprintf("%s", marketID)),
+					sdk.NewAttribute(types.AttributeMarketPrice, fmt.Sprintf("%s", aggregatedPrice.String())),
+					sdk.NewAttribute(types.AttributeConfirmations, fmt.Sprintf("%d", confirmations)),
+				),
+			)
+			return nil
+		}
+	}
+	k.deletePendingPrice(ctx, marketID)
 
 	// check case that market price was not set in genesis
 	if validPrevPrice {
 		// only emit event if price has changed
-		if !medianPrice.Equal(prevPrice.Price) {
+		if !aggregatedPrice.Equal(prevPrice.Price) {
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeMarketPriceUpdated,
 					sdk.NewAttribute(types.AttributeMarketID, fmt.Sprintf("%s", marketID)),
-					sdk.NewAttribute(types.AttributeMarketPrice, fmt.Sprintf("%s", medianPrice.String())),
+					sdk.NewAttribute(types.AttributeMarketPrice, fmt.Sprintf("%s", aggregatedPrice.String())),
 				),
 			)
 		}
@@ -137,45 +174,148 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) sdk.Error {
 	store := ctx.KVStore(k.key)
 	currentPrice := types.CurrentPrice{
 		MarketID: marketID,
-		Price:    medianPrice,
+		Price:    aggregatedPrice,
 	}
 
 	store.Set(
 		[]byte(types.CurrentPricePrefix+marketID), k.cdc.MustMarshalBinaryBare(currentPrice),
 	)
+	if !validPrevPrice || !aggregatedPrice.Equal(prevPrice.Price) {
+		k.recordPrice(ctx, marketID, aggregatedPrice)
+	}
 
 	return nil
 }
 
-// CalculateMedianPrice calculates the median prices for the input prices.
-func (k Keeper) CalculateMedianPrice(ctx sdk.Context, prices []types.CurrentPrice) sdk.Dec {
-	l := len(prices)
+// recordPrice stores a price observation of a market at the current block time,
+// and prunes the observations that are no longer needed to calculate time-weighted average prices
+func (k Keeper) recordPrice(ctx sdk.Context, marketID string, price sdk.Dec) {
+	store := ctx.KVStore(k.key)
+	k.SetPriceObservation(ctx, types.PriceObservation{
+		MarketID: marketID,
+		Price:    price,
+		Time:     ctx.BlockTime(),
+	})
 
-	if l == 1 {
-		// Return immediately if there's only one price
-		return prices[0].Price
+	// the latest observation before the cutoff is kept, as it is the price at the start of the longest window
+	cutoff := ctx.BlockTime().Add(-k.GetParams(ctx).MaxTWAPWindow)
+	iterator := store.Iterator(types.PriceHistoryMarketPrefix(marketID), types.PriceHistoryKey(marketID, cutoff))
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
 	}
-	// sort the prices
-	sort.Slice(prices, func(i, j int) bool {
-		return prices[i].Price.LT(prices[j].Price)
-	})
-	// for even numbers of prices, the median is calculated as the mean of the two middle prices
-	if l%2 == 0 {
-		median := k.calculateMeanPrice(ctx, prices[l/2-1:l/2+1])
-		return median
+	iterator.Close()
+	for i := 0; i < len(expiredKeys)-1; i++ {
+		store.Delete(expiredKeys[i])
+	}
+}
+
+// SetPriceObservation stores a price observation of a market
+func (k Keeper) SetPriceObservation(ctx sdk.Context, observation types.PriceObservation) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceHistoryKey(observation.MarketID, observation.Time), k.cdc.MustMarshalBinaryBare(observation))
+}
+
+// GetPriceHistory returns the recorded price observations of a market, ordered by time
+func (k Keeper) GetPriceHistory(ctx sdk.Context, marketID string) []types.PriceObservation {
+	return k.getPriceHistory(ctx, types.PriceHistoryMarketPrefix(marketID))
+}
+
+// GetAllPriceHistory returns the recorded price observations of every market, ordered by market and time
+func (k Keeper) GetAllPriceHistory(ctx sdk.Context) []types.PriceObservation {
+	return k.getPriceHistory(ctx, []byte(types.PriceHistoryPrefix))
+}
+
+func (k Keeper) getPriceHistory(ctx sdk.Context, keyPrefix []byte) []types.PriceObservation {
+	store := prefix.NewStore(ctx.KVStore(k.key), keyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	var observations []types.PriceObservation
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.PriceObservation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &observation)
+		observations = append(observations, observation)
+	}
+	return observations
+}
+
+// GetTWAP returns the time-weighted average of the current price of a market over the window ending at the current block time.
+// If the price history is shorter than the window, the average is taken over the whole price history.
+func (k Keeper) GetTWAP(ctx sdk.Context, marketID string, window time.Duration) (types.CurrentPrice, sdk.Error) {
+	maxWindow := k.GetParams(ctx).MaxTWAPWindow
+	if window <= 0 || window > maxWindow {
+		return types.CurrentPrice{}, types.ErrInvalidTWAPWindow(k.codespace, window, maxWindow)
+	}
+	// there is no average while the market has no valid current price
+	if _, err := k.GetCurrentPrice(ctx, marketID); err != nil {
+		return types.CurrentPrice{}, err
+	}
+	observations := k.GetPriceHistory(ctx, marketID)
+	if len(observations) == 0 {
+		return types.CurrentPrice{}, types.ErrNoValidPrice(k.codespace)
+	}
+	end := ctx.BlockTime()
+	start := end.Add(-window)
+	weightedSum := sdk.ZeroDec()
+	totalWeight := sdk.ZeroDec()
+	for i, observation := range observations {
+		periodStart := observation.Time
+		if periodStart.Before(start) {
+			periodStart = start
+		}
+		periodEnd := end
+		if i+1 < len(observations) {
+			periodEnd = observations[i+1].Time
+		}
+		if !periodEnd.After(periodStart) {
+			continue
+		}
+		weight := sdk.NewDec(int64(periodEnd.Sub(periodStart) / time.Second))
+		weightedSum = weightedSum.Add(observation.Price.Mul(weight))
+		totalWeight = totalWeight.Add(weight)
+	}
+	// the price history only covers the current block, so the average is the latest price
+	if totalWeight.IsZero() {
+		return types.CurrentPrice{MarketID: marketID, Price: observations[len(observations)-1].Price}, nil
 	}
-	// for odd numbers of prices, return the middle element
-	return prices[l/2].Price
+	return types.CurrentPrice{MarketID: marketID, Price: weightedSum.Quo(totalWeight)}, nil
+}
 
+// exceedsMaxPriceDeviation returns true if the new price differs from the previous price by more than the market's max price deviation
+func exceedsMaxPriceDeviation(market types.Market, prevPrice sdk.Dec, newPrice sdk.Dec) bool {
+	if market.MaxPriceDeviation.IsNil() || !market.MaxPriceDeviation.IsPositive() {
+		return false
+	}
+	deviation := newPrice.Sub(prevPrice).Abs().Quo(prevPrice)
+	return deviation.GT(market.MaxPriceDeviation)
 }
 
-func (k Keeper) calculateMeanPrice(ctx sdk.Context, prices []types.CurrentPrice) sdk.Dec {
-	sum := prices[0].Price.Add(prices[1].Price)
-	mean := sum.Quo(sdk.NewDec(2))
-	return mean
+// getUnexpiredPrices returns the prices posted for a market that have not expired
+func (k Keeper) getUnexpiredPrices(ctx sdk.Context, marketID string) []types.PostedPrice {
+	var unexpiredPrices []types.PostedPrice
+	k.IterateRawPrices(ctx, marketID, func(pp types.PostedPrice) bool {
+		if pp.Expiry.After(ctx.BlockTime()) {
+			unexpiredPrices = append(unexpiredPrices, pp)
+		}
+		return false
+	})
+	return unexpiredPrices
 }
 
-// GetCurrentPrice fetches the current median price of all oracles for a specific market
+// aggregatePrice calculates the price of a market from the unexpired prices of its oracles with the market's aggregation method
+func (k Keeper) aggregatePrice(market types.Market, unexpiredPrices []types.PostedPrice) (sdk.Dec, sdk.Error) {
+	aggregator, found := types.GetAggregator(market.Aggregation)
+	if !found || len(unexpiredPrices) == 0 {
+		return sdk.Dec{}, types.ErrNoValidPrice(k.codespace)
+	}
+	price, ok := aggregator.Aggregate(market, unexpiredPrices)
+	if !ok {
+		return sdk.Dec{}, types.ErrNoValidPrice(k.codespace)
+	}
+	return price, nil
+}
+
+// GetCurrentPrice fetches the current price of all oracles for a specific market
 func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, sdk.Error) {
 	store := ctx.KVStore(k.key)
 	bz := store.Get([]byte(types.CurrentPricePrefix + marketID))
@@ -191,15 +331,133 @@ func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.Current
 	return price, nil
 }
 
-// GetRawPrices fetches the set of all prices posted by oracles for an asset
-func (k Keeper) GetRawPrices(ctx sdk.Context, marketID string) []types.PostedPrice {
+// SetCurrentPrice sets the current price of a market, including the time since which it is stale
+func (k Keeper) SetCurrentPrice(ctx sdk.Context, price types.CurrentPrice) {
 	store := ctx.KVStore(k.key)
-	bz := store.Get([]byte(types.RawPriceFeedPrefix + marketID))
+	store.Set([]byte(types.CurrentPricePrefix+price.MarketID), k.cdc.MustMarshalBinaryBare(price))
+}
+
+// GetPendingPrice fetches the price held by the price deviation guard for a specific market
+func (k Keeper) GetPendingPrice(ctx sdk.Context, marketID string) (types.PendingPrice, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get([]byte(types.PendingPricePrefix + marketID))
+	if bz == nil {
+		return types.PendingPrice{}, false
+	}
+	var price types.PendingPrice
+	k.cdc.MustUnmarshalBinaryBare(bz, &price)
+	return price, true
+}
+
+// GetAllPendingPrices returns the prices held by the price deviation guard for every market
+func (k Keeper) GetAllPendingPrices(ctx sdk.Context) []types.PendingPrice {
+	store := prefix.NewStore(ctx.KVStore(k.key), []byte(types.PendingPricePrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	var prices []types.PendingPrice
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.PendingPrice
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+	return prices
+}
+
+// SetPendingPrice sets the price held by the price deviation guard for a market
+func (k Keeper) SetPendingPrice(ctx sdk.Context, price types.PendingPrice) {
+	store := ctx.KVStore(k.key)
+	store.Set([]byte(types.PendingPricePrefix+price.MarketID), k.cdc.MustMarshalBinaryBare(price))
+}
+
+func (k Keeper) deletePendingPrice(ctx sdk.Context, marketID string) {
+	store := ctx.KVStore(k.key)
+	store.Delete([]byte(types.PendingPricePrefix + marketID))
+}
+
+// GetRawPrices fetches the set of all prices posted by oracles for an asset, ordered by oracle address
+func (k Keeper) GetRawPrices(ctx sdk.Context, marketID string) []types.PostedPrice {
 	var prices []types.PostedPrice
-	k.cdc.MustUnmarshalBinaryBare(bz, &prices)
+	k.IterateRawPrices(ctx, marketID, func(pp types.PostedPrice) bool {
+		prices = append(prices, pp)
+		return false
+	})
 	return prices
 }
 
+// GetRawPrice returns the price posted by an oracle for an asset
+func (k Keeper) GetRawPrice(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.PostedPrice, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.RawPriceKey(marketID, oracle))
+	if bz == nil {
+		return types.PostedPrice{}, false
+	}
+	var price types.PostedPrice
+	k.cdc.MustUnmarshalBinaryBare(bz, &price)
+	return price, true
+}
+
+// IterateRawPrices iterates over the prices posted for an asset in order of oracle address, stopping when the callback returns true
+func (k Keeper) IterateRawPrices(ctx sdk.Context, marketID string, cb func(pp types.PostedPrice) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RawPriceMarketPrefix(marketID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pp types.PostedPrice
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pp)
+		if cb(pp) {
+			break
+		}
+	}
+}
+
+// GetLivePrices returns the prices posted for a market that have not expired and were posted by current oracles of the market
+func (k Keeper) GetLivePrices(ctx sdk.Context, marketID string) []types.PostedPrice {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return nil
+	}
+	var livePrices []types.PostedPrice
+	for _, pp := range k.getUnexpiredPrices(ctx, marketID) {
+		if market.IsOracle(pp.OracleAddress) {
+			livePrices = append(livePrices, pp)
+		}
+	}
+	return livePrices
+}
+
+// PruneRawPrices deletes the prices posted for a market that have expired or were posted by accounts that are no longer oracles of the market
+func (k Keeper) PruneRawPrices(ctx sdk.Context, marketID string) {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return
+	}
+	var pruned []sdk.AccAddress
+	k.IterateRawPrices(ctx, marketID, func(pp types.PostedPrice) bool {
+		if !pp.Expiry.After(ctx.BlockTime()) || !market.IsOracle(pp.OracleAddress) {
+			pruned = append(pruned, pp.OracleAddress)
+		}
+		return false
+	})
+	if len(pruned) == 0 {
+		return
+	}
+	for _, oracle := range pruned {
+		k.deleteRawPrice(ctx, marketID, oracle)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRawPricesPruned,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributePrunedPrices, fmt.Sprintf("%d", len(pruned))),
+		),
+	)
+}
+
+func (k Keeper) deleteRawPrice(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.RawPriceKey(marketID, oracle))
+}
+
 // Codespace return the codespace for the keeper
 func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
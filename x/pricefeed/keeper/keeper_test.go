@@ -89,6 +89,25 @@ func TestKeeper_GetSetPrice(t *testing.T) {
 	require.NoError(t, err)
 	rawPrices = keeper.GetRawPrices(ctx, "tstusd")
 	require.Equal(t, rawPrices[0].Price.Equal(sdk.MustNewDecFromStr("0.37")), true)
+
+	// each oracle's price is stored separately
+	pp, found := keeper.GetRawPrice(ctx, "tstusd", addrs[0])
+	require.True(t, found)
+	require.Equal(t, pp.Price.Equal(sdk.MustNewDecFromStr("0.37")), true)
+	pp, found = keeper.GetRawPrice(ctx, "tstusd", addrs[1])
+	require.True(t, found)
+	require.Equal(t, pp.Price.Equal(sdk.MustNewDecFromStr("0.35")), true)
+	_, found = keeper.GetRawPrice(ctx, "tstusd", sdk.AccAddress("notAnOracle"))
+	require.False(t, found)
+
+	// prices of markets with IDs that are prefixes of each other are kept apart
+	_, err = keeper.SetPrice(
+		ctx, addrs[0], "tst",
+		sdk.MustNewDecFromStr("0.5"),
+		time.Now().Add(time.Hour*1))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keeper.GetRawPrices(ctx, "tstusd")))
+	require.Equal(t, 1, len(keeper.GetRawPrices(ctx, "tst")))
 }
 
 // TestKeeper_GetSetCurrentPrice Test Setting the median price of an Asset
@@ -135,3 +154,211 @@ func TestKeeper_GetSetCurrentPrice(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, price.Price.Equal(sdk.MustNewDecFromStr("0.345")), true)
 }
+
+// TestKeeper_SetCurrentPricesAggregation tests that the current price is calculated with the market's aggregation method
+func TestKeeper_SetCurrentPricesAggregation(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true,
+				Aggregation: types.AggregationTrustedOracle, TrustedOracle: addrs[2]},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+	for i, price := range []string{"0.33", "0.34"} {
+		_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), time.Now().Add(time.Hour*1))
+		require.NoError(t, err)
+	}
+	// no price from the trusted oracle
+	err := keeper.SetCurrentPrices(ctx, "tstusd")
+	require.Error(t, err)
+
+	_, err = keeper.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("0.40"), time.Now().Add(time.Hour*1))
+	require.NoError(t, err)
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.Nil(t, err)
+	require.True(t, price.Price.Equal(sdk.MustNewDecFromStr("0.40")))
+}
+
+// TestKeeper_PriceDeviationGuard tests that large price moves are held until they are confirmed
+func TestKeeper_PriceDeviationGuard(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true,
+				MaxPriceDeviation: sdk.MustNewDecFromStr("0.1"), ConfirmationBlocks: 2},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+	keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("1.00"), time.Now().Add(time.Hour*1))
+	err := keeper.SetCurrentPrices(ctx, "tstusd")
+	require.NoError(t, err)
+
+	// moves within the max deviation are accepted immediately
+	keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("1.10"), time.Now().Add(time.Hour*1))
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.10"), price.Price)
+
+	// larger moves are held until they have been confirmed by two further blocks
+	keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("0.50"), time.Now().Add(time.Hour*1))
+	for i := 0; i < 2; i++ {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		err = keeper.SetCurrentPrices(ctx, "tstusd")
+		require.NoError(t, err)
+		price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr("1.10"), price.Price)
+		require.Equal(t, types.EventTypeMarketPriceRejected, ctx.EventManager().Events()[0].Type)
+		pendingPrice, found := keeper.GetPendingPrice(ctx, "tstusd")
+		require.True(t, found)
+		require.Equal(t, int64(i), pendingPrice.Confirmations)
+	}
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.50"), price.Price)
+	_, found := keeper.GetPendingPrice(ctx, "tstusd")
+	require.False(t, found)
+
+	// a held price is dropped if the median returns within the max deviation
+	keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("1.00"), time.Now().Add(time.Hour*1))
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("0.52"), time.Now().Add(time.Hour*1))
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.52"), price.Price)
+	_, found = keeper.GetPendingPrice(ctx, "tstusd")
+	require.False(t, found)
+
+	// a held price is only confirmed by prices within the max deviation of it
+	for _, tc := range []struct {
+		price         string
+		confirmations int64
+	}{
+		{"1.50", 0},
+		{"2.50", 0},
+		{"2.55", 1},
+		{"1.50", 0},
+	} {
+		keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(tc.price), time.Now().Add(time.Hour*1))
+		err = keeper.SetCurrentPrices(ctx, "tstusd")
+		require.NoError(t, err)
+		pendingPrice, found := keeper.GetPendingPrice(ctx, "tstusd")
+		require.True(t, found)
+		require.Equal(t, tc.confirmations, pendingPrice.Confirmations)
+	}
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.52"), price.Price)
+}
+
+// TestKeeper_GetTWAP tests the time-weighted average price of a market
+func TestKeeper_GetTWAP(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+		},
+		MaxTWAPWindow: time.Hour * 2,
+	}
+	keeper.SetParams(ctx, mp)
+	_, err := keeper.GetTWAP(ctx, "tstusd", time.Hour)
+	require.Error(t, err)
+
+	setPrice := func(price string, elapsed time.Duration) {
+		ctx = ctx.WithBlockTime(startTime.Add(elapsed))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour*24))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	}
+	setPrice("1.00", 0)
+	twap, err := keeper.GetTWAP(ctx, "tstusd", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.00"), twap.Price)
+
+	setPrice("2.00", time.Minute*30)
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	twap, err = keeper.GetTWAP(ctx, "tstusd", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.50"), twap.Price)
+	twap, err = keeper.GetTWAP(ctx, "tstusd", time.Minute*30)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2.00"), twap.Price)
+	// windows longer than the price history average over the whole history
+	twap, err = keeper.GetTWAP(ctx, "tstusd", time.Hour*2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.50"), twap.Price)
+
+	_, err = keeper.GetTWAP(ctx, "tstusd", time.Hour*3)
+	require.Error(t, err)
+	_, err = keeper.GetTWAP(ctx, "tstusd", 0)
+	require.Error(t, err)
+
+	// observations older than the max window are pruned, except the price at the start of the max window
+	setPrice("3.00", time.Hour*4)
+	require.Equal(t, 2, len(keeper.GetPriceHistory(ctx, "tstusd")))
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 5))
+	twap, err = keeper.GetTWAP(ctx, "tstusd", time.Hour*2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2.50"), twap.Price)
+}
+
+// TestKeeper_PruneRawPrices tests that expired prices and prices posted by accounts that are no longer oracles are pruned
+func TestKeeper_PruneRawPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	now := time.Now()
+	ctx := tApp.NewContext(true, abci.Header{Time: now})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{addrs[0], addrs[1]}, Active: true},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("0.33"), now.Add(time.Hour))
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("0.34"), now.Add(10*time.Minute))
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("0.35"), now.Add(time.Hour))
+	require.NoError(t, err)
+
+	// the price of the account that isn't an oracle is pruned
+	keeper.PruneRawPrices(ctx, "tstusd")
+	require.Equal(t, 2, len(keeper.GetRawPrices(ctx, "tstusd")))
+
+	// expired prices are pruned
+	ctx = ctx.WithBlockTime(now.Add(30 * time.Minute))
+	keeper.PruneRawPrices(ctx, "tstusd")
+	rawPrices := keeper.GetRawPrices(ctx, "tstusd")
+	require.Equal(t, 1, len(rawPrices))
+	require.Equal(t, addrs[0], rawPrices[0].OracleAddress)
+
+	// the market's posted prices are deleted once they have all expired
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	keeper.PruneRawPrices(ctx, "tstusd")
+	require.Equal(t, 0, len(keeper.GetRawPrices(ctx, "tstusd")))
+}
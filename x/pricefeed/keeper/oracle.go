@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// UpdateOraclePerformance records how far the unexpired price of each oracle of a market is from the price aggregated
+// with the market's aggregation method in the current block,
+// or that the oracle missed the window if it has no unexpired price. Outliers are only counted for prices posted in the current block.
+// Oracles that posted prices in the current block that are not outliers are paid the oracle reward from the reward pool,
+// and oracles whose consecutive outliers reach the slash threshold have their bond slashed.
+// The performance of the market's oracles is only updated if it can be updated for every oracle.
+func (k Keeper) UpdateOraclePerformance(ctx sdk.Context, marketID string) sdk.Error {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return types.ErrInvalidMarket(k.codespace, marketID)
+	}
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.updateOraclePerformance(cacheCtx, market); err != nil {
+		return err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+func (k Keeper) updateOraclePerformance(ctx sdk.Context, market types.Market) sdk.Error {
+	marketID := market.MarketID
+	params := k.GetParams(ctx)
+
+	unexpiredPrices := k.getUnexpiredPrices(ctx, marketID)
+	postedPrices := make(map[string]types.PostedPrice)
+	for _, pp := range unexpiredPrices {
+		postedPrices[pp.OracleAddress.String()] = pp
+	}
+	aggregatedPrice, err := k.aggregatePrice(market, unexpiredPrices)
+	if err != nil {
+		aggregatedPrice = sdk.ZeroDec()
+	}
+
+	for _, oracle := range market.Oracles {
+		performance, found := k.GetOraclePerformance(ctx, marketID, oracle)
+		if !found {
+			performance = types.NewOraclePerformance(marketID, oracle)
+		}
+		performance.Windows++
+		pp, posted := postedPrices[oracle.String()]
+		if !posted {
+			performance.MissedWindows++
+			k.SetOraclePerformance(ctx, performance)
+			continue
+		}
+		if !aggregatedPrice.IsPositive() {
+			k.SetOraclePerformance(ctx, performance)
+			continue
+		}
+		deviation := pp.Price.Sub(aggregatedPrice).Abs().Quo(aggregatedPrice)
+		performance.TotalDeviation = performance.TotalDeviation.Add(deviation)
+		performance.LastDeviation = deviation
+
+		// a price is only counted as an outlier or rewarded in the window it was posted in, not in every window until it expires
+		if pp.PostHeight != ctx.BlockHeight() {
+			k.SetOraclePerformance(ctx, performance)
+			continue
+		}
+		if params.OutlierThreshold.IsPositive() && deviation.GT(params.OutlierThreshold) {
+			performance.Outliers++
+			performance.ConsecutiveOutliers++
+			if params.SlashThreshold > 0 && performance.ConsecutiveOutliers >= params.SlashThreshold {
+				k.slashOracle(ctx, marketID, oracle, params.SlashFraction)
+				performance.ConsecutiveOutliers = 0
+			}
+		} else {
+			performance.ConsecutiveOutliers = 0
+			if err := k.payOracleReward(ctx, marketID, oracle, params.OracleReward); err != nil {
+				return err
+			}
+		}
+		k.SetOraclePerformance(ctx, performance)
+	}
+	return nil
+}
+
+// payOracleReward sends the oracle reward to the oracle, if the reward pool holds enough coins to pay it
+func (k Keeper) payOracleReward(ctx sdk.Context, marketID string, oracle sdk.AccAddress, reward sdk.Coins) sdk.Error {
+	if reward.IsZero() {
+		return nil
+	}
+	pool, err := k.GetRewardPool(ctx)
+	if err != nil {
+		return err
+	}
+	if !pool.IsAllGTE(reward) {
+		return nil
+	}
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, oracle, reward)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleRewarded,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeAmount, reward.String()),
+		),
+	)
+	return nil
+}
+
+// slashOracle removes the slash fraction of each coin from the oracle's bond. The slashed coins stay in the
+// module account, where they are added to the reward pool.
+func (k Keeper) slashOracle(ctx sdk.Context, marketID string, oracle sdk.AccAddress, fraction sdk.Dec) {
+	bond, found := k.GetOracleBond(ctx, oracle)
+	if !found || fraction.IsNil() || !fraction.IsPositive() {
+		return
+	}
+	slashed := sdk.NewCoins()
+	for _, coin := range bond.Amount {
+		slashed = slashed.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(fraction).TruncateInt())))
+	}
+	if slashed.IsZero() {
+		return
+	}
+	bond.Amount = bond.Amount.Sub(slashed)
+	k.SetOracleBond(ctx, bond)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleSlashed,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeAmount, slashed.String()),
+		),
+	)
+}
+
+// BondOracle sends coins from an oracle to the module account and adds them to the oracle's bond
+func (k Keeper) BondOracle(ctx sdk.Context, oracle sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	if !k.isOracle(ctx, oracle) {
+		return types.ErrInvalidOracle(k.codespace, oracle)
+	}
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, oracle, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+	bond, found := k.GetOracleBond(ctx, oracle)
+	if !found {
+		bond = types.NewOracleBond(oracle, sdk.NewCoins())
+	}
+	bond.Amount = bond.Amount.Add(amount)
+	k.SetOracleBond(ctx, bond)
+	return nil
+}
+
+// UnbondOracle removes coins from an oracle's bond and returns them to the oracle.
+// Oracles can't unbond while they have consecutive outliers in any market, so that they can't avoid being slashed.
+func (k Keeper) UnbondOracle(ctx sdk.Context, oracle sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	bond, found := k.GetOracleBond(ctx, oracle)
+	if !found || !bond.Amount.IsAllGTE(amount) {
+		return types.ErrInsufficientBond(k.codespace, oracle, bond.Amount, amount)
+	}
+	for _, market := range k.GetMarkets(ctx) {
+		performance, found := k.GetOraclePerformance(ctx, market.MarketID, oracle)
+		if found && performance.ConsecutiveOutliers > 0 {
+			return types.ErrOutstandingOutliers(k.codespace, oracle, market.MarketID)
+		}
+	}
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, oracle, amount)
+	if err != nil {
+		return err
+	}
+	bond.Amount = bond.Amount.Sub(amount)
+	k.SetOracleBond(ctx, bond)
+	return nil
+}
+
+// isOracle returns true if the address is an oracle of any market
+func (k Keeper) isOracle(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, market := range k.GetMarkets(ctx) {
+		for _, oracle := range market.Oracles {
+			if oracle.Equals(address) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetModuleAccountCoins returns the coins held by the module account, which are the oracle bonds and the reward pool
+func (k Keeper) GetModuleAccountCoins(ctx sdk.Context) sdk.Coins {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+}
+
+// GetRewardPool returns the coins in the module account that are not bonded by oracles
+func (k Keeper) GetRewardPool(ctx sdk.Context) (sdk.Coins, sdk.Error) {
+	coins := k.GetModuleAccountCoins(ctx)
+	totalBonded := sdk.NewCoins()
+	for _, bond := range k.GetAllOracleBonds(ctx) {
+		totalBonded = totalBonded.Add(bond.Amount)
+	}
+	pool, negative := coins.SafeSub(totalBonded)
+	if negative {
+		return nil, types.ErrBondsExceedBalance(k.codespace, totalBonded, coins)
+	}
+	return pool, nil
+}
+
+// FundRewardPool sends coins from an account to the module account, where they are added to the reward pool
+func (k Keeper) FundRewardPool(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardPoolFunded,
+			sdk.NewAttribute(types.AttributeDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// GetOraclePerformance returns the performance of an oracle for a market
+func (k Keeper) GetOraclePerformance(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OraclePerformance, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OraclePerformanceKey(marketID, oracle))
+	if bz == nil {
+		return types.OraclePerformance{}, false
+	}
+	var performance types.OraclePerformance
+	k.cdc.MustUnmarshalBinaryBare(bz, &performance)
+	return performance, true
+}
+
+// SetOraclePerformance sets the performance of an oracle for a market
+func (k Keeper) SetOraclePerformance(ctx sdk.Context, performance types.OraclePerformance) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OraclePerformanceKey(performance.MarketID, performance.OracleAddress), k.cdc.MustMarshalBinaryBare(performance))
+}
+
+// GetOraclePerformances returns the performance of each oracle that has been tracked for a market
+func (k Keeper) GetOraclePerformances(ctx sdk.Context, marketID string) types.OraclePerformances {
+	return k.getOraclePerformances(ctx, types.OraclePerformanceMarketPrefix(marketID))
+}
+
+// GetAllOraclePerformances returns the performance of each oracle that has been tracked for any market
+func (k Keeper) GetAllOraclePerformances(ctx sdk.Context) types.OraclePerformances {
+	return k.getOraclePerformances(ctx, []byte(types.OraclePerformancePrefix))
+}
+
+func (k Keeper) getOraclePerformances(ctx sdk.Context, keyPrefix []byte) types.OraclePerformances {
+	store := prefix.NewStore(ctx.KVStore(k.key), keyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	performances := types.OraclePerformances{}
+	for ; iterator.Valid(); iterator.Next() {
+		var performance types.OraclePerformance
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &performance)
+		performances = append(performances, performance)
+	}
+	return performances
+}
+
+// GetOracleBond returns the coins bonded by an oracle
+func (k Keeper) GetOracleBond(ctx sdk.Context, oracle sdk.AccAddress) (types.OracleBond, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleBondKey(oracle))
+	if bz == nil {
+		return types.OracleBond{}, false
+	}
+	var bond types.OracleBond
+	k.cdc.MustUnmarshalBinaryBare(bz, &bond)
+	return bond, true
+}
+
+// SetOracleBond sets the coins bonded by an oracle, deleting the bond if it is empty
+func (k Keeper) SetOracleBond(ctx sdk.Context, bond types.OracleBond) {
+	store := ctx.KVStore(k.key)
+	if bond.Amount.IsZero() {
+		store.Delete(types.OracleBondKey(bond.OracleAddress))
+		return
+	}
+	store.Set(types.OracleBondKey(bond.OracleAddress), k.cdc.MustMarshalBinaryBare(bond))
+}
+
+// GetAllOracleBonds returns the bonds of all oracles
+func (k Keeper) GetAllOracleBonds(ctx sdk.Context) types.OracleBonds {
+	store := prefix.NewStore(ctx.KVStore(k.key), []byte(types.OracleBondPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	bonds := types.OracleBonds{}
+	for ; iterator.Valid(); iterator.Next() {
+		var bond types.OracleBond
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &bond)
+		bonds = append(bonds, bond)
+	}
+	return bonds
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_OraclePerformance tests oracle performance tracking, rewards and slashing
+func TestKeeper_OraclePerformance(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Now()})
+	coins := []sdk.Coins{}
+	for range addrs {
+		coins = append(coins, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)))
+	}
+	pfGenesis := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			MaxTWAPWindow: pricefeed.DefaultMaxTWAPWindow,
+			Markets: []pricefeed.Market{
+				pricefeed.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs[:4], Active: true},
+			},
+			OutlierThreshold: sdk.MustNewDecFromStr("0.1"),
+			SlashThreshold:   2,
+			SlashFraction:    sdk.MustNewDecFromStr("0.5"),
+			OracleReward:     sdk.NewCoins(sdk.NewInt64Coin("ukava", 10)),
+		},
+	}
+	tApp.InitializeFromGenesisStates(
+		app.NewAuthGenState(addrs, coins),
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pfGenesis)},
+	)
+	keeper := tApp.GetPriceFeedKeeper()
+	ak := tApp.GetAccountKeeper()
+
+	// only oracles can bond
+	err := keeper.BondOracle(ctx, addrs[4], sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)))
+	require.Error(t, err)
+	require.NoError(t, keeper.BondOracle(ctx, addrs[2], sdk.NewCoins(sdk.NewInt64Coin("ukava", 100))))
+	require.NoError(t, keeper.FundRewardPool(ctx, addrs[4], sdk.NewCoins(sdk.NewInt64Coin("ukava", 100))))
+	pool, err := keeper.GetRewardPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)), pool)
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	for i, price := range []string{"1.00", "1.02", "1.50"} {
+		_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), expiry)
+		require.NoError(t, err)
+	}
+	require.NoError(t, keeper.UpdateOraclePerformance(ctx, "tstusd"))
+
+	performance, found := keeper.GetOraclePerformance(ctx, "tstusd", addrs[1])
+	require.True(t, found)
+	require.Equal(t, int64(1), performance.Windows)
+	require.Equal(t, int64(0), performance.Outliers)
+	require.Equal(t, sdk.ZeroDec(), performance.LastDeviation)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1010)), ak.GetAccount(ctx, addrs[1]).GetCoins())
+
+	performance, _ = keeper.GetOraclePerformance(ctx, "tstusd", addrs[2])
+	require.Equal(t, int64(1), performance.Outliers)
+	require.Equal(t, int64(1), performance.ConsecutiveOutliers)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 900)), ak.GetAccount(ctx, addrs[2]).GetCoins())
+	// oracles can't unbond while they have outliers that count towards a slash
+	err = keeper.UnbondOracle(ctx, addrs[2], sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)))
+	require.Equal(t, types.CodeOutstandingOutliers, err.Result().Code)
+
+	performance, _ = keeper.GetOraclePerformance(ctx, "tstusd", addrs[3])
+	require.Equal(t, int64(1), performance.MissedWindows)
+
+	// a second consecutive outlier slashes half of the bond into the reward pool,
+	// and prices posted in earlier blocks are not rewarded again
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = keeper.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("1.50"), expiry)
+	require.NoError(t, err)
+	require.NoError(t, keeper.UpdateOraclePerformance(ctx, "tstusd"))
+	performance, _ = keeper.GetOraclePerformance(ctx, "tstusd", addrs[2])
+	require.Equal(t, int64(2), performance.Outliers)
+	require.Equal(t, int64(0), performance.ConsecutiveOutliers)
+	bond, found := keeper.GetOracleBond(ctx, addrs[2])
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 50)), bond.Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1010)), ak.GetAccount(ctx, addrs[1]).GetCoins())
+	pool, err = keeper.GetRewardPool(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 130)), pool)
+
+	err = keeper.UnbondOracle(ctx, addrs[2], sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)))
+	require.Equal(t, types.CodeInsufficientBond, err.Result().Code)
+	require.NoError(t, keeper.UnbondOracle(ctx, addrs[2], sdk.NewCoins(sdk.NewInt64Coin("ukava", 50))))
+	_, found = keeper.GetOracleBond(ctx, addrs[2])
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 950)), ak.GetAccount(ctx, addrs[2]).GetCoins())
+
+	// if the reward pool can't be calculated, no oracle's performance is updated
+	keeper.SetOracleBond(ctx, types.NewOracleBond(addrs[3], sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000))))
+	_, err = keeper.GetRewardPool(ctx)
+	require.Equal(t, types.CodeBondsExceedBalance, err.Result().Code)
+	_, err = keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("1.00"), expiry)
+	require.NoError(t, err)
+	err = keeper.UpdateOraclePerformance(ctx, "tstusd")
+	require.Equal(t, types.CodeBondsExceedBalance, err.Result().Code)
+	for _, oracle := range addrs[:4] {
+		performance, _ = keeper.GetOraclePerformance(ctx, "tstusd", oracle)
+		require.Equal(t, int64(2), performance.Windows)
+	}
+}
+
+// TestKeeper_OraclePerformanceUnexpiredOutlier tests that an outlier price is only counted in the block it was posted in
+func TestKeeper_OraclePerformanceUnexpiredOutlier(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Now()})
+	coins := []sdk.Coins{}
+	for range addrs {
+		coins = append(coins, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)))
+	}
+	pfGenesis := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			MaxTWAPWindow: pricefeed.DefaultMaxTWAPWindow,
+			Markets: []pricefeed.Market{
+				pricefeed.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+			},
+			OutlierThreshold: sdk.MustNewDecFromStr("0.1"),
+			SlashThreshold:   1,
+			SlashFraction:    sdk.MustNewDecFromStr("0.5"),
+		},
+	}
+	tApp.InitializeFromGenesisStates(
+		app.NewAuthGenState(addrs, coins),
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pfGenesis)},
+	)
+	keeper := tApp.GetPriceFeedKeeper()
+	require.NoError(t, keeper.BondOracle(ctx, addrs[2], sdk.NewCoins(sdk.NewInt64Coin("ukava", 100))))
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	for i, price := range []string{"1.00", "1.02", "1.50"} {
+		_, err := keeper.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), expiry)
+		require.NoError(t, err)
+	}
+
+	// the outlier stays unexpired for several blocks, but is only counted and slashed in the block it was posted in
+	for height := ctx.BlockHeight(); height < 5; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, keeper.UpdateOraclePerformance(ctx, "tstusd"))
+	}
+	performance, found := keeper.GetOraclePerformance(ctx, "tstusd", addrs[2])
+	require.True(t, found)
+	require.Equal(t, int64(4), performance.Windows)
+	require.Equal(t, int64(1), performance.Outliers)
+	require.Equal(t, int64(0), performance.ConsecutiveOutliers)
+	bond, found := keeper.GetOracleBond(ctx, addrs[2])
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 50)), bond.Amount)
+
+	// the oracle can unbond once the outlier has been slashed
+	require.NoError(t, keeper.UnbondOracle(ctx, addrs[2], sdk.NewCoins(sdk.NewInt64Coin("ukava", 50))))
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// HandleAddMarketProposal adds the market of the proposal to the pricefeed
+func HandleAddMarketProposal(ctx sdk.Context, k Keeper, p types.AddMarketProposal) sdk.Error {
+	params := k.GetParams(ctx)
+	if _, found := findMarket(params.Markets, p.Market.MarketID); found {
+		return types.ErrMarketExists(k.codespace, p.Market.MarketID)
+	}
+	params.Markets = append(params.Markets, p.Market)
+	return k.setProposalParams(ctx, params)
+}
+
+// HandleRemoveMarketProposal removes a market from the pricefeed, along with its posted, current, pending and historical prices
+// and the performance of its oracles
+func HandleRemoveMarketProposal(ctx sdk.Context, k Keeper, p types.RemoveMarketProposal) sdk.Error {
+	params := k.GetParams(ctx)
+	i, found := findMarket(params.Markets, p.MarketID)
+	if !found {
+		return types.ErrInvalidMarket(k.codespace, p.MarketID)
+	}
+	if k.hooks != nil {
+		if err := k.hooks.BeforeMarketRemoved(ctx, p.MarketID); err != nil {
+			return err
+		}
+	}
+	params.Markets = append(params.Markets[:i:i], params.Markets[i+1:]...)
+	if err := k.setProposalParams(ctx, params); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Delete([]byte(types.CurrentPricePrefix + p.MarketID))
+	k.deletePendingPrice(ctx, p.MarketID)
+	k.deletePrefix(ctx, types.RawPriceMarketPrefix(p.MarketID))
+	k.deletePrefix(ctx, types.PriceHistoryMarketPrefix(p.MarketID))
+	k.deletePrefix(ctx, types.OraclePerformanceMarketPrefix(p.MarketID))
+	return nil
+}
+
+// HandleAddOracleProposal adds an oracle to a market
+func HandleAddOracleProposal(ctx sdk.Context, k Keeper, p types.AddOracleProposal) sdk.Error {
+	params := k.GetParams(ctx)
+	i, found := findMarket(params.Markets, p.MarketID)
+	if !found {
+		return types.ErrInvalidMarket(k.codespace, p.MarketID)
+	}
+	market := params.Markets[i]
+	if _, found := findOracle(market.Oracles, p.Oracle); found {
+		return types.ErrOracleExists(k.codespace, p.MarketID, p.Oracle)
+	}
+	market.Oracles = append(market.Oracles[:len(market.Oracles):len(market.Oracles)], p.Oracle)
+	params.Markets[i] = market
+	return k.setProposalParams(ctx, params)
+}
+
+// HandleRemoveOracleProposal removes an oracle from a market, along with its posted price and its performance for the market.
+// Oracles can't be removed if the market would be left with fewer oracles than its quorum, or if they are the market's trusted oracle.
+func HandleRemoveOracleProposal(ctx sdk.Context, k Keeper, p types.RemoveOracleProposal) sdk.Error {
+	params := k.GetParams(ctx)
+	i, found := findMarket(params.Markets, p.MarketID)
+	if !found {
+		return types.ErrInvalidMarket(k.codespace, p.MarketID)
+	}
+	market := params.Markets[i]
+	j, found := findOracle(market.Oracles, p.Oracle)
+	if !found {
+		return types.ErrInvalidOracle(k.codespace, p.Oracle)
+	}
+	market.Oracles = append(market.Oracles[:j:j], market.Oracles[j+1:]...)
+	var weights []types.OracleWeight
+	for _, ow := range market.OracleWeights {
+		if !ow.Oracle.Equals(p.Oracle) {
+			weights = append(weights, ow)
+		}
+	}
+	market.OracleWeights = weights
+	params.Markets[i] = market
+	if err := k.setProposalParams(ctx, params); err != nil {
+		return err
+	}
+
+	k.deleteRawPrice(ctx, p.MarketID, p.Oracle)
+	ctx.KVStore(k.key).Delete(types.OraclePerformanceKey(p.MarketID, p.Oracle))
+	return nil
+}
+
+// HandleActivateMarketProposal activates a market
+func HandleActivateMarketProposal(ctx sdk.Context, k Keeper, p types.ActivateMarketProposal) sdk.Error {
+	return k.setMarketActive(ctx, p.MarketID, true)
+}
+
+// HandleDeactivateMarketProposal deactivates a market
+func HandleDeactivateMarketProposal(ctx sdk.Context, k Keeper, p types.DeactivateMarketProposal) sdk.Error {
+	return k.setMarketActive(ctx, p.MarketID, false)
+}
+
+func (k Keeper) setMarketActive(ctx sdk.Context, marketID string, active bool) sdk.Error {
+	params := k.GetParams(ctx)
+	i, found := findMarket(params.Markets, marketID)
+	if !found {
+		return types.ErrInvalidMarket(k.codespace, marketID)
+	}
+	params.Markets[i].Active = active
+	return k.setProposalParams(ctx, params)
+}
+
+// setProposalParams sets the params changed by a proposal, if they are valid
+func (k Keeper) setProposalParams(ctx sdk.Context, params types.Params) sdk.Error {
+	if err := params.Validate(); err != nil {
+		return types.ErrInvalidProposal(k.codespace, err.Error())
+	}
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// deletePrefix deletes every key in the store with the input prefix
+func (k Keeper) deletePrefix(ctx sdk.Context, keyPrefix []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.key), keyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func findMarket(markets types.Markets, marketID string) (int, bool) {
+	for i, m := range markets {
+		if m.MarketID == marketID {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func findOracle(oracles []sdk.AccAddress, oracle sdk.AccAddress) (int, bool) {
+	for i, o := range oracles {
+		if o.Equals(oracle) {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_Proposals tests that governance proposals add, change and remove markets and oracles
+func TestKeeper_Proposals(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Now()})
+	pk := tApp.GetPriceFeedKeeper()
+	pk.SetParams(ctx, types.DefaultParams())
+	// the cdp hooks check removed markets against the cdp params
+	tApp.GetCDPKeeper().SetParams(ctx, cdp.DefaultParams())
+
+	// add a market
+	market := types.Market{
+		MarketID: "tst:usd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs[:1], Active: true,
+		MaxPriceDeviation: sdk.ZeroDec(), TrimFraction: sdk.ZeroDec(), MinOracles: 1,
+	}
+	err := keeper.HandleAddMarketProposal(ctx, pk, types.NewAddMarketProposal("title", "description", market))
+	require.NoError(t, err)
+	m, found := pk.GetMarket(ctx, "tst:usd")
+	require.True(t, found)
+	require.Equal(t, market.String(), m.String())
+	err = keeper.HandleAddMarketProposal(ctx, pk, types.NewAddMarketProposal("title", "description", market))
+	require.Equal(t, types.CodeMarketExists, err.Code())
+
+	// add and remove oracles
+	err = keeper.HandleAddOracleProposal(ctx, pk, types.NewAddOracleProposal("title", "description", "tst:usd", addrs[1]))
+	require.NoError(t, err)
+	err = keeper.HandleAddOracleProposal(ctx, pk, types.NewAddOracleProposal("title", "description", "tst:usd", addrs[1]))
+	require.Equal(t, types.CodeOracleExists, err.Code())
+	err = keeper.HandleAddOracleProposal(ctx, pk, types.NewAddOracleProposal("title", "description", "xyz:usd", addrs[1]))
+	require.Equal(t, types.CodeInvalidAsset, err.Code())
+	oracles, _ := pk.GetOracles(ctx, "tst:usd")
+	require.Equal(t, []sdk.AccAddress{addrs[0], addrs[1]}, oracles)
+
+	_, err = pk.SetPrice(ctx, addrs[0], "tst:usd", sdk.MustNewDecFromStr("1.00"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	// removing the only oracle with a price leaves no prices
+	err = keeper.HandleRemoveOracleProposal(ctx, pk, types.NewRemoveOracleProposal("title", "description", "tst:usd", addrs[0]))
+	require.NoError(t, err)
+	require.Empty(t, pk.GetRawPrices(ctx, "tst:usd"))
+	err = keeper.HandleAddOracleProposal(ctx, pk, types.NewAddOracleProposal("title", "description", "tst:usd", addrs[0]))
+	require.NoError(t, err)
+
+	for _, addr := range addrs[:2] {
+		_, err = pk.SetPrice(ctx, addr, "tst:usd", sdk.MustNewDecFromStr("1.00"), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+	}
+	err = keeper.HandleRemoveOracleProposal(ctx, pk, types.NewRemoveOracleProposal("title", "description", "tst:usd", addrs[0]))
+	require.NoError(t, err)
+	oracles, _ = pk.GetOracles(ctx, "tst:usd")
+	require.Equal(t, []sdk.AccAddress{addrs[1]}, oracles)
+	prices := pk.GetRawPrices(ctx, "tst:usd")
+	require.Equal(t, 1, len(prices))
+	require.Equal(t, addrs[1], prices[0].OracleAddress)
+	err = keeper.HandleRemoveOracleProposal(ctx, pk, types.NewRemoveOracleProposal("title", "description", "tst:usd", addrs[2]))
+	require.Equal(t, types.CodeInvalidOracle, err.Code())
+	// the market's quorum needs one oracle
+	err = keeper.HandleRemoveOracleProposal(ctx, pk, types.NewRemoveOracleProposal("title", "description", "tst:usd", addrs[1]))
+	require.Equal(t, types.CodeInvalidProposal, err.Code())
+
+	// deactivate and activate the market
+	err = keeper.HandleDeactivateMarketProposal(ctx, pk, types.NewDeactivateMarketProposal("title", "description", "tst:usd"))
+	require.NoError(t, err)
+	m, _ = pk.GetMarket(ctx, "tst:usd")
+	require.False(t, m.Active)
+	err = keeper.HandleActivateMarketProposal(ctx, pk, types.NewActivateMarketProposal("title", "description", "tst:usd"))
+	require.NoError(t, err)
+	m, _ = pk.GetMarket(ctx, "tst:usd")
+	require.True(t, m.Active)
+
+	// remove the market along with its prices
+	err = pk.SetCurrentPrices(ctx, "tst:usd")
+	require.NoError(t, err)
+	err = keeper.HandleRemoveMarketProposal(ctx, pk, types.NewRemoveMarketProposal("title", "description", "tst:usd"))
+	require.NoError(t, err)
+	_, found = pk.GetMarket(ctx, "tst:usd")
+	require.False(t, found)
+	_, err = pk.GetCurrentPrice(ctx, "tst:usd")
+	require.Error(t, err)
+	require.Empty(t, pk.GetRawPrices(ctx, "tst:usd"))
+	require.Empty(t, pk.GetPriceHistory(ctx, "tst:usd"))
+	require.Empty(t, pk.GetOraclePerformances(ctx, "tst:usd"))
+	err = keeper.HandleRemoveMarketProposal(ctx, pk, types.NewRemoveMarketProposal("title", "description", "tst:usd"))
+	require.Equal(t, types.CodeInvalidAsset, err.Code())
+}
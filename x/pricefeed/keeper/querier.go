@@ -17,8 +17,16 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryPrice:
 			return queryPrice(ctx, req, keeper)
+		case types.QueryTWAP:
+			return queryTWAP(ctx, req, keeper)
 		case types.QueryRawPrices:
 			return queryRawPrices(ctx, req, keeper)
+		case types.QueryOraclePerformance:
+			return queryOraclePerformance(ctx, req, keeper)
+		case types.QueryOracleBond:
+			return queryOracleBond(ctx, req, keeper)
+		case types.QueryRewardPool:
+			return queryRewardPool(ctx, req, keeper)
 		case types.QueryOracles:
 			return queryOracles(ctx, req, keeper)
 		case types.QueryMarkets:
@@ -54,6 +62,28 @@ func queryPrice(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []by
 	return bz, nil
 }
 
+func queryTWAP(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr sdk.Error) {
+	var requestParams types.QueryTWAPParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return []byte{}, sdk.ErrUnknownRequest("asset not found")
+	}
+	twap, sdkErr := keeper.GetTWAP(ctx, requestParams.MarketID, requestParams.Window)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, twap)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
 func queryRawPrices(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr sdk.Error) {
 	var requestParams types.QueryWithMarketIDParams
 	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
@@ -94,6 +124,59 @@ func queryOracles(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []
 	return bz, nil
 }
 
+func queryOraclePerformance(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr sdk.Error) {
+	var requestParams types.QueryWithMarketIDParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return []byte{}, sdk.ErrUnknownRequest("asset not found")
+	}
+	performances := keeper.GetOraclePerformances(ctx, requestParams.MarketID)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, performances)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryOracleBond(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr sdk.Error) {
+	var requestParams types.QueryOracleBondParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	bond, found := keeper.GetOracleBond(ctx, requestParams.Oracle)
+	if !found {
+		bond = types.NewOracleBond(requestParams.Oracle, sdk.NewCoins())
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, bond)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryRewardPool(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr sdk.Error) {
+	pool, sdkErr := keeper.GetRewardPool(ctx)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, pool)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
 func queryMarkets(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr sdk.Error) {
 	markets := keeper.GetMarkets(ctx)
 
@@ -0,0 +1,31 @@
+package pricefeed
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewProposalHandler handles all pricefeed governance proposals
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case AddMarketProposal:
+			return HandleAddMarketProposal(ctx, k, c)
+		case RemoveMarketProposal:
+			return HandleRemoveMarketProposal(ctx, k, c)
+		case AddOracleProposal:
+			return HandleAddOracleProposal(ctx, k, c)
+		case RemoveOracleProposal:
+			return HandleRemoveOracleProposal(ctx, k, c)
+		case ActivateMarketProposal:
+			return HandleActivateMarketProposal(ctx, k, c)
+		case DeactivateMarketProposal:
+			return HandleDeactivateMarketProposal(ctx, k, c)
+		default:
+			errMsg := fmt.Sprintf("unrecognized pricefeed proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding pricefeed type
+func DecodeStore(cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	switch {
+	case bytes.HasPrefix(kvA.Key, []byte(types.RawPriceFeedPrefix)):
+		var postedPriceA, postedPriceB types.PostedPrice
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &postedPriceA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &postedPriceB)
+		return fmt.Sprintf("%s\n%s", postedPriceA, postedPriceB)
+	case bytes.HasPrefix(kvA.Key, []byte(types.CurrentPricePrefix)):
+		var priceA, priceB types.CurrentPrice
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &priceA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &priceB)
+		return fmt.Sprintf("%s\n%s", priceA, priceB)
+	case bytes.HasPrefix(kvA.Key, []byte(types.PendingPricePrefix)):
+		var priceA, priceB types.PendingPrice
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &priceA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &priceB)
+		return fmt.Sprintf("%s\n%s", priceA, priceB)
+	case bytes.HasPrefix(kvA.Key, []byte(types.PriceHistoryPrefix)):
+		var observationA, observationB types.PriceObservation
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &observationA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &observationB)
+		return fmt.Sprintf("%s\n%s", observationA, observationB)
+	case bytes.HasPrefix(kvA.Key, []byte(types.OraclePerformancePrefix)):
+		var performanceA, performanceB types.OraclePerformance
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &performanceA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &performanceB)
+		return fmt.Sprintf("%s\n%s", performanceA, performanceB)
+	case bytes.HasPrefix(kvA.Key, []byte(types.OracleBondPrefix)):
+		var bondA, bondB types.OracleBond
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &bondA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &bondB)
+		return fmt.Sprintf("%s\n%s", bondA, bondB)
+	default:
+		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key))
+	}
+}
@@ -0,0 +1,173 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// Simulation parameter constants
+const (
+	Markets          = "markets"
+	OutlierThreshold = "outlier_threshold"
+	SlashThreshold   = "slash_threshold"
+	SlashFraction    = "slash_fraction"
+	OracleReward     = "oracle_reward"
+)
+
+// MaxOracles is the maximum number of simulation accounts that are made oracles for each market
+const MaxOracles = 10
+
+// BaseAssetPrices are the reference prices around which simulated prices are generated, keyed by market id
+var BaseAssetPrices = map[string]sdk.Dec{
+	"btc:usd": sdk.MustNewDecFromStr("8000.00"),
+	"xrp:usd": sdk.MustNewDecFromStr("0.25"),
+}
+
+// simulated markets, in a deterministic order
+var marketIDs = []string{"btc:usd", "xrp:usd"}
+
+// GenMarkets randomized Markets, with a random set of simulation accounts as the oracles of each market
+func GenMarkets(r *rand.Rand, accs []simulation.Account) types.Markets {
+	var markets types.Markets
+	for _, marketID := range marketIDs {
+		numOracles := simulation.RandIntBetween(r, 1, min(len(accs), MaxOracles)+1)
+		var oracles []sdk.AccAddress
+		for _, i := range r.Perm(len(accs))[:numOracles] {
+			oracles = append(oracles, accs[i].Address)
+		}
+		var weights []types.OracleWeight
+		for _, oracle := range oracles {
+			weights = append(weights, types.OracleWeight{Oracle: oracle, Weight: sdk.NewInt(int64(simulation.RandIntBetween(r, 1, 11)))})
+		}
+		markets = append(markets, types.Market{
+			MarketID:   marketID,
+			BaseAsset:  marketID[:3],
+			QuoteAsset: "usd",
+			Oracles:    oracles,
+			Active:     true,
+			// simulated prices move by up to 10% at a time, so the guard holds some of them
+			MaxPriceDeviation:  sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 5, 31)), 2),
+			ConfirmationBlocks: int64(simulation.RandIntBetween(r, 0, 4)),
+			Aggregation:        GenAggregation(r),
+			TrimFraction:       sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 31)), 2),
+			OracleWeights:      weights,
+			TrustedOracle:      oracles[r.Intn(len(oracles))],
+			MinOracles:         int64(simulation.RandIntBetween(r, 0, len(oracles)+1)),
+		})
+	}
+	return markets
+}
+
+// GenAggregation randomized market Aggregation
+func GenAggregation(r *rand.Rand) string {
+	methods := []string{
+		types.AggregationMedian, types.AggregationTrimmedMean, types.AggregationWeightedMedian, types.AggregationTrustedOracle,
+	}
+	return methods[r.Intn(len(methods))]
+}
+
+// GenPostedPrices randomized PostedPrices, with one price close to the base asset price from every oracle of every market
+func GenPostedPrices(r *rand.Rand, markets types.Markets, genesisTime time.Time) []types.PostedPrice {
+	var postedPrices []types.PostedPrice
+	for _, market := range markets {
+		basePrice, found := BaseAssetPrices[market.MarketID]
+		if !found {
+			continue
+		}
+		for _, oracle := range market.Oracles {
+			postedPrices = append(postedPrices, types.PostedPrice{
+				MarketID:      market.MarketID,
+				OracleAddress: oracle,
+				Price:         RandomPrice(r, basePrice),
+				Expiry:        genesisTime.Add(time.Hour * 24 * 365),
+			})
+		}
+	}
+	return postedPrices
+}
+
+// GenOutlierThreshold randomized OutlierThreshold, close to the range simulated prices move in so that some prices are outliers
+func GenOutlierThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 5, 21)), 2)
+}
+
+// GenSlashThreshold randomized SlashThreshold
+func GenSlashThreshold(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 0, 6))
+}
+
+// GenSlashFraction randomized SlashFraction
+func GenSlashFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 11)), 2)
+}
+
+// GenOracleReward randomized OracleReward, paid in the bond denom
+func GenOracleReward(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 0, 1001))))
+}
+
+// RandomPrice returns a price within 10% of the input price
+func RandomPrice(r *rand.Rand, price sdk.Dec) sdk.Dec {
+	return price.Mul(sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 900, 1101)), 3))
+}
+
+// RandomizedGenState generates a random GenesisState for pricefeed
+func RandomizedGenState(simState *module.SimulationState) {
+	var markets types.Markets
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Markets, &markets, simState.Rand,
+		func(r *rand.Rand) { markets = GenMarkets(r, simState.Accounts) },
+	)
+
+	var outlierThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OutlierThreshold, &outlierThreshold, simState.Rand,
+		func(r *rand.Rand) { outlierThreshold = GenOutlierThreshold(r) },
+	)
+
+	var slashThreshold int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashThreshold, &slashThreshold, simState.Rand,
+		func(r *rand.Rand) { slashThreshold = GenSlashThreshold(r) },
+	)
+
+	var slashFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFraction, &slashFraction, simState.Rand,
+		func(r *rand.Rand) { slashFraction = GenSlashFraction(r) },
+	)
+
+	var oracleReward sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OracleReward, &oracleReward, simState.Rand,
+		func(r *rand.Rand) { oracleReward = GenOracleReward(r) },
+	)
+
+	pricefeedGenesis := types.NewGenesisState(
+		types.NewParams(markets, types.DefaultMaxTWAPWindow, outlierThreshold, slashThreshold, slashFraction, oracleReward),
+		GenPostedPrices(simState.Rand, markets, simState.GenTimestamp),
+		types.OracleBonds{},
+		types.OraclePerformances{},
+		[]types.PriceObservation{},
+		[]types.PendingPrice{},
+		[]types.CurrentPrice{},
+	)
+
+	fmt.Printf("Selected randomly generated pricefeed parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, pricefeedGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(pricefeedGenesis)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,185 @@
+package operations
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/pricefeed"
+	pricefeedsim "github.com/kava-labs/kava/x/pricefeed/simulation"
+)
+
+// SimulateMsgPostPrice generates a MsgPostPrice from a random oracle of a random active market,
+// with a price close to the current price of that market
+func SimulateMsgPostPrice(k pricefeed.Keeper) simulation.Operation {
+	handler := pricefeed.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		var markets pricefeed.Markets
+		for _, m := range k.GetMarkets(ctx) {
+			if m.Active && len(m.Oracles) > 0 {
+				markets = append(markets, m)
+			}
+		}
+		if len(markets) == 0 {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, nil
+		}
+		market := markets[r.Intn(len(markets))]
+		oracle := market.Oracles[r.Intn(len(market.Oracles))]
+
+		currentPrice, sdkErr := k.GetCurrentPrice(ctx, market.MarketID)
+		if sdkErr != nil {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, nil
+		}
+		price := pricefeedsim.RandomPrice(r, currentPrice.Price)
+		expiry := ctx.BlockTime().Add(time.Duration(simulation.RandIntBetween(r, 1, 8)) * time.Hour * 24)
+
+		msg := pricefeed.NewMsgPostPrice(oracle, market.MarketID, price, expiry)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgBondOracle generates a MsgBondOracle from a random oracle of a random market,
+// bonding a random part of one of the oracle's coins
+func SimulateMsgBondOracle(ak auth.AccountKeeper, k pricefeed.Keeper) simulation.Operation {
+	handler := pricefeed.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		markets := k.GetMarkets(ctx)
+		if len(markets) == 0 {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, nil
+		}
+		market := markets[r.Intn(len(markets))]
+		if len(market.Oracles) == 0 {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, nil
+		}
+		oracle := market.Oracles[r.Intn(len(market.Oracles))]
+		acc := ak.GetAccount(ctx, oracle)
+		if acc == nil {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, nil
+		}
+		coins := acc.SpendableCoins(ctx.BlockTime())
+		if coins.Empty() {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, nil
+		}
+		coin := coins[r.Intn(len(coins))]
+		// bond up to a tenth of the oracle's balance of the coin
+		maxAmount := coin.Amount.QuoRaw(10)
+		if !maxAmount.IsPositive() {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, nil
+		}
+		amount, err := simulation.RandPositiveInt(r, maxAmount)
+		if err != nil {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, err
+		}
+
+		msg := pricefeed.NewMsgBondOracle(oracle, sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)))
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgUnbondOracle generates a MsgUnbondOracle from a random bonded oracle, unbonding a random part of its bond
+func SimulateMsgUnbondOracle(k pricefeed.Keeper) simulation.Operation {
+	handler := pricefeed.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		bonds := k.GetAllOracleBonds(ctx)
+		if len(bonds) == 0 {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, nil
+		}
+		bond := bonds[r.Intn(len(bonds))]
+		coin := bond.Amount[r.Intn(len(bond.Amount))]
+		amount, err := simulation.RandPositiveInt(r, coin.Amount)
+		if err != nil {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, err
+		}
+
+		msg := pricefeed.NewMsgUnbondOracle(bond.OracleAddress, sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)))
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		// unbonding fails while the oracle has outliers that count towards a slash
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgFundRewardPool generates a MsgFundRewardPool from a random account, adding a random part of one of the account's coins
+// to the reward pool
+func SimulateMsgFundRewardPool(ak auth.AccountKeeper, k pricefeed.Keeper) simulation.Operation {
+	handler := pricefeed.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		simAcc := simulation.RandomAcc(r, accs)
+		acc := ak.GetAccount(ctx, simAcc.Address)
+		if acc == nil {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, nil
+		}
+		coins := acc.SpendableCoins(ctx.BlockTime())
+		if coins.Empty() {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, nil
+		}
+		coin := coins[r.Intn(len(coins))]
+		// fund up to a hundredth of the account's balance of the coin
+		maxAmount := coin.Amount.QuoRaw(100)
+		if !maxAmount.IsPositive() {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, nil
+		}
+		amount, err := simulation.RandPositiveInt(r, maxAmount)
+		if err != nil {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, err
+		}
+
+		msg := pricefeed.NewMsgFundRewardPool(simAcc.Address, sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)))
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(pricefeed.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
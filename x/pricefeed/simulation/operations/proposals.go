@@ -0,0 +1,32 @@
+package operations
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govsimops "github.com/cosmos/cosmos-sdk/x/gov/simulation/operations"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+// SimulateOracleProposalContent generates a proposal adding a random account as an oracle of a random market,
+// or removing a random oracle from it
+func SimulateOracleProposalContent(k pricefeed.Keeper) govsimops.ContentSimulator {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simulation.Account) govtypes.Content {
+		title := simulation.RandStringOfLength(r, 10)
+		description := simulation.RandStringOfLength(r, 100)
+
+		markets := k.GetMarkets(ctx)
+		if len(markets) == 0 {
+			return pricefeed.NewAddOracleProposal(title, description, simulation.RandStringOfLength(r, 7), simulation.RandomAcc(r, accs).Address)
+		}
+		market := markets[r.Intn(len(markets))]
+		if r.Intn(2) == 0 && len(market.Oracles) > 0 {
+			oracle := market.Oracles[r.Intn(len(market.Oracles))]
+			return pricefeed.NewRemoveOracleProposal(title, description, market.MarketID, oracle)
+		}
+		return pricefeed.NewAddOracleProposal(title, description, market.MarketID, simulation.RandomAcc(r, accs).Address)
+	}
+}
@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+const (
+	keyOutlierThreshold = "OutlierThreshold"
+	keySlashThreshold   = "SlashThreshold"
+	keySlashFraction    = "SlashFraction"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation. Markets are not changed, as their oracles are simulation accounts
+// chosen at genesis and param changes are generated without access to the accounts.
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keyOutlierThreshold, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenOutlierThreshold(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keySlashThreshold, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenSlashThreshold(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keySlashFraction, "",
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSlashFraction(r))
+			},
+		),
+	}
+}
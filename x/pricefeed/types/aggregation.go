@@ -0,0 +1,139 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Aggregation methods a market's current price can be calculated with
+const (
+	AggregationMedian         = "median"
+	AggregationTrimmedMean    = "trimmed_mean"
+	AggregationWeightedMedian = "weighted_median"
+	AggregationTrustedOracle  = "trusted_oracle"
+)
+
+// Aggregator calculates the current price of a market from the unexpired prices posted by its oracles.
+// It returns false if no price can be calculated from the input prices.
+type Aggregator interface {
+	Aggregate(market Market, prices []PostedPrice) (sdk.Dec, bool)
+}
+
+// aggregators are the supported aggregation methods, new methods are added by implementing Aggregator and adding them here
+var aggregators = map[string]Aggregator{
+	AggregationMedian:         MedianAggregator{},
+	AggregationTrimmedMean:    TrimmedMeanAggregator{},
+	AggregationWeightedMedian: WeightedMedianAggregator{},
+	AggregationTrustedOracle:  TrustedOracleAggregator{},
+}
+
+// GetAggregator returns the aggregator of an aggregation method, markets without a method use the median
+func GetAggregator(method string) (Aggregator, bool) {
+	if method == "" {
+		method = AggregationMedian
+	}
+	aggregator, found := aggregators[method]
+	return aggregator, found
+}
+
+// MedianAggregator calculates the median of the prices, the mean of the two middle prices for even numbers of prices
+type MedianAggregator struct{}
+
+// Aggregate implements Aggregator
+func (MedianAggregator) Aggregate(market Market, prices []PostedPrice) (sdk.Dec, bool) {
+	if len(prices) == 0 {
+		return sdk.Dec{}, false
+	}
+	return MedianPrice(sortedPrices(prices)), true
+}
+
+// TrimmedMeanAggregator calculates the mean of the prices, leaving out the market's trim fraction of the lowest and of the highest prices
+type TrimmedMeanAggregator struct{}
+
+// Aggregate implements Aggregator
+func (TrimmedMeanAggregator) Aggregate(market Market, prices []PostedPrice) (sdk.Dec, bool) {
+	if len(prices) == 0 {
+		return sdk.Dec{}, false
+	}
+	sorted := sortedPrices(prices)
+	trim := 0
+	if !market.TrimFraction.IsNil() {
+		trim = int(market.TrimFraction.MulInt64(int64(len(sorted))).TruncateInt64())
+	}
+	// trimming every price leaves the middle price(s)
+	if 2*trim >= len(sorted) {
+		return MedianPrice(sorted), true
+	}
+	sum := sdk.ZeroDec()
+	for _, price := range sorted[trim : len(sorted)-trim] {
+		sum = sum.Add(price)
+	}
+	return sum.QuoInt64(int64(len(sorted) - 2*trim)), true
+}
+
+// WeightedMedianAggregator calculates the median of the prices weighted by the market's oracle weights,
+// which is the price at which half of the total weight is reached
+type WeightedMedianAggregator struct{}
+
+// Aggregate implements Aggregator
+func (WeightedMedianAggregator) Aggregate(market Market, prices []PostedPrice) (sdk.Dec, bool) {
+	if len(prices) == 0 {
+		return sdk.Dec{}, false
+	}
+	sorted := make([]PostedPrice, len(prices))
+	copy(sorted, prices)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Price.LT(sorted[j].Price)
+	})
+	weights := make([]sdk.Int, len(sorted))
+	totalWeight := sdk.ZeroInt()
+	for i, pp := range sorted {
+		weights[i] = market.OracleWeight(pp.OracleAddress)
+		totalWeight = totalWeight.Add(weights[i])
+	}
+	cumulativeWeight := sdk.ZeroInt()
+	for i, pp := range sorted {
+		cumulativeWeight = cumulativeWeight.Add(weights[i])
+		half := cumulativeWeight.MulRaw(2)
+		// with exactly half of the weight on each side, the median is between the two middle prices
+		if half.Equal(totalWeight) && i+1 < len(sorted) {
+			return pp.Price.Add(sorted[i+1].Price).QuoInt64(2), true
+		}
+		if half.GTE(totalWeight) {
+			return pp.Price, true
+		}
+	}
+	return sorted[len(sorted)-1].Price, true
+}
+
+// TrustedOracleAggregator uses the price of the market's trusted oracle
+type TrustedOracleAggregator struct{}
+
+// Aggregate implements Aggregator
+func (TrustedOracleAggregator) Aggregate(market Market, prices []PostedPrice) (sdk.Dec, bool) {
+	for _, pp := range prices {
+		if pp.OracleAddress.Equals(market.TrustedOracle) {
+			return pp.Price, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// MedianPrice returns the median of the input prices, which must be sorted
+func MedianPrice(sorted []sdk.Dec) sdk.Dec {
+	l := len(sorted)
+	if l%2 == 0 {
+		return sorted[l/2-1].Add(sorted[l/2]).QuoInt64(2)
+	}
+	return sorted[l/2]
+}
+
+func sortedPrices(prices []PostedPrice) []sdk.Dec {
+	sorted := make(SortDecs, len(prices))
+	for i, pp := range prices {
+		sorted[i] = pp.Price
+	}
+	sort.Sort(sorted)
+	return sorted
+}
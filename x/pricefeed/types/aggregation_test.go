@@ -0,0 +1,98 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregators(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("oracle0")), sdk.AccAddress([]byte("oracle1")), sdk.AccAddress([]byte("oracle2")),
+		sdk.AccAddress([]byte("oracle3")), sdk.AccAddress([]byte("oracle4")),
+	}
+	prices := []PostedPrice{
+		{MarketID: "tstusd", OracleAddress: addrs[0], Price: sdk.MustNewDecFromStr("1.00")},
+		{MarketID: "tstusd", OracleAddress: addrs[1], Price: sdk.MustNewDecFromStr("9.00")},
+		{MarketID: "tstusd", OracleAddress: addrs[2], Price: sdk.MustNewDecFromStr("1.20")},
+		{MarketID: "tstusd", OracleAddress: addrs[3], Price: sdk.MustNewDecFromStr("1.10")},
+		{MarketID: "tstusd", OracleAddress: addrs[4], Price: sdk.MustNewDecFromStr("0.10")},
+	}
+
+	tests := []struct {
+		name        string
+		market      Market
+		prices      []PostedPrice
+		expectPrice sdk.Dec
+		expectPass  bool
+	}{
+		{"defaultMedian", Market{}, prices, sdk.MustNewDecFromStr("1.10"), true},
+		{"median", Market{Aggregation: AggregationMedian}, prices, sdk.MustNewDecFromStr("1.10"), true},
+		{"evenMedian", Market{Aggregation: AggregationMedian}, prices[:4], sdk.MustNewDecFromStr("1.15"), true},
+		{"noPrices", Market{Aggregation: AggregationMedian}, nil, sdk.Dec{}, false},
+		{"mean", Market{Aggregation: AggregationTrimmedMean, TrimFraction: sdk.ZeroDec()}, prices, sdk.MustNewDecFromStr("2.48"), true},
+		{"trimmedMean", Market{Aggregation: AggregationTrimmedMean, TrimFraction: sdk.MustNewDecFromStr("0.2")}, prices, sdk.MustNewDecFromStr("1.10"), true},
+		{"trimmedMeanRoundsDown", Market{Aggregation: AggregationTrimmedMean, TrimFraction: sdk.MustNewDecFromStr("0.3")}, prices[:3], sdk.MustNewDecFromStr("3.733333333333333333"), true},
+		{"unweightedMedian", Market{Aggregation: AggregationWeightedMedian}, prices, sdk.MustNewDecFromStr("1.10"), true},
+		{"weightedMedian", Market{
+			Aggregation:   AggregationWeightedMedian,
+			OracleWeights: []OracleWeight{{addrs[1], sdk.NewInt(3)}},
+		}, prices, sdk.MustNewDecFromStr("1.20"), true},
+		{"weightedMedianBetween", Market{
+			Aggregation:   AggregationWeightedMedian,
+			OracleWeights: []OracleWeight{{addrs[1], sdk.NewInt(2)}},
+		}, prices, sdk.MustNewDecFromStr("1.15"), true},
+		{"trustedOracle", Market{Aggregation: AggregationTrustedOracle, TrustedOracle: addrs[1]}, prices, sdk.MustNewDecFromStr("9.00"), true},
+		{"trustedOracleMissing", Market{Aggregation: AggregationTrustedOracle, TrustedOracle: addrs[1]}, prices[2:], sdk.Dec{}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			aggregator, found := GetAggregator(tc.market.Aggregation)
+			require.True(t, found)
+			price, ok := aggregator.Aggregate(tc.market, tc.prices)
+			require.Equal(t, tc.expectPass, ok)
+			if tc.expectPass {
+				require.True(t, tc.expectPrice.Equal(price), "expected %s, got %s", tc.expectPrice, price)
+			}
+		})
+	}
+
+	_, found := GetAggregator("mode")
+	require.False(t, found)
+}
+
+func TestParams_ValidateAggregation(t *testing.T) {
+	oracle := sdk.AccAddress([]byte("oracle0"))
+	tests := []struct {
+		name       string
+		market     Market
+		expectPass bool
+	}{
+		{"default", Market{}, true},
+		{"unknown", Market{Aggregation: "mode"}, false},
+		{"trimmedMean", Market{Aggregation: AggregationTrimmedMean, TrimFraction: sdk.MustNewDecFromStr("0.25")}, true},
+		{"trimFractionTooLarge", Market{Aggregation: AggregationTrimmedMean, TrimFraction: sdk.MustNewDecFromStr("0.5")}, false},
+		{"negativeTrimFraction", Market{Aggregation: AggregationTrimmedMean, TrimFraction: sdk.MustNewDecFromStr("-0.1")}, false},
+		{"weightedMedian", Market{Aggregation: AggregationWeightedMedian, OracleWeights: []OracleWeight{{oracle, sdk.NewInt(2)}}}, true},
+		{"zeroWeight", Market{Aggregation: AggregationWeightedMedian, OracleWeights: []OracleWeight{{oracle, sdk.ZeroInt()}}}, false},
+		{"trustedOracle", Market{Aggregation: AggregationTrustedOracle, Oracles: []sdk.AccAddress{oracle}, TrustedOracle: oracle}, true},
+		{"trustedOracleNotAnOracle", Market{Aggregation: AggregationTrustedOracle, TrustedOracle: oracle}, false},
+		{"missingTrustedOracle", Market{Aggregation: AggregationTrustedOracle, Oracles: []sdk.AccAddress{oracle}}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			market := tc.market
+			market.MarketID = "tstusd"
+			market.BaseAsset = "tst"
+			market.QuoteAsset = "usd"
+			params := DefaultParams()
+			params.Markets = Markets{market}
+			if tc.expectPass {
+				require.NoError(t, params.Validate())
+			} else {
+				require.Error(t, params.Validate())
+			}
+		})
+	}
+}
@@ -13,4 +13,13 @@ func init() {
 // RegisterCodec registers concrete types on the Amino code
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgPostPrice{}, "pricefeed/MsgPostPrice", nil)
+	cdc.RegisterConcrete(MsgBondOracle{}, "pricefeed/MsgBondOracle", nil)
+	cdc.RegisterConcrete(MsgUnbondOracle{}, "pricefeed/MsgUnbondOracle", nil)
+	cdc.RegisterConcrete(MsgFundRewardPool{}, "pricefeed/MsgFundRewardPool", nil)
+	cdc.RegisterConcrete(AddMarketProposal{}, "pricefeed/AddMarketProposal", nil)
+	cdc.RegisterConcrete(RemoveMarketProposal{}, "pricefeed/RemoveMarketProposal", nil)
+	cdc.RegisterConcrete(AddOracleProposal{}, "pricefeed/AddOracleProposal", nil)
+	cdc.RegisterConcrete(RemoveOracleProposal{}, "pricefeed/RemoveOracleProposal", nil)
+	cdc.RegisterConcrete(ActivateMarketProposal{}, "pricefeed/ActivateMarketProposal", nil)
+	cdc.RegisterConcrete(DeactivateMarketProposal{}, "pricefeed/DeactivateMarketProposal", nil)
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -20,6 +21,20 @@ const (
 	CodeInvalidAsset sdk.CodeType = 4
 	// CodeInvalidOracle error code for invalid oracle
 	CodeInvalidOracle sdk.CodeType = 5
+	// CodeInvalidWindow error code for invalid time-weighted average price windows
+	CodeInvalidWindow sdk.CodeType = 6
+	// CodeInsufficientBond error code for unbonding more than an oracle has bonded
+	CodeInsufficientBond sdk.CodeType = 7
+	// CodeOutstandingOutliers error code for unbonding while an oracle has outliers that count towards a slash
+	CodeOutstandingOutliers sdk.CodeType = 8
+	// CodeMarketExists error code for adding a market that already exists
+	CodeMarketExists sdk.CodeType = 9
+	// CodeOracleExists error code for adding an oracle to a market it is already an oracle of
+	CodeOracleExists sdk.CodeType = 10
+	// CodeInvalidProposal error code for invalid pricefeed governance proposals
+	CodeInvalidProposal sdk.CodeType = 11
+	// CodeBondsExceedBalance error code for oracle bonds that exceed the module account balance
+	CodeBondsExceedBalance sdk.CodeType = 12
 )
 
 // ErrEmptyInput Error constructor
@@ -46,3 +61,38 @@ func ErrInvalidMarket(codespace sdk.CodespaceType, marketId string) sdk.Error {
 func ErrInvalidOracle(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidOracle, fmt.Sprintf("oracle %s does not exist or not authorized", addr))
 }
+
+// ErrInvalidTWAPWindow Error constructor for time-weighted average price requests with a window outside the price history
+func ErrInvalidTWAPWindow(codespace sdk.CodespaceType, window time.Duration, maxWindow time.Duration) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidWindow, fmt.Sprintf("twap window %s must be positive and at most %s", window, maxWindow))
+}
+
+// ErrInsufficientBond Error constructor for unbonding more than an oracle has bonded
+func ErrInsufficientBond(codespace sdk.CodespaceType, addr sdk.AccAddress, bond sdk.Coins, amount sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientBond, fmt.Sprintf("oracle %s has bonded %s, cannot unbond %s", addr, bond, amount))
+}
+
+// ErrOutstandingOutliers Error constructor for unbonding while an oracle has outliers that count towards a slash
+func ErrOutstandingOutliers(codespace sdk.CodespaceType, addr sdk.AccAddress, marketID string) sdk.Error {
+	return sdk.NewError(codespace, CodeOutstandingOutliers, fmt.Sprintf("oracle %s cannot unbond with consecutive outliers in market %s", addr, marketID))
+}
+
+// ErrMarketExists Error constructor for adding a market that already exists
+func ErrMarketExists(codespace sdk.CodespaceType, marketID string) sdk.Error {
+	return sdk.NewError(codespace, CodeMarketExists, fmt.Sprintf("market %s already exists", marketID))
+}
+
+// ErrOracleExists Error constructor for adding an oracle to a market it is already an oracle of
+func ErrOracleExists(codespace sdk.CodespaceType, marketID string, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeOracleExists, fmt.Sprintf("oracle %s is already an oracle of market %s", addr, marketID))
+}
+
+// ErrInvalidProposal Error constructor for invalid pricefeed governance proposals
+func ErrInvalidProposal(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposal, fmt.Sprintf("invalid proposal: %s", msg))
+}
+
+// ErrBondsExceedBalance Error constructor for oracle bonds that exceed the module account balance
+func ErrBondsExceedBalance(codespace sdk.CodespaceType, bonded sdk.Coins, balance sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeBondsExceedBalance, fmt.Sprintf("oracle bonds %s exceed the %s module account balance %s", bonded, ModuleName, balance))
+}
@@ -2,14 +2,26 @@ package types
 
 // Pricefeed module event types
 const (
-	EventTypeMarketPriceUpdated = "market_price_updated"
-	EventTypeOracleUpdatedPrice = "oracle_updated_price"
-	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketPriceUpdated   = "market_price_updated"
+	EventTypeMarketPriceRejected  = "market_price_rejected"
+	EventTypeMarketPriceStale     = "market_price_stale"
+	EventTypeOracleUpdatedPrice   = "oracle_updated_price"
+	EventTypeNoValidPrices        = "no_valid_prices"
+	EventTypeOracleRewarded       = "oracle_rewarded"
+	EventTypeOracleSlashed        = "oracle_slashed"
+	EventTypeOracleTrackingFailed = "oracle_tracking_failed"
+	EventTypeRawPricesPruned      = "raw_prices_pruned"
+	EventTypeRewardPoolFunded     = "reward_pool_funded"
 
 	AttributeValueCategory        = ModuleName
 	AttributeMarketID             = "market_id"
 	AttributeMarketPrice          = "market_price"
 	AttributeOracle               = "oracle"
+	AttributeDepositor            = "depositor"
 	AttributeExpiry               = "expiry"
+	AttributeConfirmations        = "confirmations"
+	AttributeAmount               = "amount"
+	AttributePrunedPrices         = "pruned_prices"
 	AttributeKeyPriceUpdateFailed = "price_update_failed"
+	AttributeKeyError             = "error_message"
 )
@@ -2,19 +2,31 @@ package types
 
 import (
 	"bytes"
+	"fmt"
+	"time"
 )
 
 // GenesisState - pricefeed state that must be provided at genesis
 type GenesisState struct {
-	Params       Params        `json:"params" yaml:"params"`
-	PostedPrices []PostedPrice `json:"posted_prices" yaml:"posted_prices"`
+	Params             Params             `json:"params" yaml:"params"`
+	PostedPrices       []PostedPrice      `json:"posted_prices" yaml:"posted_prices"`
+	OracleBonds        OracleBonds        `json:"oracle_bonds" yaml:"oracle_bonds"`
+	OraclePerformances OraclePerformances `json:"oracle_performances" yaml:"oracle_performances"`
+	PriceHistory       []PriceObservation `json:"price_history" yaml:"price_history"`
+	PendingPrices      []PendingPrice     `json:"pending_prices" yaml:"pending_prices"`
+	CurrentPrices      []CurrentPrice     `json:"current_prices" yaml:"current_prices"`
 }
 
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, bonds OracleBonds, performances OraclePerformances, history []PriceObservation, pending []PendingPrice, current []CurrentPrice) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:             p,
+		PostedPrices:       pp,
+		OracleBonds:        bonds,
+		OraclePerformances: performances,
+		PriceHistory:       history,
+		PendingPrices:      pending,
+		CurrentPrices:      current,
 	}
 }
 
@@ -23,6 +35,11 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		OracleBonds{},
+		OraclePerformances{},
+		[]PriceObservation{},
+		[]PendingPrice{},
+		[]CurrentPrice{},
 	)
 }
 
@@ -45,5 +62,64 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	bondedOracles := make(map[string]bool)
+	for _, bond := range gs.OracleBonds {
+		if bondedOracles[bond.OracleAddress.String()] {
+			return fmt.Errorf("duplicate bond for oracle %s", bond.OracleAddress)
+		}
+		bondedOracles[bond.OracleAddress.String()] = true
+		if !bond.Amount.IsValid() {
+			return fmt.Errorf("invalid bond for oracle %s: %s", bond.OracleAddress, bond.Amount)
+		}
+	}
+	for _, performance := range gs.OraclePerformances {
+		if performance.MissedWindows > performance.Windows || performance.Outliers > performance.Windows {
+			return fmt.Errorf("invalid performance for oracle %s in market %s: more missed windows or outliers than windows",
+				performance.OracleAddress, performance.MarketID)
+		}
+	}
+	markets := make(map[string]bool)
+	for _, market := range gs.Params.Markets {
+		markets[market.MarketID] = true
+	}
+	observationTimes := make(map[string]time.Time)
+	for _, observation := range gs.PriceHistory {
+		if !markets[observation.MarketID] {
+			return fmt.Errorf("price observation for unknown market %s", observation.MarketID)
+		}
+		if observation.Price.IsNil() || !observation.Price.IsPositive() {
+			return fmt.Errorf("invalid price observation for market %s: %s", observation.MarketID, observation.Price)
+		}
+		if prevTime, found := observationTimes[observation.MarketID]; found && !observation.Time.After(prevTime) {
+			return fmt.Errorf("price observations for market %s are not in time order", observation.MarketID)
+		}
+		observationTimes[observation.MarketID] = observation.Time
+	}
+	pendingMarkets := make(map[string]bool)
+	for _, pp := range gs.PendingPrices {
+		if !markets[pp.MarketID] {
+			return fmt.Errorf("pending price for unknown market %s", pp.MarketID)
+		}
+		if pendingMarkets[pp.MarketID] {
+			return fmt.Errorf("duplicate pending price for market %s", pp.MarketID)
+		}
+		pendingMarkets[pp.MarketID] = true
+		if pp.Price.IsNil() || !pp.Price.IsPositive() || pp.Confirmations < 0 {
+			return fmt.Errorf("invalid pending price for market %s: %s with %d confirmations", pp.MarketID, pp.Price, pp.Confirmations)
+		}
+	}
+	currentMarkets := make(map[string]bool)
+	for _, cp := range gs.CurrentPrices {
+		if !markets[cp.MarketID] {
+			return fmt.Errorf("current price for unknown market %s", cp.MarketID)
+		}
+		if currentMarkets[cp.MarketID] {
+			return fmt.Errorf("duplicate current price for market %s", cp.MarketID)
+		}
+		currentMarkets[cp.MarketID] = true
+		if cp.Price.IsNil() || !cp.Price.IsPositive() {
+			return fmt.Errorf("invalid current price for market %s: %s", cp.MarketID, cp.Price)
+		}
+	}
 	return nil
 }
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "pricefeed"
@@ -16,15 +22,72 @@ const (
 	// DefaultParamspace default namestore
 	DefaultParamspace = ModuleName
 
-	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
+	// RawPriceFeedPrefix prefix for the prices posted by the oracles of an asset
 	RawPriceFeedPrefix = StoreKey + ":raw:"
 
 	// CurrentPricePrefix prefix for the current price of an asset
 	CurrentPricePrefix = StoreKey + ":currentprice:"
 
+	// PendingPricePrefix prefix for the price of an asset held by the price deviation guard
+	PendingPricePrefix = StoreKey + ":pendingprice:"
+
+	// PriceHistoryPrefix prefix for the recorded price observations of an asset
+	PriceHistoryPrefix = StoreKey + ":pricehistory:"
+
+	// OraclePerformancePrefix prefix for the performance of the oracles of an asset
+	OraclePerformancePrefix = StoreKey + ":oracleperformance:"
+
+	// OracleBondPrefix prefix for the coins bonded by oracles
+	OracleBondPrefix = StoreKey + ":oraclebond:"
+
 	// MarketPrefix Prefix for the assets in the pricefeed system
 	MarketPrefix = StoreKey + ":markets"
 
 	// OraclePrefix store prefix for the oracle accounts
 	OraclePrefix = StoreKey + ":oracles"
+
+	// MaxMarketIDLength the longest market ID, as market IDs are prefixed by their length in a single byte in store keys
+	MaxMarketIDLength = 255
 )
+
+// RawPriceMarketPrefix returns the prefix of the prices posted for a market
+func RawPriceMarketPrefix(marketID string) []byte {
+	return append([]byte(RawPriceFeedPrefix), marketIDKey(marketID)...)
+}
+
+// RawPriceKey returns the key of the price posted by an oracle for a market
+func RawPriceKey(marketID string, oracle sdk.AccAddress) []byte {
+	return append(RawPriceMarketPrefix(marketID), oracle.Bytes()...)
+}
+
+// PriceHistoryMarketPrefix returns the prefix of the price observations of a market
+func PriceHistoryMarketPrefix(marketID string) []byte {
+	return append([]byte(PriceHistoryPrefix), marketIDKey(marketID)...)
+}
+
+// PriceHistoryKey returns the key of the price observation of a market at a given time.
+// Observations of a market are ordered by time.
+func PriceHistoryKey(marketID string, t time.Time) []byte {
+	return append(PriceHistoryMarketPrefix(marketID), sdk.FormatTimeBytes(t)...)
+}
+
+// OraclePerformanceMarketPrefix returns the prefix of the oracle performances of a market
+func OraclePerformanceMarketPrefix(marketID string) []byte {
+	return append([]byte(OraclePerformancePrefix), marketIDKey(marketID)...)
+}
+
+// OraclePerformanceKey returns the key of the performance of an oracle for a market
+func OraclePerformanceKey(marketID string, oracle sdk.AccAddress) []byte {
+	return append(OraclePerformanceMarketPrefix(marketID), oracle.Bytes()...)
+}
+
+// OracleBondKey returns the key of the coins bonded by an oracle
+func OracleBondKey(oracle sdk.AccAddress) []byte {
+	return append([]byte(OracleBondPrefix), oracle.Bytes()...)
+}
+
+// marketIDKey returns the market ID prefixed by its length, so that the keys of a market
+// are not a prefix of the keys of a market with a longer ID, such as "btc" and "btc:usd"
+func marketIDKey(marketID string) []byte {
+	return append([]byte{byte(len(marketID))}, marketID...)
+}
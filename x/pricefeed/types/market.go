@@ -10,11 +10,44 @@ import (
 
 // Market an asset in the pricefeed
 type Market struct {
-	MarketID   string           `json:"market_id" yaml:"market_id"`
-	BaseAsset  string           `json:"base_asset" yaml:"base_asset"`
-	QuoteAsset string           `json:"quote_asset" yaml:"quote_asset"`
-	Oracles    []sdk.AccAddress `json:"oracles" yaml:"oracles"`
-	Active     bool             `json:"active" yaml:"active"`
+	MarketID           string           `json:"market_id" yaml:"market_id"`
+	BaseAsset          string           `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset         string           `json:"quote_asset" yaml:"quote_asset"`
+	Oracles            []sdk.AccAddress `json:"oracles" yaml:"oracles"`
+	Active             bool             `json:"active" yaml:"active"`
+	MaxPriceDeviation  sdk.Dec          `json:"max_price_deviation" yaml:"max_price_deviation"` // maximum fractional change of the current price in one block, larger changes are held (zero disables the guard)
+	ConfirmationBlocks int64            `json:"confirmation_blocks" yaml:"confirmation_blocks"` // number of further blocks in which a held price must keep deviating before it is accepted
+	Aggregation        string           `json:"aggregation" yaml:"aggregation"`                 // method the current price is calculated from the oracles' prices with: median (default), trimmed_mean, weighted_median or trusted_oracle
+	TrimFraction       sdk.Dec          `json:"trim_fraction" yaml:"trim_fraction"`             // fraction of the lowest and of the highest prices left out of a trimmed mean
+	OracleWeights      []OracleWeight   `json:"oracle_weights" yaml:"oracle_weights"`           // weights of the oracles in a weighted median, oracles without a weight have a weight of one
+	TrustedOracle      sdk.AccAddress   `json:"trusted_oracle" yaml:"trusted_oracle"`           // oracle whose price is the current price with trusted_oracle aggregation
+	MinOracles         int64            `json:"min_oracles" yaml:"min_oracles"`                 // number of oracles that must have unexpired prices for a new price to be published, the last price is kept as stale otherwise (zero disables the quorum)
+}
+
+// OracleWeight the weight of an oracle's price in a weighted median
+type OracleWeight struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+	Weight sdk.Int        `json:"weight" yaml:"weight"`
+}
+
+// IsOracle returns true if the address is an oracle of the market
+func (a Market) IsOracle(address sdk.AccAddress) bool {
+	for _, oracle := range a.Oracles {
+		if oracle.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// OracleWeight returns the weight of an oracle's price in a weighted median
+func (a Market) OracleWeight(oracle sdk.AccAddress) sdk.Int {
+	for _, ow := range a.OracleWeights {
+		if ow.Oracle.Equals(oracle) {
+			return ow.Weight
+		}
+	}
+	return sdk.OneInt()
 }
 
 // String implement fmt.Stringer
@@ -24,8 +57,61 @@ func (a Market) String() string {
 	Base Asset: %s
 	Quote Asset: %s
 	Oracles: %s
-	Active: %t`,
-		a.MarketID, a.BaseAsset, a.QuoteAsset, a.Oracles, a.Active)
+	Active: %t
+	Max Price Deviation: %s
+	Confirmation Blocks: %d
+	Aggregation: %s
+	Trim Fraction: %s
+	Oracle Weights: %v
+	Trusted Oracle: %s
+	Min Oracles: %d`,
+		a.MarketID, a.BaseAsset, a.QuoteAsset, a.Oracles, a.Active, a.MaxPriceDeviation, a.ConfirmationBlocks,
+		a.Aggregation, a.TrimFraction, a.OracleWeights, a.TrustedOracle, a.MinOracles)
+}
+
+// Validate ensure that a market has valid values
+func (a Market) Validate() error {
+	if a.MarketID == "" {
+		return fmt.Errorf("invalid market: %s. missing market ID", a.String())
+	}
+	if len(a.MarketID) > MaxMarketIDLength {
+		return fmt.Errorf("invalid market: %s. market ID longer than %d characters", a.MarketID, MaxMarketIDLength)
+	}
+	if !a.MaxPriceDeviation.IsNil() && a.MaxPriceDeviation.IsNegative() {
+		return fmt.Errorf("invalid market: %s. negative max price deviation", a.MarketID)
+	}
+	if a.ConfirmationBlocks < 0 {
+		return fmt.Errorf("invalid market: %s. negative confirmation blocks", a.MarketID)
+	}
+	seenOracles := make(map[string]bool)
+	for _, oracle := range a.Oracles {
+		if oracle.Empty() {
+			return fmt.Errorf("invalid market: %s. empty oracle address", a.MarketID)
+		}
+		if seenOracles[oracle.String()] {
+			return fmt.Errorf("invalid market: %s. duplicate oracle %s", a.MarketID, oracle)
+		}
+		seenOracles[oracle.String()] = true
+	}
+	if _, found := GetAggregator(a.Aggregation); !found {
+		return fmt.Errorf("invalid market: %s. unknown aggregation %s", a.MarketID, a.Aggregation)
+	}
+	if a.Aggregation == AggregationTrimmedMean &&
+		(a.TrimFraction.IsNil() || a.TrimFraction.IsNegative() || a.TrimFraction.GTE(sdk.NewDecWithPrec(5, 1))) {
+		return fmt.Errorf("invalid market: %s. trim fraction should be at least 0 and less than 0.5", a.MarketID)
+	}
+	for _, ow := range a.OracleWeights {
+		if ow.Weight == (sdk.Int{}) || !ow.Weight.IsPositive() {
+			return fmt.Errorf("invalid market: %s. oracle weight of %s should be positive", a.MarketID, ow.Oracle)
+		}
+	}
+	if a.Aggregation == AggregationTrustedOracle && !seenOracles[a.TrustedOracle.String()] {
+		return fmt.Errorf("invalid market: %s. trusted oracle %s is not an oracle of the market", a.MarketID, a.TrustedOracle)
+	}
+	if a.MinOracles < 0 || a.MinOracles > int64(len(a.Oracles)) {
+		return fmt.Errorf("invalid market: %s. min oracles should be between 0 and the number of oracles", a.MarketID)
+	}
+	return nil
 }
 
 // Markets array type for oracle
@@ -42,8 +128,14 @@ func (ms Markets) String() string {
 
 // CurrentPrice struct that contains the metadata of a current price for a particular market in the pricefeed module.
 type CurrentPrice struct {
-	MarketID string  `json:"market_id" yaml:"market_id"`
-	Price    sdk.Dec `json:"price" yaml:"price"`
+	MarketID   string    `json:"market_id" yaml:"market_id"`
+	Price      sdk.Dec   `json:"price" yaml:"price"`
+	StaleSince time.Time `json:"stale_since" yaml:"stale_since"` // time since which fewer than the market's min oracles have unexpired prices, zero if the price is not stale
+}
+
+// IsStale returns true if the price was kept because too few oracles had unexpired prices
+func (cp CurrentPrice) IsStale() bool {
+	return !cp.StaleSince.IsZero()
 }
 
 // PostedPrice price for market posted by a specific oracle
@@ -52,12 +144,44 @@ type PostedPrice struct {
 	OracleAddress sdk.AccAddress `json:"oracle_address" yaml:"oracle_address"`
 	Price         sdk.Dec        `json:"price" yaml:"price"`
 	Expiry        time.Time      `json:"expiry" yaml:"expiry"`
+	PostHeight    int64          `json:"post_height" yaml:"post_height"` // block height the price was posted at
+}
+
+// PendingPrice an aggregated price that moved further from the current price than the market's max price deviation,
+// which is held until it has been confirmed by the market's confirmation blocks
+type PendingPrice struct {
+	MarketID      string  `json:"market_id" yaml:"market_id"`
+	Price         sdk.Dec `json:"price" yaml:"price"`
+	Confirmations int64   `json:"confirmations" yaml:"confirmations"`
+}
+
+// implement fmt.Stringer
+func (pp PendingPrice) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Price: %s
+Confirmations: %d`, pp.MarketID, pp.Price, pp.Confirmations))
+}
+
+// PriceObservation the current price of a market from the observation time until the next observation,
+// recorded to calculate time-weighted average prices
+type PriceObservation struct {
+	MarketID string    `json:"market_id" yaml:"market_id"`
+	Price    sdk.Dec   `json:"price" yaml:"price"`
+	Time     time.Time `json:"time" yaml:"time"`
+}
+
+// implement fmt.Stringer
+func (po PriceObservation) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Price: %s
+Time: %s`, po.MarketID, po.Price, po.Time))
 }
 
 // implement fmt.Stringer
 func (cp CurrentPrice) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
-Price: %s`, cp.MarketID, cp.Price))
+Price: %s
+Stale Since: %s`, cp.MarketID, cp.Price, cp.StaleSince))
 }
 
 // implement fmt.Stringer
@@ -65,7 +189,8 @@ func (pp PostedPrice) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
 Oracle Address: %s
 Price: %s
-Expiry: %s`, pp.MarketID, pp.OracleAddress, pp.Price, pp.Expiry))
+Expiry: %s
+Post Height: %d`, pp.MarketID, pp.OracleAddress, pp.Price, pp.Expiry, pp.PostHeight))
 }
 
 // SortDecs provides the interface needed to sort sdk.Dec slices
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,10 +10,21 @@ import (
 const (
 	// TypeMsgPostPrice type of PostPrice msg
 	TypeMsgPostPrice = "post_price"
+	// TypeMsgBondOracle type of BondOracle msg
+	TypeMsgBondOracle = "bond_oracle"
+	// TypeMsgUnbondOracle type of UnbondOracle msg
+	TypeMsgUnbondOracle = "unbond_oracle"
+	// TypeMsgFundRewardPool type of FundRewardPool msg
+	TypeMsgFundRewardPool = "fund_reward_pool"
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPostPrice{}
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgBondOracle{}
+	_ sdk.Msg = &MsgUnbondOracle{}
+	_ sdk.Msg = &MsgFundRewardPool{}
+)
 
 // MsgPostPrice struct representing a posted price message.
 // Used by oracles to input prices to the pricefeed
@@ -68,3 +80,129 @@ func (msg MsgPostPrice) ValidateBasic() sdk.Error {
 	// TODO check coin denoms
 	return nil
 }
+
+// MsgBondOracle struct representing an oracle bonding coins, which are slashed after repeated outliers
+type MsgBondOracle struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgBondOracle creates a new bond oracle msg
+func NewMsgBondOracle(oracle sdk.AccAddress, amount sdk.Coins) MsgBondOracle {
+	return MsgBondOracle{
+		Oracle: oracle,
+		Amount: amount,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgBondOracle) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgBondOracle) Type() string { return TypeMsgBondOracle }
+
+// GetSignBytes Implements Msg.
+func (msg MsgBondOracle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBondOracle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Oracle}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgBondOracle) ValidateBasic() sdk.Error {
+	if msg.Oracle.Empty() {
+		return sdk.ErrInternal("invalid (empty) oracle address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid bond amount: %s", msg.Amount))
+	}
+	return nil
+}
+
+// MsgUnbondOracle struct representing an oracle withdrawing bonded coins
+type MsgUnbondOracle struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgUnbondOracle creates a new unbond oracle msg
+func NewMsgUnbondOracle(oracle sdk.AccAddress, amount sdk.Coins) MsgUnbondOracle {
+	return MsgUnbondOracle{
+		Oracle: oracle,
+		Amount: amount,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgUnbondOracle) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUnbondOracle) Type() string { return TypeMsgUnbondOracle }
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnbondOracle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnbondOracle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Oracle}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgUnbondOracle) ValidateBasic() sdk.Error {
+	if msg.Oracle.Empty() {
+		return sdk.ErrInternal("invalid (empty) oracle address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid unbond amount: %s", msg.Amount))
+	}
+	return nil
+}
+
+// MsgFundRewardPool struct representing an account adding coins to the reward pool that oracles are paid from
+type MsgFundRewardPool struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgFundRewardPool creates a new fund reward pool msg
+func NewMsgFundRewardPool(depositor sdk.AccAddress, amount sdk.Coins) MsgFundRewardPool {
+	return MsgFundRewardPool{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgFundRewardPool) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgFundRewardPool) Type() string { return TypeMsgFundRewardPool }
+
+// GetSignBytes Implements Msg.
+func (msg MsgFundRewardPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFundRewardPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgFundRewardPool) ValidateBasic() sdk.Error {
+	if msg.Depositor.Empty() {
+		return sdk.ErrInternal("invalid (empty) depositor address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid reward pool funding amount: %s", msg.Amount))
+	}
+	return nil
+}
@@ -34,3 +34,34 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgBondOracle_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ukava", 100))
+
+	tests := []struct {
+		name       string
+		msg        sdk.Msg
+		expectPass bool
+	}{
+		{"bond", NewMsgBondOracle(addr, amount), true},
+		{"bondEmptyAddr", NewMsgBondOracle(sdk.AccAddress{}, amount), false},
+		{"bondEmptyAmount", NewMsgBondOracle(addr, sdk.Coins{}), false},
+		{"bondInvalidAmount", NewMsgBondOracle(addr, sdk.Coins{sdk.Coin{Denom: "ukava", Amount: sdk.NewInt(-1)}}), false},
+		{"unbond", NewMsgUnbondOracle(addr, amount), true},
+		{"unbondEmptyAddr", NewMsgUnbondOracle(sdk.AccAddress{}, amount), false},
+		{"unbondEmptyAmount", NewMsgUnbondOracle(addr, sdk.Coins{}), false},
+		{"fundRewardPool", NewMsgFundRewardPool(addr, amount), true},
+		{"fundRewardPoolEmptyAddr", NewMsgFundRewardPool(sdk.AccAddress{}, amount), false},
+		{"fundRewardPoolEmptyAmount", NewMsgFundRewardPool(addr, sdk.Coins{}), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OraclePerformance how closely the prices posted by an oracle for a market follow the market's aggregated price
+type OraclePerformance struct {
+	MarketID            string         `json:"market_id" yaml:"market_id"`
+	OracleAddress       sdk.AccAddress `json:"oracle_address" yaml:"oracle_address"`
+	Windows             int64          `json:"windows" yaml:"windows"`                           // number of blocks the market's price was updated in
+	MissedWindows       int64          `json:"missed_windows" yaml:"missed_windows"`             // number of windows the oracle had no unexpired price in
+	Outliers            int64          `json:"outliers" yaml:"outliers"`                         // number of windows the oracle's price was further than the outlier threshold from the aggregated price
+	ConsecutiveOutliers int64          `json:"consecutive_outliers" yaml:"consecutive_outliers"` // number of outliers since the oracle's last price within the outlier threshold, or its last slash
+	TotalDeviation      sdk.Dec        `json:"total_deviation" yaml:"total_deviation"`           // sum of the fractional distances of the oracle's prices from the aggregated price
+	LastDeviation       sdk.Dec        `json:"last_deviation" yaml:"last_deviation"`             // fractional distance of the oracle's last price from the aggregated price
+}
+
+// NewOraclePerformance returns the performance of an oracle that has not been tracked in any window
+func NewOraclePerformance(marketID string, oracle sdk.AccAddress) OraclePerformance {
+	return OraclePerformance{
+		MarketID:       marketID,
+		OracleAddress:  oracle,
+		TotalDeviation: sdk.ZeroDec(),
+		LastDeviation:  sdk.ZeroDec(),
+	}
+}
+
+// implement fmt.Stringer
+func (op OraclePerformance) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Oracle Address: %s
+Windows: %d
+Missed Windows: %d
+Outliers: %d
+Consecutive Outliers: %d
+Total Deviation: %s
+Last Deviation: %s`, op.MarketID, op.OracleAddress, op.Windows, op.MissedWindows, op.Outliers, op.ConsecutiveOutliers,
+		op.TotalDeviation, op.LastDeviation))
+}
+
+// OraclePerformances array of OraclePerformance
+type OraclePerformances []OraclePerformance
+
+// OracleBond coins bonded by an oracle, which are slashed into the reward pool after repeated outliers
+type OracleBond struct {
+	OracleAddress sdk.AccAddress `json:"oracle_address" yaml:"oracle_address"`
+	Amount        sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewOracleBond returns a new OracleBond
+func NewOracleBond(oracle sdk.AccAddress, amount sdk.Coins) OracleBond {
+	return OracleBond{
+		OracleAddress: oracle,
+		Amount:        amount,
+	}
+}
+
+// implement fmt.Stringer
+func (ob OracleBond) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Oracle Address: %s
+Amount: %s`, ob.OracleAddress, ob.Amount))
+}
+
+// OracleBonds array of OracleBond
+type OracleBonds []OracleBond
@@ -3,31 +3,53 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Parameter keys
 var (
-	KeyMarkets     = []byte("Markets")
-	DefaultMarkets = Markets{}
+	KeyMarkets              = []byte("Markets")
+	KeyMaxTWAPWindow        = []byte("MaxTWAPWindow")
+	KeyOutlierThreshold     = []byte("OutlierThreshold")
+	KeySlashThreshold       = []byte("SlashThreshold")
+	KeySlashFraction        = []byte("SlashFraction")
+	KeyOracleReward         = []byte("OracleReward")
+	DefaultMarkets          = Markets{}
+	DefaultMaxTWAPWindow    = time.Hour * 24
+	DefaultOutlierThreshold = sdk.NewDecWithPrec(1, 1)
+	DefaultSlashThreshold   = int64(0)
+	DefaultSlashFraction    = sdk.ZeroDec()
+	DefaultOracleReward     = sdk.Coins{}
 )
 
 // Params params for pricefeed. Can be altered via governance
 type Params struct {
-	Markets Markets `json:"markets" yaml:"markets"` //  Array containing the markets supported by the pricefeed
+	Markets          Markets       `json:"markets" yaml:"markets"`                     //  Array containing the markets supported by the pricefeed
+	MaxTWAPWindow    time.Duration `json:"max_twap_window" yaml:"max_twap_window"`     // longest window a time-weighted average price can be requested over, price history older than this is pruned
+	OutlierThreshold sdk.Dec       `json:"outlier_threshold" yaml:"outlier_threshold"` // fractional distance from the aggregated price beyond which an oracle's price is an outlier, zero disables outlier tracking
+	SlashThreshold   int64         `json:"slash_threshold" yaml:"slash_threshold"`     // number of consecutive outliers after which an oracle's bond is slashed, zero disables slashing
+	SlashFraction    sdk.Dec       `json:"slash_fraction" yaml:"slash_fraction"`       // fraction of an oracle's bond slashed into the reward pool
+	OracleReward     sdk.Coins     `json:"oracle_reward" yaml:"oracle_reward"`         // paid from the reward pool to each oracle with a price that is not an outlier in each block
 }
 
 // NewParams creates a new AssetParams object
-func NewParams(markets Markets) Params {
+func NewParams(markets Markets, maxTWAPWindow time.Duration, outlierThreshold sdk.Dec, slashThreshold int64, slashFraction sdk.Dec, oracleReward sdk.Coins) Params {
 	return Params{
-		Markets: markets,
+		Markets:          markets,
+		MaxTWAPWindow:    maxTWAPWindow,
+		OutlierThreshold: outlierThreshold,
+		SlashThreshold:   slashThreshold,
+		SlashFraction:    slashFraction,
+		OracleReward:     oracleReward,
 	}
 }
 
 // DefaultParams default params for pricefeed
 func DefaultParams() Params {
-	return NewParams(DefaultMarkets)
+	return NewParams(DefaultMarkets, DefaultMaxTWAPWindow, DefaultOutlierThreshold, DefaultSlashThreshold, DefaultSlashFraction, DefaultOracleReward)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -40,6 +62,11 @@ func ParamKeyTable() params.KeyTable {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		{Key: KeyMarkets, Value: &p.Markets},
+		{Key: KeyMaxTWAPWindow, Value: &p.MaxTWAPWindow},
+		{Key: KeyOutlierThreshold, Value: &p.OutlierThreshold},
+		{Key: KeySlashThreshold, Value: &p.SlashThreshold},
+		{Key: KeySlashFraction, Value: &p.SlashFraction},
+		{Key: KeyOracleReward, Value: &p.OracleReward},
 	}
 }
 
@@ -49,15 +76,40 @@ func (p Params) String() string {
 	for _, a := range p.Markets {
 		out += fmt.Sprintf("%s\n", a.String())
 	}
+	out += fmt.Sprintf("Max TWAP Window: %s\n", p.MaxTWAPWindow)
+	out += fmt.Sprintf("Outlier Threshold: %s\n", p.OutlierThreshold)
+	out += fmt.Sprintf("Slash Threshold: %d\n", p.SlashThreshold)
+	out += fmt.Sprintf("Slash Fraction: %s\n", p.SlashFraction)
+	out += fmt.Sprintf("Oracle Reward: %s\n", p.OracleReward)
 	return strings.TrimSpace(out)
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
+	if p.MaxTWAPWindow <= 0 {
+		return fmt.Errorf("max twap window should be positive, is %s", p.MaxTWAPWindow)
+	}
+	if !p.OutlierThreshold.IsNil() && p.OutlierThreshold.IsNegative() {
+		return fmt.Errorf("outlier threshold should not be negative, is %s", p.OutlierThreshold)
+	}
+	if p.SlashThreshold < 0 {
+		return fmt.Errorf("slash threshold should not be negative, is %d", p.SlashThreshold)
+	}
+	if !p.SlashFraction.IsNil() && (p.SlashFraction.IsNegative() || p.SlashFraction.GT(sdk.OneDec())) {
+		return fmt.Errorf("slash fraction should be between 0 and 1, is %s", p.SlashFraction)
+	}
+	if !p.OracleReward.IsValid() {
+		return fmt.Errorf("invalid oracle reward: %s", p.OracleReward)
+	}
 	// iterate over assets and verify them
+	seenMarkets := make(map[string]bool)
 	for _, asset := range p.Markets {
-		if asset.MarketID == "" {
-			return fmt.Errorf("invalid market: %s. missing market ID", asset.String())
+		if seenMarkets[asset.MarketID] {
+			return fmt.Errorf("duplicate market: %s", asset.MarketID)
+		}
+		seenMarkets[asset.MarketID] = true
+		if err := asset.Validate(); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -0,0 +1,321 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeAddMarket defines the type for an AddMarketProposal
+	ProposalTypeAddMarket = "AddMarket"
+	// ProposalTypeRemoveMarket defines the type for a RemoveMarketProposal
+	ProposalTypeRemoveMarket = "RemoveMarket"
+	// ProposalTypeAddOracle defines the type for an AddOracleProposal
+	ProposalTypeAddOracle = "AddOracle"
+	// ProposalTypeRemoveOracle defines the type for a RemoveOracleProposal
+	ProposalTypeRemoveOracle = "RemoveOracle"
+	// ProposalTypeActivateMarket defines the type for an ActivateMarketProposal
+	ProposalTypeActivateMarket = "ActivateMarket"
+	// ProposalTypeDeactivateMarket defines the type for a DeactivateMarketProposal
+	ProposalTypeDeactivateMarket = "DeactivateMarket"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = AddMarketProposal{}
+	_ govtypes.Content = RemoveMarketProposal{}
+	_ govtypes.Content = AddOracleProposal{}
+	_ govtypes.Content = RemoveOracleProposal{}
+	_ govtypes.Content = ActivateMarketProposal{}
+	_ govtypes.Content = DeactivateMarketProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddMarket)
+	govtypes.RegisterProposalTypeCodec(AddMarketProposal{}, "pricefeed/AddMarketProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveMarket)
+	govtypes.RegisterProposalTypeCodec(RemoveMarketProposal{}, "pricefeed/RemoveMarketProposal")
+	govtypes.RegisterProposalType(ProposalTypeAddOracle)
+	govtypes.RegisterProposalTypeCodec(AddOracleProposal{}, "pricefeed/AddOracleProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveOracle)
+	govtypes.RegisterProposalTypeCodec(RemoveOracleProposal{}, "pricefeed/RemoveOracleProposal")
+	govtypes.RegisterProposalType(ProposalTypeActivateMarket)
+	govtypes.RegisterProposalTypeCodec(ActivateMarketProposal{}, "pricefeed/ActivateMarketProposal")
+	govtypes.RegisterProposalType(ProposalTypeDeactivateMarket)
+	govtypes.RegisterProposalTypeCodec(DeactivateMarketProposal{}, "pricefeed/DeactivateMarketProposal")
+}
+
+// AddMarketProposal adds a market to the pricefeed
+type AddMarketProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Market      Market `json:"market" yaml:"market"`
+}
+
+// NewAddMarketProposal creates a new add market proposal
+func NewAddMarketProposal(title, description string, market Market) AddMarketProposal {
+	return AddMarketProposal{title, description, market}
+}
+
+// GetTitle returns the title of the proposal
+func (p AddMarketProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p AddMarketProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p AddMarketProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p AddMarketProposal) ProposalType() string { return ProposalTypeAddMarket }
+
+// ValidateBasic runs basic stateless validity checks
+func (p AddMarketProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+	if err := p.Market.Validate(); err != nil {
+		return ErrInvalidProposal(DefaultCodespace, err.Error())
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p AddMarketProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Add Market Proposal:
+  Title:       %s
+  Description: %s
+  %s`, p.Title, p.Description, p.Market))
+}
+
+// RemoveMarketProposal removes a market from the pricefeed, along with its prices
+type RemoveMarketProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	MarketID    string `json:"market_id" yaml:"market_id"`
+}
+
+// NewRemoveMarketProposal creates a new remove market proposal
+func NewRemoveMarketProposal(title, description, marketID string) RemoveMarketProposal {
+	return RemoveMarketProposal{title, description, marketID}
+}
+
+// GetTitle returns the title of the proposal
+func (p RemoveMarketProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p RemoveMarketProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p RemoveMarketProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p RemoveMarketProposal) ProposalType() string { return ProposalTypeRemoveMarket }
+
+// ValidateBasic runs basic stateless validity checks
+func (p RemoveMarketProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(p.MarketID) == "" {
+		return ErrInvalidProposal(DefaultCodespace, "missing market ID")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p RemoveMarketProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Remove Market Proposal:
+  Title:       %s
+  Description: %s
+  Market ID:   %s`, p.Title, p.Description, p.MarketID))
+}
+
+// AddOracleProposal adds an oracle to a market
+type AddOracleProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	MarketID    string         `json:"market_id" yaml:"market_id"`
+	Oracle      sdk.AccAddress `json:"oracle" yaml:"oracle"`
+}
+
+// NewAddOracleProposal creates a new add oracle proposal
+func NewAddOracleProposal(title, description, marketID string, oracle sdk.AccAddress) AddOracleProposal {
+	return AddOracleProposal{title, description, marketID, oracle}
+}
+
+// GetTitle returns the title of the proposal
+func (p AddOracleProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p AddOracleProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p AddOracleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p AddOracleProposal) ProposalType() string { return ProposalTypeAddOracle }
+
+// ValidateBasic runs basic stateless validity checks
+func (p AddOracleProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+	return validateOracleProposal(p.MarketID, p.Oracle)
+}
+
+// String implements fmt.Stringer
+func (p AddOracleProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Add Oracle Proposal:
+  Title:       %s
+  Description: %s
+  Market ID:   %s
+  Oracle:      %s`, p.Title, p.Description, p.MarketID, p.Oracle))
+}
+
+// RemoveOracleProposal removes an oracle from a market, along with its price for the market
+type RemoveOracleProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	MarketID    string         `json:"market_id" yaml:"market_id"`
+	Oracle      sdk.AccAddress `json:"oracle" yaml:"oracle"`
+}
+
+// NewRemoveOracleProposal creates a new remove oracle proposal
+func NewRemoveOracleProposal(title, description, marketID string, oracle sdk.AccAddress) RemoveOracleProposal {
+	return RemoveOracleProposal{title, description, marketID, oracle}
+}
+
+// GetTitle returns the title of the proposal
+func (p RemoveOracleProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p RemoveOracleProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p RemoveOracleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p RemoveOracleProposal) ProposalType() string { return ProposalTypeRemoveOracle }
+
+// ValidateBasic runs basic stateless validity checks
+func (p RemoveOracleProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+	return validateOracleProposal(p.MarketID, p.Oracle)
+}
+
+// String implements fmt.Stringer
+func (p RemoveOracleProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Remove Oracle Proposal:
+  Title:       %s
+  Description: %s
+  Market ID:   %s
+  Oracle:      %s`, p.Title, p.Description, p.MarketID, p.Oracle))
+}
+
+// ActivateMarketProposal activates a market, so that its current price is updated again
+type ActivateMarketProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	MarketID    string `json:"market_id" yaml:"market_id"`
+}
+
+// NewActivateMarketProposal creates a new activate market proposal
+func NewActivateMarketProposal(title, description, marketID string) ActivateMarketProposal {
+	return ActivateMarketProposal{title, description, marketID}
+}
+
+// GetTitle returns the title of the proposal
+func (p ActivateMarketProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p ActivateMarketProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p ActivateMarketProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p ActivateMarketProposal) ProposalType() string { return ProposalTypeActivateMarket }
+
+// ValidateBasic runs basic stateless validity checks
+func (p ActivateMarketProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(p.MarketID) == "" {
+		return ErrInvalidProposal(DefaultCodespace, "missing market ID")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p ActivateMarketProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Activate Market Proposal:
+  Title:       %s
+  Description: %s
+  Market ID:   %s`, p.Title, p.Description, p.MarketID))
+}
+
+// DeactivateMarketProposal deactivates a market, which keeps its last current price until it is activated again
+type DeactivateMarketProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	MarketID    string `json:"market_id" yaml:"market_id"`
+}
+
+// NewDeactivateMarketProposal creates a new deactivate market proposal
+func NewDeactivateMarketProposal(title, description, marketID string) DeactivateMarketProposal {
+	return DeactivateMarketProposal{title, description, marketID}
+}
+
+// GetTitle returns the title of the proposal
+func (p DeactivateMarketProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p DeactivateMarketProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p DeactivateMarketProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p DeactivateMarketProposal) ProposalType() string { return ProposalTypeDeactivateMarket }
+
+// ValidateBasic runs basic stateless validity checks
+func (p DeactivateMarketProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(p.MarketID) == "" {
+		return ErrInvalidProposal(DefaultCodespace, "missing market ID")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p DeactivateMarketProposal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Deactivate Market Proposal:
+  Title:       %s
+  Description: %s
+  Market ID:   %s`, p.Title, p.Description, p.MarketID))
+}
+
+func validateOracleProposal(marketID string, oracle sdk.AccAddress) sdk.Error {
+	if strings.TrimSpace(marketID) == "" {
+		return ErrInvalidProposal(DefaultCodespace, "missing market ID")
+	}
+	if oracle.Empty() {
+		return ErrInvalidProposal(DefaultCodespace, "missing oracle address")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposals_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	market := Market{MarketID: "tst:usd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{addr}, Active: true}
+	longIDMarket := market
+	longIDMarket.MarketID = strings.Repeat("a", MaxMarketIDLength+1)
+
+	tests := []struct {
+		name       string
+		proposal   govtypes.Content
+		expectPass bool
+	}{
+		{"addMarket", NewAddMarketProposal("title", "description", market), true},
+		{"addMarketEmptyTitle", NewAddMarketProposal("", "description", market), false},
+		{"addInvalidMarket", NewAddMarketProposal("title", "description", Market{}), false},
+		{"addMarketLongID", NewAddMarketProposal("title", "description", longIDMarket), false},
+		{"removeMarket", NewRemoveMarketProposal("title", "description", "tst:usd"), true},
+		{"removeMarketEmptyID", NewRemoveMarketProposal("title", "description", ""), false},
+		{"addOracle", NewAddOracleProposal("title", "description", "tst:usd", addr), true},
+		{"addOracleEmptyAddr", NewAddOracleProposal("title", "description", "tst:usd", sdk.AccAddress{}), false},
+		{"removeOracle", NewRemoveOracleProposal("title", "description", "tst:usd", addr), true},
+		{"removeOracleEmptyID", NewRemoveOracleProposal("title", "description", "", addr), false},
+		{"activateMarket", NewActivateMarketProposal("title", "description", "tst:usd"), true},
+		{"activateMarketEmptyDescription", NewActivateMarketProposal("title", "", "tst:usd"), false},
+		{"deactivateMarket", NewDeactivateMarketProposal("title", "description", "tst:usd"), true},
+		{"deactivateMarketEmptyID", NewDeactivateMarketProposal("title", "description", " "), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.proposal.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.proposal.ValidateBasic())
+			}
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // price Takes an [assetcode] and returns CurrentPrice for that asset
 // pricefeed Takes an [assetcode] and returns the raw []PostedPrice for that asset
 // assets Returns []Assets in the pricefeed system
@@ -15,6 +21,14 @@ const (
 	QueryRawPrices = "rawprices"
 	// QueryPrice command for price queries
 	QueryPrice = "price"
+	// QueryTWAP command for time-weighted average price queries
+	QueryTWAP = "twap"
+	// QueryOraclePerformance command for oracle performance queries
+	QueryOraclePerformance = "oracleperformance"
+	// QueryOracleBond command for oracle bond queries
+	QueryOracleBond = "oraclebond"
+	// QueryRewardPool command for reward pool queries
+	QueryRewardPool = "rewardpool"
 )
 
 // QueryWithMarketIDParams fields for querying information from a specific market
@@ -28,3 +42,29 @@ func NewQueryWithMarketIDParams(marketID string) QueryWithMarketIDParams {
 		MarketID: marketID,
 	}
 }
+
+// QueryTWAPParams fields for querying the time-weighted average price of a market
+type QueryTWAPParams struct {
+	MarketID string
+	Window   time.Duration
+}
+
+// NewQueryTWAPParams creates a new instance of QueryTWAPParams
+func NewQueryTWAPParams(marketID string, window time.Duration) QueryTWAPParams {
+	return QueryTWAPParams{
+		MarketID: marketID,
+		Window:   window,
+	}
+}
+
+// QueryOracleBondParams fields for querying the bond of an oracle
+type QueryOracleBondParams struct {
+	Oracle sdk.AccAddress
+}
+
+// NewQueryOracleBondParams creates a new instance of QueryOracleBondParams
+func NewQueryOracleBondParams(oracle sdk.AccAddress) QueryOracleBondParams {
+	return QueryOracleBondParams{
+		Oracle: oracle,
+	}
+}